@@ -1,23 +1,38 @@
 package vcsclient
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"os"
-	"sort"
 	"strings"
+	"time"
 
-	"github.com/jfrog/gofrog/datastructures"
+	"github.com/google/uuid"
 	"github.com/microsoft/azure-devops-go-api/azuredevops"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/core"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/git"
 	"github.com/mitchellh/mapstructure"
 
 	"github.com/jfrog/froggit-go/vcsutils"
 )
 
+// The location ID of the Azure Repos file diffs API
+var azureReposFileDiffsLocationID = uuid.MustParse("c4c5a7e6-e9f3-4730-a92b-84baacff694b")
+
+// The object ID used to create or delete a branch
+const azureReposEmptyObjectID = "0000000000000000000000000000000000000000"
+
+// The comments of a pull request thread are numbered from 1, so the first comment of every thread has the same ID
+const azureReposFirstThreadCommentID = 1
+
+// The default number of items fetched in each page of the lists which are paged by skipping items
+const azureReposPageSize = 100
+
 // Azure Devops API version 6
 type AzureReposClient struct {
 	vcsInfo           VcsInfo
@@ -64,21 +79,352 @@ func (client *AzureReposClient) ListRepositories(ctx context.Context) (map[strin
 	return repositories, nil
 }
 
-// ListBranches on Azure Repos
-func (client *AzureReposClient) ListBranches(ctx context.Context, _, repository string) ([]string, error) {
+// StreamRepositories on Azure Repos. The owner is the project, which is by default the project of the client.
+func (client *AzureReposClient) StreamRepositories(ctx context.Context, options ListRepositoriesOptions) <-chan RepositoryResult {
+	if !options.UpdatedSince.IsZero() {
+		return streamRepositories(ctx, options, newErrorPager[RepositoryListItem](&UnsupportedFeatureError{Provider: vcsutils.AzureRepos, Feature: "repository push times"}))
+	}
+	project := client.vcsInfo.Project
+	if options.Owner != "" {
+		project = options.Owner
+	}
+	// The repositories of a project are returned in a single response
+	return streamRepositories(ctx, options, newSinglePagePager(func(ctx context.Context) ([]RepositoryListItem, error) {
+		azureReposGitClient, err := client.buildAzureReposClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+		repos, err := azureReposGitClient.GetRepositories(ctx, git.GetRepositoriesArgs{Project: &project})
+		if err != nil {
+			return nil, err
+		}
+		results := make([]RepositoryListItem, 0, len(vcsutils.DefaultIfNotNil(repos)))
+		for _, repo := range vcsutils.DefaultIfNotNil(repos) {
+			results = append(results, RepositoryListItem{Owner: project, Name: vcsutils.DefaultIfNotNil(repo.Name), RepositoryInfo: mapAzureReposRepositoryToRepositoryInfo(&repo)})
+		}
+		return results, nil
+	}))
+}
+
+// ListBranches on Azure Repos. The branches are returned in a single response.
+func (client *AzureReposClient) ListBranches(_, repository string) *Pager[string] {
+	return newSinglePagePager(func(ctx context.Context) ([]string, error) {
+		azureReposGitClient, err := client.buildAzureReposClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+		var branches []string
+		gitBranchStats, err := azureReposGitClient.GetBranches(ctx, git.GetBranchesArgs{Project: &client.vcsInfo.Project, RepositoryId: &repository})
+		if err != nil {
+			return nil, err
+		}
+		for _, branch := range *gitBranchStats {
+			branches = append(branches, *branch.Name)
+		}
+		return branches, nil
+	})
+}
+
+// CreateBranch on Azure Repos
+func (client *AzureReposClient) CreateBranch(ctx context.Context, owner, repository, branch, fromRef string) error {
+	err := validateParametersNotBlank(map[string]string{"repository": repository, "branch": branch, "fromRef": fromRef})
+	if err != nil {
+		return err
+	}
+	sha, err := client.getCommitSHA(ctx, owner, repository, fromRef)
+	if err != nil {
+		return err
+	}
+	return client.updateRef(ctx, repository, branchRefPrefix+branch, azureReposEmptyObjectID, sha)
+}
+
+// DeleteBranch on Azure Repos
+func (client *AzureReposClient) DeleteBranch(ctx context.Context, _, repository, branch string) error {
+	err := validateParametersNotBlank(map[string]string{"repository": repository, "branch": branch})
+	if err != nil {
+		return err
+	}
+	// The current commit of the branch is required to delete it
+	objectID, err := client.getRefObjectID(ctx, repository, branchRefPrefix+branch)
+	if err != nil {
+		return err
+	}
+	return client.updateRef(ctx, repository, branchRefPrefix+branch, objectID, azureReposEmptyObjectID)
+}
+
+// getCommitSHA returns the commit SHA of a branch. References can be created only from a commit SHA.
+func (client *AzureReposClient) getCommitSHA(ctx context.Context, owner, repository, ref string) (string, error) {
+	if isCommitSHA(ref) {
+		return ref, nil
+	}
+	commit, err := client.GetLatestCommit(ctx, owner, repository, ref)
+	return commit.Hash, err
+}
+
+// getRefObjectID returns the ID of the object the reference points to, such as the head commit of a branch
+func (client *AzureReposClient) getRefObjectID(ctx context.Context, repository, refName string) (string, error) {
 	azureReposGitClient, err := client.buildAzureReposClient(ctx)
 	if err != nil {
-		return nil, err
+		return "", err
+	}
+	refs, err := azureReposGitClient.GetRefs(ctx, git.GetRefsArgs{
+		RepositoryId: &repository,
+		Project:      &client.vcsInfo.Project,
+		Filter:       vcsutils.PointerOf(strings.TrimPrefix(refName, "refs/")),
+	})
+	if err != nil {
+		return "", err
+	}
+	// The filter matches all the references starting with the reference name
+	for _, ref := range refs.Value {
+		if vcsutils.DefaultIfNotNil(ref.Name) == refName {
+			return vcsutils.DefaultIfNotNil(ref.ObjectId), nil
+		}
 	}
-	var branches []string
-	gitBranchStats, err := azureReposGitClient.GetBranches(ctx, git.GetBranchesArgs{Project: &client.vcsInfo.Project, RepositoryId: &repository})
+	return "", fmt.Errorf("'%s' was not found in the repository %s", refName, repository)
+}
+
+// updateRef creates, moves or deletes a reference. An empty object ID is used as the old object of a new reference, and the new object of a deleted reference.
+func (client *AzureReposClient) updateRef(ctx context.Context, repository, refName, oldObjectID, newObjectID string) error {
+	azureReposGitClient, err := client.buildAzureReposClient(ctx)
 	if err != nil {
-		return nil, err
+		return err
+	}
+	results, err := azureReposGitClient.UpdateRefs(ctx, git.UpdateRefsArgs{
+		RefUpdates: &[]git.GitRefUpdate{{
+			Name:        &refName,
+			OldObjectId: &oldObjectID,
+			NewObjectId: &newObjectID,
+		}},
+		RepositoryId: &repository,
+		Project:      &client.vcsInfo.Project,
+	})
+	if err != nil {
+		return err
+	}
+	for _, result := range vcsutils.DefaultIfNotNil(results) {
+		if !vcsutils.DefaultIfNotNil(result.Success) {
+			return fmt.Errorf("failed to update '%s': %s", refName, vcsutils.DefaultIfNotNil(result.UpdateStatus))
+		}
+	}
+	return nil
+}
+
+// GetBranch on Azure Repos.
+// The branch is considered protected if it has enabled branch policies.
+func (client *AzureReposClient) GetBranch(ctx context.Context, _, repository, branch string) (BranchDetails, error) {
+	err := validateParametersNotBlank(map[string]string{"repository": repository, "branch": branch})
+	if err != nil {
+		return BranchDetails{}, err
+	}
+	azureReposGitClient, err := client.buildAzureReposClient(ctx)
+	if err != nil {
+		return BranchDetails{}, err
+	}
+	branchStats, err := azureReposGitClient.GetBranch(ctx, git.GetBranchArgs{
+		RepositoryId: &repository,
+		Name:         &branch,
+		Project:      &client.vcsInfo.Project,
+	})
+	if err != nil {
+		return BranchDetails{}, err
+	}
+	repo, err := azureReposGitClient.GetRepository(ctx, git.GetRepositoryArgs{RepositoryId: &repository, Project: &client.vcsInfo.Project})
+	if err != nil {
+		return BranchDetails{}, err
+	}
+	policies, err := azureReposGitClient.GetPolicyConfigurations(ctx, git.GetPolicyConfigurationsArgs{
+		Project:      &client.vcsInfo.Project,
+		RepositoryId: repo.Id,
+		RefName:      vcsutils.PointerOf(branchRefPrefix + branch),
+	})
+	if err != nil {
+		return BranchDetails{}, err
+	}
+	branchDetails := BranchDetails{
+		Name:    vcsutils.DefaultIfNotNil(branchStats.Name),
+		Default: vcsutils.DefaultIfNotNil(repo.DefaultBranch) == branchRefPrefix+branch,
+	}
+	if branchStats.Commit != nil {
+		branchDetails.CommitSHA = vcsutils.DefaultIfNotNil(branchStats.Commit.CommitId)
+	}
+	for _, policy := range vcsutils.DefaultIfNotNil(policies.PolicyConfigurations) {
+		if vcsutils.DefaultIfNotNil(policy.IsEnabled) && !vcsutils.DefaultIfNotNil(policy.IsDeleted) {
+			branchDetails.Protected = true
+			break
+		}
+	}
+	return branchDetails, nil
+}
+
+// CommitFiles on Azure Repos
+func (client *AzureReposClient) CommitFiles(ctx context.Context, _, repository, branch, message string, changes []CommitFileChange, author CommitAuthor) error {
+	err := validateParametersNotBlank(map[string]string{"repository": repository, "branch": branch, "message": message})
+	if err != nil {
+		return err
+	}
+	if err = validateCommitFileChanges(changes); err != nil {
+		return err
+	}
+	// The push is rejected if the branch was moved since its head commit was read
+	objectID, err := client.getRefObjectID(ctx, repository, branchRefPrefix+branch)
+	if err != nil {
+		return err
+	}
+	return client.pushChanges(ctx, repository, branch, objectID, message, changes, author)
+}
+
+// pushChanges pushes a single commit with the file changes on top of the branch head commit.
+// An empty object ID is used as the head commit of a new branch.
+func (client *AzureReposClient) pushChanges(ctx context.Context, repository, branch, headObjectID, message string, changes []CommitFileChange, author CommitAuthor) error {
+	var gitChanges []interface{}
+	for _, change := range changes {
+		gitChanges = append(gitChanges, mapCommitFileChangeToAzureReposChanges(change)...)
+	}
+	commit := git.GitCommitRef{Comment: &message, Changes: &gitChanges}
+	if author != (CommitAuthor{}) {
+		commit.Author = &git.GitUserDate{Name: &author.Name, Email: &author.Email}
+	}
+	azureReposGitClient, err := client.buildAzureReposClient(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = azureReposGitClient.CreatePush(ctx, git.CreatePushArgs{
+		Push: &git.GitPush{
+			RefUpdates: &[]git.GitRefUpdate{{Name: vcsutils.PointerOf(branchRefPrefix + branch), OldObjectId: &headObjectID}},
+			Commits:    &[]git.GitCommitRef{commit},
+		},
+		RepositoryId: &repository,
+		Project:      &client.vcsInfo.Project,
+	})
+	return err
+}
+
+// mapCommitFileChangeToAzureReposChanges maps a file change to the changes of a push.
+// A moved file is deleted from the previous path and added in the new path, since a renamed file can't be edited in the same change.
+func mapCommitFileChangeToAzureReposChanges(change CommitFileChange) []interface{} {
+	changeTypes := git.VersionControlChangeTypeValues
+	switch change.Action {
+	case UpdateFile:
+		return []interface{}{createAzureReposGitChange(changeTypes.Edit, change.Path, change.Content)}
+	case DeleteFile:
+		return []interface{}{createAzureReposGitChange(changeTypes.Delete, change.Path, nil)}
+	case MoveFile:
+		return []interface{}{
+			createAzureReposGitChange(changeTypes.Delete, change.PreviousPath, nil),
+			createAzureReposGitChange(changeTypes.Add, change.Path, change.Content),
+		}
+	}
+	return []interface{}{createAzureReposGitChange(changeTypes.Add, change.Path, change.Content)}
+}
+
+func createAzureReposGitChange(changeType git.VersionControlChangeType, filePath string, content []byte) git.GitChange {
+	gitChange := git.GitChange{
+		ChangeType: &changeType,
+		Item:       git.GitItem{Path: vcsutils.PointerOf("/" + strings.TrimPrefix(filePath, "/"))},
+	}
+	if changeType != git.VersionControlChangeTypeValues.Delete {
+		gitChange.NewContent = &git.ItemContent{
+			Content:     vcsutils.PointerOf(encodeFileContent(content)),
+			ContentType: &git.ItemContentTypeValues.Base64Encoded,
+		}
+	}
+	return gitChange
+}
+
+// ListTags on Azure Repos
+func (client *AzureReposClient) ListTags(_, repository string) *Pager[TagInfo] {
+	err := validateParametersNotBlank(map[string]string{"repository": repository})
+	if err != nil {
+		return newErrorPager[TagInfo](err)
+	}
+	// The cursor of the pages is the continuation token
+	return newPager(func(ctx context.Context, continuationToken string) ([]TagInfo, string, error) {
+		azureReposGitClient, err := client.buildAzureReposClient(ctx)
+		if err != nil {
+			return nil, "", err
+		}
+		// Peeling resolves the commits of annotated tags
+		refs, err := azureReposGitClient.GetRefs(ctx, git.GetRefsArgs{
+			RepositoryId:      &repository,
+			Project:           &client.vcsInfo.Project,
+			Filter:            vcsutils.PointerOf("tags/"),
+			PeelTags:          vcsutils.PointerOf(true),
+			ContinuationToken: getAzureReposContinuationToken(continuationToken),
+		})
+		if err != nil {
+			return nil, "", err
+		}
+		results := make([]TagInfo, 0, len(refs.Value))
+		for _, ref := range refs.Value {
+			commitSHA := vcsutils.DefaultIfNotNil(ref.PeeledObjectId)
+			if commitSHA == "" {
+				commitSHA = vcsutils.DefaultIfNotNil(ref.ObjectId)
+			}
+			results = append(results, TagInfo{Name: strings.TrimPrefix(vcsutils.DefaultIfNotNil(ref.Name), tagRefPrefix), CommitSHA: commitSHA})
+		}
+		return results, refs.ContinuationToken, nil
+	})
+}
+
+// getAzureReposContinuationToken returns the continuation token of a page, which is nil for the first page
+func getAzureReposContinuationToken(continuationToken string) *string {
+	if continuationToken == "" {
+		return nil
+	}
+	return &continuationToken
+}
+
+// CreateTag on Azure Repos
+func (client *AzureReposClient) CreateTag(ctx context.Context, owner, repository, tag, fromRef, message string) error {
+	err := validateParametersNotBlank(map[string]string{"repository": repository, "tag": tag, "fromRef": fromRef})
+	if err != nil {
+		return err
+	}
+	sha, err := client.getCommitSHA(ctx, owner, repository, fromRef)
+	if err != nil {
+		return err
+	}
+	if message == "" {
+		return client.updateRef(ctx, repository, tagRefPrefix+tag, azureReposEmptyObjectID, sha)
+	}
+	azureReposGitClient, err := client.buildAzureReposClient(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = azureReposGitClient.CreateAnnotatedTag(ctx, git.CreateAnnotatedTagArgs{
+		TagObject: &git.GitAnnotatedTag{
+			Name:         &tag,
+			Message:      &message,
+			TaggedObject: &git.GitObject{ObjectId: &sha},
+		},
+		RepositoryId: &repository,
+		Project:      &client.vcsInfo.Project,
+	})
+	return err
+}
+
+// DeleteTag on Azure Repos
+func (client *AzureReposClient) DeleteTag(ctx context.Context, _, repository, tag string) error {
+	err := validateParametersNotBlank(map[string]string{"repository": repository, "tag": tag})
+	if err != nil {
+		return err
 	}
-	for _, branch := range *gitBranchStats {
-		branches = append(branches, *branch.Name)
+	objectID, err := client.getRefObjectID(ctx, repository, tagRefPrefix+tag)
+	if err != nil {
+		return err
 	}
-	return branches, nil
+	return client.updateRef(ctx, repository, tagRefPrefix+tag, objectID, azureReposEmptyObjectID)
+}
+
+// CreateRelease on Azure Repos, which doesn't support releases
+func (client *AzureReposClient) CreateRelease(_ context.Context, _, _ string, _ CreateReleaseOptions) (ReleaseInfo, error) {
+	return ReleaseInfo{}, &UnsupportedFeatureError{Provider: vcsutils.AzureRepos, Feature: "releases"}
+}
+
+// ListReleases on Azure Repos, which doesn't support releases
+func (client *AzureReposClient) ListReleases(_, _ string) *Pager[ReleaseInfo] {
+	return newErrorPager[ReleaseInfo](&UnsupportedFeatureError{Provider: vcsutils.AzureRepos, Feature: "releases"})
 }
 
 // DownloadRepository on Azure Repos
@@ -198,7 +544,37 @@ func (client *AzureReposClient) AddPullRequestComment(ctx context.Context, _, re
 }
 
 // ListPullRequestComments returns all the pull request threads with their comments.
-func (client *AzureReposClient) ListPullRequestComments(ctx context.Context, _, repository string, pullRequestID int) ([]CommentInfo, error) {
+// The threads are returned in a single response.
+func (client *AzureReposClient) ListPullRequestComments(_, repository string, pullRequestID int) *Pager[CommentInfo] {
+	return newSinglePagePager(func(ctx context.Context) ([]CommentInfo, error) {
+		threads, err := client.getPullRequestThreads(ctx, repository, pullRequestID)
+		if err != nil {
+			return nil, err
+		}
+		var commentInfo []CommentInfo
+		for _, thread := range threads {
+			var commentsAggregator strings.Builder
+			for _, comment := range *thread.Comments {
+				_, err = commentsAggregator.WriteString(
+					fmt.Sprintf("Author: %s, Id: %d, Content:%s\n",
+						*comment.Author.DisplayName,
+						*comment.Id,
+						*comment.Content))
+				if err != nil {
+					return nil, err
+				}
+			}
+			commentInfo = append(commentInfo, CommentInfo{
+				ID:      int64(*thread.Id),
+				Created: thread.PublishedDate.Time,
+				Content: commentsAggregator.String(),
+			})
+		}
+		return commentInfo, nil
+	})
+}
+
+func (client *AzureReposClient) getPullRequestThreads(ctx context.Context, repository string, pullRequestID int) ([]git.GitPullRequestCommentThread, error) {
 	azureReposGitClient, err := client.buildAzureReposClient(ctx)
 	if err != nil {
 		return nil, err
@@ -211,114 +587,565 @@ func (client *AzureReposClient) ListPullRequestComments(ctx context.Context, _,
 	if err != nil {
 		return nil, err
 	}
-	var commentInfo []CommentInfo
-	for _, thread := range *threads {
-		var commentsAggregator strings.Builder
-		for _, comment := range *thread.Comments {
-			_, err = commentsAggregator.WriteString(
-				fmt.Sprintf("Author: %s, Id: %d, Content:%s\n",
-					*comment.Author.DisplayName,
-					*comment.Id,
-					*comment.Content))
-			if err != nil {
-				return nil, err
-			}
-		}
-		commentInfo = append(commentInfo, CommentInfo{
-			ID:      int64(*thread.Id),
-			Created: thread.PublishedDate.Time,
-			Content: commentsAggregator.String(),
-		})
+	return vcsutils.DefaultIfNotNil(threads), nil
+}
+
+// EditPullRequestComment on Azure Repos.
+// The comment ID is the ID of the thread returned by ListPullRequestComments, and the first comment of the thread is edited.
+func (client *AzureReposClient) EditPullRequestComment(ctx context.Context, _, repository string, pullRequestID int, commentID int64, content string) error {
+	err := validateParametersNotBlank(map[string]string{"repository": repository, "content": content})
+	if err != nil {
+		return err
+	}
+	azureReposGitClient, err := client.buildAzureReposClient(ctx)
+	if err != nil {
+		return err
 	}
-	return commentInfo, nil
+	threadID := int(commentID)
+	_, err = azureReposGitClient.UpdateComment(ctx, git.UpdateCommentArgs{
+		Comment:       &git.Comment{Content: &content},
+		RepositoryId:  &repository,
+		PullRequestId: &pullRequestID,
+		ThreadId:      &threadID,
+		CommentId:     vcsutils.PointerOf(azureReposFirstThreadCommentID),
+		Project:       &client.vcsInfo.Project,
+	})
+	return err
 }
 
-// ListOpenPullRequests on Azure Repos
-func (client *AzureReposClient) ListOpenPullRequests(ctx context.Context, _, repository string) ([]PullRequestInfo, error) {
+// DeletePullRequestComment on Azure Repos.
+// The comment ID is the ID of the thread returned by ListPullRequestComments, and all the comments of the thread are deleted.
+func (client *AzureReposClient) DeletePullRequestComment(ctx context.Context, _, repository string, pullRequestID int, commentID int64) error {
+	err := validateParametersNotBlank(map[string]string{"repository": repository})
+	if err != nil {
+		return err
+	}
 	azureReposGitClient, err := client.buildAzureReposClient(ctx)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	client.logger.Debug("fetching open pull requests in", repository)
-	pullRequests, err := azureReposGitClient.GetPullRequests(ctx, git.GetPullRequestsArgs{
-		RepositoryId:   &repository,
-		Project:        &client.vcsInfo.Project,
-		SearchCriteria: &git.GitPullRequestSearchCriteria{Status: &git.PullRequestStatusValues.Active},
+	threadID := int(commentID)
+	thread, err := azureReposGitClient.GetPullRequestThread(ctx, git.GetPullRequestThreadArgs{
+		RepositoryId:  &repository,
+		PullRequestId: &pullRequestID,
+		ThreadId:      &threadID,
+		Project:       &client.vcsInfo.Project,
 	})
 	if err != nil {
-		return nil, err
+		return err
 	}
-	var pullRequestsInfo []PullRequestInfo
-	for _, pullRequest := range *pullRequests {
-		// Trim the branches prefix and get the actual branches name
-		shortSourceName := (*pullRequest.SourceRefName)[strings.LastIndex(*pullRequest.SourceRefName, "/")+1:]
-		shortTargetName := (*pullRequest.TargetRefName)[strings.LastIndex(*pullRequest.TargetRefName, "/")+1:]
-		pullRequestsInfo = append(pullRequestsInfo, PullRequestInfo{
-			ID: int64(*pullRequest.PullRequestId),
-			Source: BranchInfo{
-				Name:       shortSourceName,
-				Repository: repository,
-			},
-			Target: BranchInfo{
-				Name:       shortTargetName,
-				Repository: repository,
-			},
+	// A thread can't be deleted, so we delete its comments instead
+	for _, comment := range vcsutils.DefaultIfNotNil(thread.Comments) {
+		if vcsutils.DefaultIfNotNil(comment.IsDeleted) {
+			continue
+		}
+		err = azureReposGitClient.DeleteComment(ctx, git.DeleteCommentArgs{
+			RepositoryId:  &repository,
+			PullRequestId: &pullRequestID,
+			ThreadId:      &threadID,
+			CommentId:     comment.Id,
+			Project:       &client.vcsInfo.Project,
 		})
+		if err != nil {
+			return err
+		}
 	}
-	return pullRequestsInfo, nil
+	return nil
 }
 
-// GetLatestCommit on Azure Repos
-func (client *AzureReposClient) GetLatestCommit(ctx context.Context, _, repository, branch string) (CommitInfo, error) {
-	azureReposGitClient, err := client.buildAzureReposClient(ctx)
+// AddPullRequestReviewComments on Azure Repos
+func (client *AzureReposClient) AddPullRequestReviewComments(ctx context.Context, _, repository string, pullRequestID int, comments []ReviewComment) error {
+	err := validateParametersNotBlank(map[string]string{"repository": repository})
 	if err != nil {
-		return CommitInfo{}, err
+		return err
 	}
-	latestCommitInfo := CommitInfo{}
-	commits, err := azureReposGitClient.GetCommits(ctx, git.GetCommitsArgs{
-		RepositoryId:   &repository,
-		Project:        &client.vcsInfo.Project,
-		SearchCriteria: &git.GitQueryCommitsCriteria{ItemVersion: &git.GitVersionDescriptor{Version: &branch, VersionType: &git.GitVersionTypeValues.Branch}},
-	})
+	if err = validateReviewComments(comments); err != nil {
+		return err
+	}
+	azureReposGitClient, err := client.buildAzureReposClient(ctx)
 	if err != nil {
-		return latestCommitInfo, err
+		return err
 	}
-	if len(*commits) > 0 {
-		// The latest commit is the first in the list
-		latestCommit := (*commits)[0]
-		latestCommitInfo = CommitInfo{
-			Hash:          vcsutils.DefaultIfNotNil(latestCommit.CommitId),
-			AuthorName:    vcsutils.DefaultIfNotNil(latestCommit.Author.Name),
-			CommitterName: vcsutils.DefaultIfNotNil(latestCommit.Committer.Name),
-			Url:           vcsutils.DefaultIfNotNil(latestCommit.Url),
-			Timestamp:     latestCommit.Committer.Date.Time.Unix(),
-			Message:       vcsutils.DefaultIfNotNil(latestCommit.Comment),
-			ParentHashes:  vcsutils.DefaultIfNotNil(latestCommit.Parents),
+	// Each review comment is added inside a new thread, positioned on the file lines using the thread context.
+	for _, comment := range comments {
+		_, err = azureReposGitClient.CreateThread(ctx, git.CreateThreadArgs{
+			CommentThread: &git.GitPullRequestCommentThread{
+				Comments:      &[]git.Comment{{Content: vcsutils.PointerOf(comment.Content)}},
+				Status:        &git.CommentThreadStatusValues.Active,
+				ThreadContext: createAzureReposThreadContext(comment),
+			},
+			RepositoryId:  &repository,
+			PullRequestId: &pullRequestID,
+			Project:       &client.vcsInfo.Project,
+		})
+		if err != nil {
+			return err
 		}
 	}
-	return latestCommitInfo, nil
-}
-
-func getUnsupportedInAzureError(functionName string) error {
-	return fmt.Errorf("%s is currently not supported for Azure Repos", functionName)
-}
-
-// AddSshKeyToRepository on Azure Repos
-func (client *AzureReposClient) AddSshKeyToRepository(ctx context.Context, owner, repository, keyName, publicKey string, permission Permission) error {
-	return getUnsupportedInAzureError("add ssh key to repository")
+	return nil
 }
 
-// GetRepositoryInfo on Azure Repos
-func (client *AzureReposClient) GetRepositoryInfo(ctx context.Context, owner, repository string) (RepositoryInfo, error) {
-	return RepositoryInfo{}, getUnsupportedInAzureError("get repository info")
+// ListPullRequestReviewComments on Azure Repos. The threads are returned in a single response.
+func (client *AzureReposClient) ListPullRequestReviewComments(_, repository string, pullRequestID int) *Pager[ReviewCommentInfo] {
+	err := validateParametersNotBlank(map[string]string{"repository": repository})
+	if err != nil {
+		return newErrorPager[ReviewCommentInfo](err)
+	}
+	return newSinglePagePager(func(ctx context.Context) ([]ReviewCommentInfo, error) {
+		threads, err := client.getPullRequestThreads(ctx, repository, pullRequestID)
+		if err != nil {
+			return nil, err
+		}
+		var results []ReviewCommentInfo
+		for _, thread := range threads {
+			// Only the threads positioned on a file are review comments. The first comment of the thread is the review comment, the rest are replies.
+			if thread.ThreadContext == nil || thread.ThreadContext.FilePath == nil || len(vcsutils.DefaultIfNotNil(thread.Comments)) == 0 {
+				continue
+			}
+			results = append(results, mapAzureReposThreadToReviewCommentInfo(thread))
+		}
+		return results, nil
+	})
 }
 
-// GetCommitBySha on Azure Repos
-func (client *AzureReposClient) GetCommitBySha(ctx context.Context, owner, repository, sha string) (CommitInfo, error) {
-	return CommitInfo{}, getUnsupportedInAzureError("get commit by sha")
+// ListPullRequestFiles on Azure Repos. The files are returned in a single page, since they are listed together with their line diff blocks.
+func (client *AzureReposClient) ListPullRequestFiles(owner, repository string, pullRequestID int) *Pager[vcsutils.FileDiff] {
+	return newSinglePagePager(func(ctx context.Context) ([]vcsutils.FileDiff, error) {
+		fileDiffs, err := client.GetPullRequestDiff(ctx, owner, repository, pullRequestID)
+		if err != nil {
+			return nil, err
+		}
+		// The numbers of added and removed lines are available only from the line diff blocks
+		for i := range fileDiffs {
+			fileDiffs[i].Hunks = nil
+		}
+		return fileDiffs, nil
+	})
 }
 
-// CreateLabel on Azure Repos
+// GetPullRequestDiff on Azure Repos.
+// Azure Repos doesn't return the content of the changed lines, so the hunks contain only the line ranges.
+func (client *AzureReposClient) GetPullRequestDiff(ctx context.Context, _, repository string, pullRequestID int) ([]vcsutils.FileDiff, error) {
+	err := validateParametersNotBlank(map[string]string{"repository": repository})
+	if err != nil {
+		return nil, err
+	}
+	azureReposGitClient, err := client.buildAzureReposClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	iterations, err := azureReposGitClient.GetPullRequestIterations(ctx, git.GetPullRequestIterationsArgs{
+		RepositoryId:  &repository,
+		PullRequestId: &pullRequestID,
+		Project:       &client.vcsInfo.Project,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if iterations == nil || len(*iterations) == 0 {
+		return nil, nil
+	}
+	// The last iteration contains all the changes of the pull request, compared to the common commit with the target branch
+	lastIteration := (*iterations)[len(*iterations)-1]
+	fileDiffs, err := client.getPullRequestIterationChanges(ctx, azureReposGitClient, repository, pullRequestID, vcsutils.DefaultIfNotNil(lastIteration.Id))
+	if err != nil || len(fileDiffs) == 0 {
+		return fileDiffs, err
+	}
+	if lastIteration.CommonRefCommit == nil || lastIteration.SourceRefCommit == nil {
+		return fileDiffs, nil
+	}
+	err = client.addLineDiffBlocks(ctx, repository, vcsutils.DefaultIfNotNil(lastIteration.CommonRefCommit.CommitId), vcsutils.DefaultIfNotNil(lastIteration.SourceRefCommit.CommitId), fileDiffs)
+	return fileDiffs, err
+}
+
+func (client *AzureReposClient) getPullRequestIterationChanges(ctx context.Context, azureReposGitClient git.Client, repository string, pullRequestID, iterationID int) ([]vcsutils.FileDiff, error) {
+	var fileDiffs []vcsutils.FileDiff
+	for top, skip := 100, 0; top > 0; {
+		iterationChanges, err := azureReposGitClient.GetPullRequestIterationChanges(ctx, git.GetPullRequestIterationChangesArgs{
+			RepositoryId:  &repository,
+			PullRequestId: &pullRequestID,
+			IterationId:   &iterationID,
+			Project:       &client.vcsInfo.Project,
+			Top:           &top,
+			Skip:          &skip,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, change := range vcsutils.DefaultIfNotNil(iterationChanges.ChangeEntries) {
+			changedItem, err := remapFields[git.GitItem](change.Item, "json")
+			if err != nil {
+				return nil, err
+			}
+			if vcsutils.DefaultIfNotNil(changedItem.GitObjectType) == git.GitObjectTypeValues.Tree {
+				continue
+			}
+			fileDiffs = append(fileDiffs, mapAzureReposChangeToFileDiff(change, changedItem))
+		}
+		// Both values are zero when there are no more changes
+		top, skip = vcsutils.DefaultIfNotNil(iterationChanges.NextTop), vcsutils.DefaultIfNotNil(iterationChanges.NextSkip)
+	}
+	return fileDiffs, nil
+}
+
+// addLineDiffBlocks adds the hunks and the numbers of changed lines to the file diffs.
+// The file diffs API is not supported by the azure-devops-go-api client, so the request is sent directly.
+func (client *AzureReposClient) addLineDiffBlocks(ctx context.Context, repository, baseCommit, targetCommit string, fileDiffs []vcsutils.FileDiff) error {
+	var fileDiffParams []git.FileDiffParams
+	for _, fileDiff := range fileDiffs {
+		originalPath := fileDiff.Path
+		if fileDiff.PreviousPath != "" {
+			originalPath = fileDiff.PreviousPath
+		}
+		fileDiffParams = append(fileDiffParams, git.FileDiffParams{
+			OriginalPath: vcsutils.PointerOf("/" + originalPath),
+			Path:         vcsutils.PointerOf("/" + fileDiff.Path),
+		})
+	}
+	requestBody, err := json.Marshal(git.FileDiffsCriteria{
+		BaseVersionCommit:   &baseCommit,
+		TargetVersionCommit: &targetCommit,
+		FileDiffParams:      &fileDiffParams,
+	})
+	if err != nil {
+		return err
+	}
+	azureDevopsClient, err := client.connectionDetails.GetClientByResourceAreaId(ctx, git.ResourceAreaId)
+	if err != nil {
+		return err
+	}
+	routeValues := map[string]string{"project": client.vcsInfo.Project, "repositoryId": repository}
+	response, err := azureDevopsClient.Send(ctx, http.MethodPost, azureReposFileDiffsLocationID, "5.1-preview.1", routeValues, nil, bytes.NewReader(requestBody), "application/json", "application/json", nil)
+	if err != nil {
+		return err
+	}
+	var lineDiffs []git.FileDiff
+	if err = azureDevopsClient.UnmarshalCollectionBody(response, &lineDiffs); err != nil {
+		return err
+	}
+	lineDiffsByPath := make(map[string]git.FileDiff, len(lineDiffs))
+	for _, lineDiff := range lineDiffs {
+		lineDiffsByPath[strings.TrimPrefix(vcsutils.DefaultIfNotNil(lineDiff.Path), "/")] = lineDiff
+	}
+	for i := range fileDiffs {
+		lineDiff, exists := lineDiffsByPath[fileDiffs[i].Path]
+		if !exists {
+			continue
+		}
+		for _, block := range vcsutils.DefaultIfNotNil(lineDiff.LineDiffBlocks) {
+			if vcsutils.DefaultIfNotNil(block.ChangeType) == git.LineDiffBlockChangeTypeValues.None {
+				continue
+			}
+			hunk := vcsutils.DiffHunk{
+				OldStart: vcsutils.DefaultIfNotNil(block.OriginalLineNumberStart),
+				OldLines: vcsutils.DefaultIfNotNil(block.OriginalLinesCount),
+				NewStart: vcsutils.DefaultIfNotNil(block.ModifiedLineNumberStart),
+				NewLines: vcsutils.DefaultIfNotNil(block.ModifiedLinesCount),
+			}
+			fileDiffs[i].Additions += hunk.NewLines
+			fileDiffs[i].Deletions += hunk.OldLines
+			fileDiffs[i].Hunks = append(fileDiffs[i].Hunks, hunk)
+		}
+	}
+	return nil
+}
+
+// ListOpenPullRequests on Azure Repos
+func (client *AzureReposClient) ListOpenPullRequests(_, repository string) *Pager[PullRequestInfo] {
+	return newNumberedPager(0, func(ctx context.Context, page int) ([]PullRequestInfo, int, error) {
+		azureReposGitClient, err := client.buildAzureReposClient(ctx)
+		if err != nil {
+			return nil, 0, err
+		}
+		client.logger.Debug("fetching open pull requests in", repository)
+		pullRequests, err := azureReposGitClient.GetPullRequests(ctx, git.GetPullRequestsArgs{
+			RepositoryId:   &repository,
+			Project:        &client.vcsInfo.Project,
+			SearchCriteria: &git.GitPullRequestSearchCriteria{Status: &git.PullRequestStatusValues.Active},
+			Top:            vcsutils.PointerOf(azureReposPageSize),
+			Skip:           vcsutils.PointerOf(page * azureReposPageSize),
+		})
+		if err != nil {
+			return nil, 0, err
+		}
+		var pullRequestsInfo []PullRequestInfo
+		for _, pullRequest := range *pullRequests {
+			// Trim the branches prefix and get the actual branches name
+			shortSourceName := (*pullRequest.SourceRefName)[strings.LastIndex(*pullRequest.SourceRefName, "/")+1:]
+			shortTargetName := (*pullRequest.TargetRefName)[strings.LastIndex(*pullRequest.TargetRefName, "/")+1:]
+			pullRequestsInfo = append(pullRequestsInfo, PullRequestInfo{
+				ID: int64(*pullRequest.PullRequestId),
+				Source: BranchInfo{
+					Name:       shortSourceName,
+					Repository: repository,
+				},
+				Target: BranchInfo{
+					Name:       shortTargetName,
+					Repository: repository,
+				},
+			})
+		}
+		// A partial page is the last one
+		if len(pullRequestsInfo) < azureReposPageSize {
+			return pullRequestsInfo, 0, nil
+		}
+		return pullRequestsInfo, page + 1, nil
+	})
+}
+
+// GetLatestCommit on Azure Repos
+func (client *AzureReposClient) GetLatestCommit(ctx context.Context, _, repository, branch string) (CommitInfo, error) {
+	azureReposGitClient, err := client.buildAzureReposClient(ctx)
+	if err != nil {
+		return CommitInfo{}, err
+	}
+	latestCommitInfo := CommitInfo{}
+	commits, err := azureReposGitClient.GetCommits(ctx, git.GetCommitsArgs{
+		RepositoryId:   &repository,
+		Project:        &client.vcsInfo.Project,
+		SearchCriteria: &git.GitQueryCommitsCriteria{ItemVersion: &git.GitVersionDescriptor{Version: &branch, VersionType: &git.GitVersionTypeValues.Branch}},
+	})
+	if err != nil {
+		return latestCommitInfo, err
+	}
+	if len(*commits) > 0 {
+		// The latest commit is the first in the list
+		latestCommitInfo = mapAzureReposCommitToCommitInfo((*commits)[0])
+	}
+	return latestCommitInfo, nil
+}
+
+func getUnsupportedInAzureError(functionName string) error {
+	return fmt.Errorf("%s is currently not supported for Azure Repos", functionName)
+}
+
+// AddSshKeyToRepository on Azure Repos
+func (client *AzureReposClient) AddSshKeyToRepository(ctx context.Context, owner, repository, keyName, publicKey string, permission Permission) error {
+	return getUnsupportedInAzureError("add ssh key to repository")
+}
+
+// GetRepositoryInfo on Azure Repos. The repository is searched in the project of the client.
+func (client *AzureReposClient) GetRepositoryInfo(ctx context.Context, _, repository string) (RepositoryInfo, error) {
+	err := validateParametersNotBlank(map[string]string{"repository": repository})
+	if err != nil {
+		return RepositoryInfo{}, err
+	}
+	azureReposGitClient, err := client.buildAzureReposClient(ctx)
+	if err != nil {
+		return RepositoryInfo{}, err
+	}
+	repo, err := azureReposGitClient.GetRepository(ctx, git.GetRepositoryArgs{RepositoryId: &repository, Project: &client.vcsInfo.Project})
+	if err != nil {
+		return RepositoryInfo{}, err
+	}
+	return mapAzureReposRepositoryToRepositoryInfo(repo), nil
+}
+
+// CreateRepository on Azure Repos. The repository is created in the project of the client, and inherits its visibility.
+func (client *AzureReposClient) CreateRepository(ctx context.Context, _ string, options CreateRepositoryOptions) error {
+	err := validateParametersNotBlank(map[string]string{"repository name": options.Name})
+	if err != nil {
+		return err
+	}
+	azureReposGitClient, err := client.buildAzureReposClient(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = azureReposGitClient.CreateRepository(ctx, git.CreateRepositoryArgs{
+		GitRepositoryToCreate: &git.GitRepositoryCreateOptions{Name: &options.Name},
+		Project:               &client.vcsInfo.Project,
+	})
+	if err != nil || !options.InitReadme {
+		return err
+	}
+	// The first branch of the repository becomes its default branch
+	return client.pushChanges(ctx, options.Name, getInitialBranch(options), azureReposEmptyObjectID, initialCommitMessage,
+		[]CommitFileChange{createReadmeFileChange(options)}, CommitAuthor{})
+}
+
+// ForkRepository on Azure Repos. The target owner is the project to create the fork in, which is the project of the client by default.
+func (client *AzureReposClient) ForkRepository(ctx context.Context, _, repository, targetOwner string) error {
+	err := validateParametersNotBlank(map[string]string{"repository": repository})
+	if err != nil {
+		return err
+	}
+	azureReposGitClient, err := client.buildAzureReposClient(ctx)
+	if err != nil {
+		return err
+	}
+	parent, err := azureReposGitClient.GetRepository(ctx, git.GetRepositoryArgs{RepositoryId: &repository, Project: &client.vcsInfo.Project})
+	if err != nil {
+		return err
+	}
+	targetProject := targetOwner
+	if targetProject == "" {
+		targetProject = client.vcsInfo.Project
+	}
+	_, err = azureReposGitClient.CreateRepository(ctx, git.CreateRepositoryArgs{
+		GitRepositoryToCreate: &git.GitRepositoryCreateOptions{
+			Name:             parent.Name,
+			ParentRepository: &git.GitRepositoryRef{Id: parent.Id, Project: parent.Project},
+		},
+		Project: &targetProject,
+	})
+	return err
+}
+
+// ArchiveRepository on Azure Repos, which doesn't support archived repositories
+func (client *AzureReposClient) ArchiveRepository(_ context.Context, _, _ string) error {
+	return &UnsupportedFeatureError{Provider: vcsutils.AzureRepos, Feature: "archived repositories"}
+}
+
+// DeleteRepository on Azure Repos
+func (client *AzureReposClient) DeleteRepository(ctx context.Context, _, repository string) error {
+	err := validateParametersNotBlank(map[string]string{"repository": repository})
+	if err != nil {
+		return err
+	}
+	azureReposGitClient, err := client.buildAzureReposClient(ctx)
+	if err != nil {
+		return err
+	}
+	// The repository can be deleted only by its ID
+	repo, err := azureReposGitClient.GetRepository(ctx, git.GetRepositoryArgs{RepositoryId: &repository, Project: &client.vcsInfo.Project})
+	if err != nil {
+		return err
+	}
+	return azureReposGitClient.DeleteRepository(ctx, git.DeleteRepositoryArgs{RepositoryId: repo.Id, Project: &client.vcsInfo.Project})
+}
+
+// GetCommitBySha on Azure Repos
+func (client *AzureReposClient) GetCommitBySha(ctx context.Context, owner, repository, sha string) (CommitInfo, error) {
+	return CommitInfo{}, getUnsupportedInAzureError("get commit by sha")
+}
+
+// ListCommits on Azure Repos
+func (client *AzureReposClient) ListCommits(_, repository string, options ListCommitsOptions) *Pager[CommitInfo] {
+	err := validateParametersNotBlank(map[string]string{"repository": repository})
+	if err != nil {
+		return newErrorPager[CommitInfo](err)
+	}
+	pageSize := options.PageSize
+	if pageSize == 0 {
+		pageSize = azureReposPageSize
+	}
+	return newNumberedPager(0, func(ctx context.Context, page int) ([]CommitInfo, int, error) {
+		azureReposGitClient, err := client.buildAzureReposClient(ctx)
+		if err != nil {
+			return nil, 0, err
+		}
+		commits, err := azureReposGitClient.GetCommits(ctx, git.GetCommitsArgs{
+			RepositoryId:   &repository,
+			Project:        &client.vcsInfo.Project,
+			SearchCriteria: createAzureReposCommitsCriteria(options, pageSize, page*pageSize),
+		})
+		if err != nil {
+			return nil, 0, err
+		}
+		results := make([]CommitInfo, 0, len(vcsutils.DefaultIfNotNil(commits)))
+		for _, commit := range vcsutils.DefaultIfNotNil(commits) {
+			results = append(results, mapAzureReposCommitToCommitInfo(commit))
+		}
+		// A partial page is the last one
+		if len(results) < pageSize {
+			return results, 0, nil
+		}
+		return results, page + 1, nil
+	})
+}
+
+func mapAzureReposStatusToCommitStatusInfo(status git.GitStatus) CommitStatusInfo {
+	statusInfo := CommitStatusInfo{
+		State:       mapAzureReposCommitState(vcsutils.DefaultIfNotNil(status.State)),
+		Description: vcsutils.DefaultIfNotNil(status.Description),
+		DetailsURL:  vcsutils.DefaultIfNotNil(status.TargetUrl),
+	}
+	if status.Context != nil {
+		// The context of the status is identified by both its genre and its name
+		statusInfo.Context = vcsutils.DefaultIfNotNil(status.Context.Name)
+		if genre := vcsutils.DefaultIfNotNil(status.Context.Genre); genre != "" {
+			statusInfo.Context = genre + "/" + statusInfo.Context
+		}
+	}
+	if status.CreationDate != nil {
+		statusInfo.CreatedAt = status.CreationDate.Time.UTC()
+	}
+	if status.UpdatedDate != nil {
+		statusInfo.LastUpdatedAt = status.UpdatedDate.Time.UTC()
+	}
+	return statusInfo
+}
+
+// getCommitID returns the given ref if it is a commit SHA, or the latest commit of the given branch otherwise.
+// An empty ID is returned if the branch has no commits.
+func (client *AzureReposClient) getCommitID(ctx context.Context, repository, ref string) (string, error) {
+	if isCommitSHA(ref) {
+		return ref, nil
+	}
+	latestCommit, err := client.GetLatestCommit(ctx, "", repository, ref)
+	if err != nil {
+		return "", err
+	}
+	return latestCommit.Hash, nil
+}
+
+// getAzureReposCommitState maps a commit state to an Azure Repos status state. Skipped checks are not applicable to the commit.
+func getAzureReposCommitState(commitState CommitStatus) git.GitStatusState {
+	switch commitState {
+	case Pass:
+		return git.GitStatusStateValues.Succeeded
+	case Fail:
+		return git.GitStatusStateValues.Failed
+	case Error, Cancelled:
+		return git.GitStatusStateValues.Error
+	case InProgress:
+		return git.GitStatusStateValues.Pending
+	case Skipped:
+		return git.GitStatusStateValues.NotApplicable
+	}
+	return git.GitStatusStateValues.NotSet
+}
+
+// mapAzureReposCommitState maps the state of a status. Statuses which aren't applicable to the commit are skipped.
+func mapAzureReposCommitState(state git.GitStatusState) CommitStatus {
+	switch state {
+	case git.GitStatusStateValues.Succeeded:
+		return Pass
+	case git.GitStatusStateValues.NotApplicable:
+		return Skipped
+	case git.GitStatusStateValues.Failed:
+		return Fail
+	case git.GitStatusStateValues.Error:
+		return Error
+	}
+	return InProgress
+}
+
+func createAzureReposCommitsCriteria(options ListCommitsOptions, top, skip int) *git.GitQueryCommitsCriteria {
+	criteria := &git.GitQueryCommitsCriteria{Top: &top, Skip: &skip}
+	if options.Ref != "" {
+		versionType := &git.GitVersionTypeValues.Branch
+		if isCommitSHA(options.Ref) {
+			versionType = &git.GitVersionTypeValues.Commit
+		}
+		criteria.ItemVersion = &git.GitVersionDescriptor{Version: vcsutils.PointerOf(options.Ref), VersionType: versionType}
+	}
+	if options.Path != "" {
+		criteria.ItemPath = vcsutils.PointerOf(options.Path)
+	}
+	if options.Author != "" {
+		criteria.Author = vcsutils.PointerOf(options.Author)
+	}
+	if !options.Since.IsZero() {
+		criteria.FromDate = vcsutils.PointerOf(options.Since.UTC().Format(time.RFC3339))
+	}
+	if !options.Until.IsZero() {
+		criteria.ToDate = vcsutils.PointerOf(options.Until.UTC().Format(time.RFC3339))
+	}
+	return criteria
+}
+
+// CreateLabel on Azure Repos
 func (client *AzureReposClient) CreateLabel(ctx context.Context, owner, repository string, labelInfo LabelInfo) error {
 	return getUnsupportedInAzureError("create label")
 }
@@ -328,14 +1155,92 @@ func (client *AzureReposClient) GetLabel(ctx context.Context, owner, repository,
 	return nil, getUnsupportedInAzureError("get label")
 }
 
+// ListLabels on Azure Repos
+func (client *AzureReposClient) ListLabels(owner, repository string) *Pager[LabelInfo] {
+	return newErrorPager[LabelInfo](&UnsupportedFeatureError{Provider: vcsutils.AzureRepos, Feature: "repository labels"})
+}
+
+// UpdateLabel on Azure Repos
+func (client *AzureReposClient) UpdateLabel(ctx context.Context, owner, repository, name string, labelInfo LabelInfo) error {
+	return &UnsupportedFeatureError{Provider: vcsutils.AzureRepos, Feature: "repository labels"}
+}
+
+// DeleteLabel on Azure Repos
+func (client *AzureReposClient) DeleteLabel(ctx context.Context, owner, repository, name string) error {
+	return &UnsupportedFeatureError{Provider: vcsutils.AzureRepos, Feature: "repository labels"}
+}
+
+// AddPullRequestLabels on Azure Repos
+func (client *AzureReposClient) AddPullRequestLabels(ctx context.Context, _, repository string, pullRequestID int, labels ...string) error {
+	err := validateParametersNotBlank(map[string]string{"repository": repository})
+	if err != nil {
+		return err
+	}
+	if len(labels) == 0 {
+		return nil
+	}
+	azureReposGitClient, err := client.buildAzureReposClient(ctx)
+	if err != nil {
+		return err
+	}
+	// Pull request labels are tags, which are created on demand if they don't exist in the project
+	for _, label := range labels {
+		_, err = azureReposGitClient.CreatePullRequestLabel(ctx, git.CreatePullRequestLabelArgs{
+			Label:         &core.WebApiCreateTagRequestData{Name: vcsutils.PointerOf(label)},
+			RepositoryId:  &repository,
+			PullRequestId: &pullRequestID,
+			Project:       &client.vcsInfo.Project,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // ListPullRequestLabels on Azure Repos
-func (client *AzureReposClient) ListPullRequestLabels(ctx context.Context, owner, repository string, pullRequestID int) ([]string, error) {
-	return nil, getUnsupportedInAzureError("list pull request labels")
+func (client *AzureReposClient) ListPullRequestLabels(_, repository string, pullRequestID int) *Pager[string] {
+	err := validateParametersNotBlank(map[string]string{"repository": repository})
+	if err != nil {
+		return newErrorPager[string](err)
+	}
+	return newSinglePagePager(func(ctx context.Context) ([]string, error) {
+		azureReposGitClient, err := client.buildAzureReposClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+		labels, err := azureReposGitClient.GetPullRequestLabels(ctx, git.GetPullRequestLabelsArgs{
+			RepositoryId:  &repository,
+			PullRequestId: &pullRequestID,
+			Project:       &client.vcsInfo.Project,
+		})
+		if err != nil {
+			return nil, err
+		}
+		results := make([]string, 0, len(vcsutils.DefaultIfNotNil(labels)))
+		for _, label := range vcsutils.DefaultIfNotNil(labels) {
+			results = append(results, vcsutils.DefaultIfNotNil(label.Name))
+		}
+		return results, nil
+	})
 }
 
 // UnlabelPullRequest on Azure Repos
-func (client *AzureReposClient) UnlabelPullRequest(ctx context.Context, owner, repository, name string, pullRequestID int) error {
-	return getUnsupportedInAzureError("unlabel pull request")
+func (client *AzureReposClient) UnlabelPullRequest(ctx context.Context, _, repository, name string, pullRequestID int) error {
+	err := validateParametersNotBlank(map[string]string{"repository": repository, "name": name})
+	if err != nil {
+		return err
+	}
+	azureReposGitClient, err := client.buildAzureReposClient(ctx)
+	if err != nil {
+		return err
+	}
+	return azureReposGitClient.DeletePullRequestLabels(ctx, git.DeletePullRequestLabelsArgs{
+		RepositoryId:  &repository,
+		PullRequestId: &pullRequestID,
+		LabelIdOrName: &name,
+		Project:       &client.vcsInfo.Project,
+	})
 }
 
 // UploadCodeScanning on Azure Repos
@@ -358,9 +1263,135 @@ func (client *AzureReposClient) DeleteWebhook(ctx context.Context, owner, reposi
 	return getUnsupportedInAzureError("delete webhook")
 }
 
-// SetCommitStatus on Azure Repos
-func (client *AzureReposClient) SetCommitStatus(ctx context.Context, commitStatus CommitStatus, owner, repository, ref, title, description, detailsURL string) error {
-	return getUnsupportedInAzureError("set commit status")
+// ListWebhooks on Azure Repos
+func (client *AzureReposClient) ListWebhooks(owner, repository string) *Pager[WebhookInfo] {
+	return newErrorPager[WebhookInfo](&UnsupportedFeatureError{Provider: vcsutils.AzureRepos, Feature: "webhooks"})
+}
+
+// GetWebhook on Azure Repos
+func (client *AzureReposClient) GetWebhook(ctx context.Context, owner, repository, webhookID string) (WebhookInfo, error) {
+	return WebhookInfo{}, &UnsupportedFeatureError{Provider: vcsutils.AzureRepos, Feature: "webhooks"}
+}
+
+// PingWebhook on Azure Repos
+func (client *AzureReposClient) PingWebhook(ctx context.Context, owner, repository, webhookID string) error {
+	return &UnsupportedFeatureError{Provider: vcsutils.AzureRepos, Feature: "webhook test deliveries"}
+}
+
+// CreateOrganizationWebhook on Azure Repos
+func (client *AzureReposClient) CreateOrganizationWebhook(ctx context.Context, owner, payloadURL string, webhookEvents ...vcsutils.WebhookEvent) (string, string, error) {
+	return "", "", &UnsupportedFeatureError{Provider: vcsutils.AzureRepos, Feature: "organization webhooks"}
+}
+
+// UpdateOrganizationWebhook on Azure Repos
+func (client *AzureReposClient) UpdateOrganizationWebhook(ctx context.Context, owner, payloadURL, token, webhookID string, webhookEvents ...vcsutils.WebhookEvent) error {
+	return &UnsupportedFeatureError{Provider: vcsutils.AzureRepos, Feature: "organization webhooks"}
+}
+
+// DeleteOrganizationWebhook on Azure Repos
+func (client *AzureReposClient) DeleteOrganizationWebhook(ctx context.Context, owner, webhookID string) error {
+	return &UnsupportedFeatureError{Provider: vcsutils.AzureRepos, Feature: "organization webhooks"}
+}
+
+// ListOrganizationWebhooks on Azure Repos
+func (client *AzureReposClient) ListOrganizationWebhooks(owner string) *Pager[WebhookInfo] {
+	return newErrorPager[WebhookInfo](&UnsupportedFeatureError{Provider: vcsutils.AzureRepos, Feature: "organization webhooks"})
+}
+
+// SetCommitStatus on Azure Repos.
+// The statuses are reported on commits, and therefore a branch ref is resolved to its latest commit.
+// The key of the status is its context name, and the part of the key before its last '/' is the context genre.
+func (client *AzureReposClient) SetCommitStatus(ctx context.Context, _, repository, ref string, status CommitStatusOptions) error {
+	err := validateParametersNotBlank(map[string]string{"repository": repository, "ref": ref})
+	if err != nil {
+		return err
+	}
+	commitID, err := client.getCommitID(ctx, repository, ref)
+	if err != nil {
+		return err
+	}
+	if commitID == "" {
+		return fmt.Errorf("couldn't find a commit for ref %s in repository %s", ref, repository)
+	}
+	azureReposGitClient, err := client.buildAzureReposClient(ctx)
+	if err != nil {
+		return err
+	}
+	statusContext := &git.GitStatusContext{Name: &status.Key}
+	if separatorIndex := strings.LastIndex(status.Key, "/"); separatorIndex != -1 {
+		statusContext.Genre = vcsutils.PointerOf(status.Key[:separatorIndex])
+		statusContext.Name = vcsutils.PointerOf(status.Key[separatorIndex+1:])
+	}
+	_, err = azureReposGitClient.CreateCommitStatus(ctx, git.CreateCommitStatusArgs{
+		GitCommitStatusToCreate: &git.GitStatus{
+			Context:     statusContext,
+			Description: &status.Description,
+			State:       vcsutils.PointerOf(getAzureReposCommitState(status.State)),
+			TargetUrl:   &status.DetailsURL,
+		},
+		CommitId:     &commitID,
+		RepositoryId: &repository,
+		Project:      &client.vcsInfo.Project,
+	})
+	return err
+}
+
+// ListCommitStatuses on Azure Repos.
+// The statuses are reported on commits, and therefore a branch ref is resolved to its latest commit.
+func (client *AzureReposClient) ListCommitStatuses(_, repository, ref string) *Pager[CommitStatusInfo] {
+	err := validateParametersNotBlank(map[string]string{"repository": repository, "ref": ref})
+	if err != nil {
+		return newErrorPager[CommitStatusInfo](err)
+	}
+	commitID := ref
+	return newNumberedPager(0, func(ctx context.Context, page int) ([]CommitStatusInfo, int, error) {
+		if !isCommitSHA(commitID) {
+			latestCommitID, err := client.getCommitID(ctx, repository, ref)
+			if err != nil || latestCommitID == "" {
+				return nil, 0, err
+			}
+			commitID = latestCommitID
+		}
+		azureReposGitClient, err := client.buildAzureReposClient(ctx)
+		if err != nil {
+			return nil, 0, err
+		}
+		statuses, err := azureReposGitClient.GetStatuses(ctx, git.GetStatusesArgs{
+			CommitId:     &commitID,
+			RepositoryId: &repository,
+			Project:      &client.vcsInfo.Project,
+			Top:          vcsutils.PointerOf(azureReposPageSize),
+			Skip:         vcsutils.PointerOf(page * azureReposPageSize),
+			LatestOnly:   vcsutils.PointerOf(true),
+		})
+		if err != nil {
+			return nil, 0, err
+		}
+		results := make([]CommitStatusInfo, 0, len(vcsutils.DefaultIfNotNil(statuses)))
+		for _, status := range vcsutils.DefaultIfNotNil(statuses) {
+			results = append(results, mapAzureReposStatusToCommitStatusInfo(status))
+		}
+		// A partial page is the last one
+		if len(results) < azureReposPageSize {
+			return results, 0, nil
+		}
+		return results, page + 1, nil
+	})
+}
+
+// CreateCheckRun on Azure Repos
+func (client *AzureReposClient) CreateCheckRun(ctx context.Context, owner, repository string, checkRun CheckRunOptions) (string, error) {
+	return "", &UnsupportedFeatureError{Provider: vcsutils.AzureRepos, Feature: "check runs"}
+}
+
+// UpdateCheckRun on Azure Repos
+func (client *AzureReposClient) UpdateCheckRun(ctx context.Context, owner, repository, checkRunID string, checkRun CheckRunOptions) error {
+	return &UnsupportedFeatureError{Provider: vcsutils.AzureRepos, Feature: "check runs"}
+}
+
+// GetCombinedCommitStatus on Azure Repos
+func (client *AzureReposClient) GetCombinedCommitStatus(ctx context.Context, owner, repository, ref string) (CombinedCommitStatus, error) {
+	return getCombinedCommitStatus(ctx, client.ListCommitStatuses(owner, repository, ref))
 }
 
 // DownloadFileFromRepo on Azure Repos
@@ -373,7 +1404,16 @@ func (client *AzureReposClient) GetRepositoryEnvironmentInfo(ctx context.Context
 	return RepositoryEnvironmentInfo{}, getUnsupportedInAzureError("get repository environment info")
 }
 
-func (client *AzureReposClient) GetModifiedFiles(ctx context.Context, _, repository, refBefore, refAfter string) ([]string, error) {
+func (client *AzureReposClient) GetModifiedFiles(ctx context.Context, owner, repository, refBefore, refAfter string) ([]string, error) {
+	fileChanges, err := client.GetFileChanges(ctx, owner, repository, refBefore, refAfter)
+	if err != nil {
+		return nil, err
+	}
+	return getFileChangesPaths(fileChanges), nil
+}
+
+// GetFileChanges on Azure Repos
+func (client *AzureReposClient) GetFileChanges(ctx context.Context, _, repository, refBefore, refAfter string) ([]FileChange, error) {
 	if err := validateParametersNotBlank(map[string]string{
 		"repository": repository,
 		"refBefore":  refBefore,
@@ -387,7 +1427,7 @@ func (client *AzureReposClient) GetModifiedFiles(ctx context.Context, _, reposit
 		return nil, err
 	}
 
-	fileNamesSet := datastructures.MakeSet[string]()
+	var fileChanges []FileChange
 	changesToReturn := vcsutils.PointerOf(100)
 	changesToSkip := vcsutils.PointerOf(0)
 
@@ -430,13 +1470,108 @@ func (client *AzureReposClient) GetModifiedFiles(ctx context.Context, _, reposit
 
 			// Azure returns all paths with '/' prefix. Other providers doesn't, so let's
 			// remove the prefix here to produce output of the same format.
-			fileNamesSet.Add(strings.TrimPrefix(vcsutils.DefaultIfNotNil(changedItem.Path), "/"))
+			fileChange := FileChange{
+				Path:   strings.TrimPrefix(vcsutils.DefaultIfNotNil(changedItem.Path), "/"),
+				Status: getAzureReposFileStatus(vcsutils.DefaultIfNotNil(change.ChangeType)),
+			}
+			if fileChange.Status == vcsutils.FileRenamed {
+				fileChange.PreviousPath = strings.TrimPrefix(vcsutils.DefaultIfNotNil(change.OriginalPath), "/")
+			}
+			fileChanges = append(fileChanges, fileChange)
+		}
+	}
+	sortFileChanges(fileChanges)
+	return fileChanges, nil
+}
+
+// Azure Repos file paths start with '/'. A single line comment starts and ends on the same line.
+func createAzureReposThreadContext(comment ReviewComment) *git.CommentThreadContext {
+	startLine := comment.Line
+	if comment.StartLine > 0 && comment.StartLine < comment.Line {
+		startLine = comment.StartLine
+	}
+	start := &git.CommentPosition{Line: vcsutils.PointerOf(startLine), Offset: vcsutils.PointerOf(1)}
+	end := &git.CommentPosition{Line: vcsutils.PointerOf(comment.Line), Offset: vcsutils.PointerOf(1)}
+	threadContext := &git.CommentThreadContext{FilePath: vcsutils.PointerOf("/" + strings.TrimPrefix(comment.Path, "/"))}
+	if comment.Side == LeftSide {
+		threadContext.LeftFileStart, threadContext.LeftFileEnd = start, end
+	} else {
+		threadContext.RightFileStart, threadContext.RightFileEnd = start, end
+	}
+	return threadContext
+}
+
+func mapAzureReposRepositoryToRepositoryInfo(repo *git.GitRepository) RepositoryInfo {
+	repositoryInfo := RepositoryInfo{
+		// The repositories inherit the visibility of the project
+		RepositoryVisibility: Private,
+		CloneInfo:            CloneInfo{HTTP: vcsutils.DefaultIfNotNil(repo.RemoteUrl), SSH: vcsutils.DefaultIfNotNil(repo.SshUrl)},
+		DefaultBranch:        strings.TrimPrefix(vcsutils.DefaultIfNotNil(repo.DefaultBranch), branchRefPrefix),
+		WebURL:               vcsutils.DefaultIfNotNil(repo.WebUrl),
+		Fork:                 vcsutils.DefaultIfNotNil(repo.IsFork),
+		Size:                 int64(vcsutils.DefaultIfNotNil(repo.Size)),
+	}
+	if repo.Project != nil && vcsutils.DefaultIfNotNil(repo.Project.Visibility) == core.ProjectVisibilityValues.Public {
+		repositoryInfo.RepositoryVisibility = Public
+	}
+	if parent := repo.ParentRepository; parent != nil {
+		repositoryInfo.Parent = &RepositoryReference{Name: vcsutils.DefaultIfNotNil(parent.Name)}
+		if parent.Project != nil {
+			repositoryInfo.Parent.Owner = vcsutils.DefaultIfNotNil(parent.Project.Name)
 		}
 	}
-	_ = fileNamesSet.Remove("") // Make sure there are no blank filepath.
-	fileNamesList := fileNamesSet.ToSlice()
-	sort.Strings(fileNamesList)
-	return fileNamesList, nil
+	return repositoryInfo
+}
+
+func mapAzureReposChangeToFileDiff(change git.GitPullRequestChange, changedItem git.GitItem) vcsutils.FileDiff {
+	fileDiff := vcsutils.FileDiff{
+		Path:   strings.TrimPrefix(vcsutils.DefaultIfNotNil(changedItem.Path), "/"),
+		Status: getAzureReposFileStatus(vcsutils.DefaultIfNotNil(change.ChangeType)),
+	}
+	if fileDiff.Status == vcsutils.FileRenamed {
+		fileDiff.PreviousPath = strings.TrimPrefix(vcsutils.DefaultIfNotNil(change.OriginalPath), "/")
+	}
+	return fileDiff
+}
+
+// The change type is a comma separated list of changes, for example "edit, rename"
+func getAzureReposFileStatus(changeType git.VersionControlChangeType) vcsutils.FileStatus {
+	changes := string(changeType)
+	switch {
+	case strings.Contains(changes, string(git.VersionControlChangeTypeValues.Delete)):
+		return vcsutils.FileRemoved
+	case strings.Contains(changes, string(git.VersionControlChangeTypeValues.Add)):
+		return vcsutils.FileAdded
+	case strings.Contains(changes, string(git.VersionControlChangeTypeValues.Rename)):
+		return vcsutils.FileRenamed
+	default:
+		return vcsutils.FileModified
+	}
+}
+
+func mapAzureReposThreadToReviewCommentInfo(thread git.GitPullRequestCommentThread) ReviewCommentInfo {
+	threadContext := thread.ThreadContext
+	reviewComment := ReviewCommentInfo{
+		ID:      int64(vcsutils.DefaultIfNotNil(thread.Id)),
+		Content: vcsutils.DefaultIfNotNil((*thread.Comments)[0].Content),
+		Path:    strings.TrimPrefix(*threadContext.FilePath, "/"),
+		Side:    RightSide,
+	}
+	if thread.PublishedDate != nil {
+		reviewComment.Created = thread.PublishedDate.Time
+	}
+	start, end := threadContext.RightFileStart, threadContext.RightFileEnd
+	if end == nil && threadContext.LeftFileEnd != nil {
+		start, end = threadContext.LeftFileStart, threadContext.LeftFileEnd
+		reviewComment.Side = LeftSide
+	}
+	if end != nil {
+		reviewComment.Line = vcsutils.DefaultIfNotNil(end.Line)
+	}
+	if start != nil && vcsutils.DefaultIfNotNil(start.Line) < reviewComment.Line {
+		reviewComment.StartLine = vcsutils.DefaultIfNotNil(start.Line)
+	}
+	return reviewComment
 }
 
 // remapFields creates an instance of the T type and copies data from src parameter to it
@@ -453,3 +1588,20 @@ func remapFields[T any](src any, tagName string) (T, error) {
 	}
 	return dst, nil
 }
+
+func mapAzureReposCommitToCommitInfo(commit git.GitCommitRef) CommitInfo {
+	return CommitInfo{
+		Hash:           vcsutils.DefaultIfNotNil(commit.CommitId),
+		AuthorName:     vcsutils.DefaultIfNotNil(commit.Author.Name),
+		AuthorEmail:    vcsutils.DefaultIfNotNil(commit.Author.Email),
+		CommitterName:  vcsutils.DefaultIfNotNil(commit.Committer.Name),
+		CommitterEmail: vcsutils.DefaultIfNotNil(commit.Committer.Email),
+		Url:            vcsutils.DefaultIfNotNil(commit.Url),
+		WebURL:         vcsutils.DefaultIfNotNil(commit.RemoteUrl),
+		Timestamp:      commit.Committer.Date.Time.Unix(),
+		AuthorDate:     commit.Author.Date.Time.UTC(),
+		CommitDate:     commit.Committer.Date.Time.UTC(),
+		Message:        vcsutils.DefaultIfNotNil(commit.Comment),
+		ParentHashes:   vcsutils.DefaultIfNotNil(commit.Parents),
+	}
+}
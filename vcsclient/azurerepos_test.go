@@ -8,11 +8,14 @@ import (
 	"fmt"
 	"github.com/jfrog/froggit-go/vcsutils"
 	"github.com/microsoft/azure-devops-go-api/azuredevops"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/core"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/git"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/webapi"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"io"
 	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"reflect"
@@ -56,6 +59,30 @@ func TestAzureRepos_ListRepositories(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestAzureRepos_StreamRepositories(t *testing.T) {
+	ctx := context.Background()
+	response := []byte(`{"count":2,"value":[
+		{"name":"test_repo_1","defaultBranch":"refs/heads/main","project":{"visibility":"public"}},
+		{"name":"other_repo","project":{"visibility":"public"}}]}`)
+	client, cleanUp := createServerAndClient(t, vcsutils.AzureRepos, true, response, "listRepositories", createAzureReposHandler)
+	defer cleanUp()
+
+	repositories, err := collectRepositories(client.StreamRepositories(ctx, ListRepositoriesOptions{Owner: "froggit", Search: "test"}))
+	assert.NoError(t, err)
+	assert.Equal(t, []RepositoryListItem{
+		{Owner: "froggit", Name: "test_repo_1", RepositoryInfo: RepositoryInfo{RepositoryVisibility: Public, DefaultBranch: "main"}},
+	}, repositories)
+
+	_, err = collectRepositories(client.StreamRepositories(ctx, ListRepositoriesOptions{UpdatedSince: time.Now()}))
+	var unsupportedErr *UnsupportedFeatureError
+	assert.True(t, errors.As(err, &unsupportedErr))
+
+	badClient, badClientCleanup := createBadAzureReposClient(t, []byte{})
+	defer badClientCleanup()
+	_, err = collectRepositories(badClient.StreamRepositories(ctx, ListRepositoriesOptions{}))
+	assert.Error(t, err)
+}
+
 func TestAzureRepos_TestListBranches(t *testing.T) {
 	type ListBranchesResponse struct {
 		Value []git.GitBranchStats
@@ -71,16 +98,214 @@ func TestAzureRepos_TestListBranches(t *testing.T) {
 	ctx := context.Background()
 	client, cleanUp := createServerAndClient(t, vcsutils.AzureRepos, true, jsonRes, "listBranches", createAzureReposHandler)
 	defer cleanUp()
-	resp, err := client.ListBranches(ctx, "", repo1)
+	resp, err := client.ListBranches("", repo1).All(ctx)
 	assert.NoError(t, err)
 	assert.ElementsMatch(t, testBranches, resp)
 
 	badClient, badClientCleanup := createBadAzureReposClient(t, []byte{})
 	defer badClientCleanup()
-	_, err = badClient.ListBranches(ctx, "", repo1)
+	_, err = badClient.ListBranches("", repo1).All(ctx)
+	assert.Error(t, err)
+}
+
+func TestAzureRepos_TestCreateBranch(t *testing.T) {
+	ctx := context.Background()
+	response := []byte(`{"value":[{"name":"refs/heads/` + branch1 + `","success":true}],"count":1}`)
+	client, cleanUp := createServerAndClient(t, vcsutils.AzureRepos, true, response, "refs", createAzureReposHandler)
+	defer cleanUp()
+	err := client.CreateBranch(ctx, "", repo1, branch1, commitHash)
+	assert.NoError(t, err)
+
+	badClient, badClientCleanup := createBadAzureReposClient(t, []byte{})
+	defer badClientCleanup()
+	err = badClient.CreateBranch(ctx, "", repo1, branch1, commitHash)
+	assert.Error(t, err)
+}
+
+func TestAzureRepos_TestDeleteBranch(t *testing.T) {
+	ctx := context.Background()
+	refs := []byte(`{"value":[{"name":"refs/heads/` + branch1 + `-fix","objectId":"1"},{"name":"refs/heads/` + branch1 + `","objectId":"` + commitHash + `"}],"count":2}`)
+	updateResults := []byte(`{"value":[{"name":"refs/heads/` + branch1 + `","success":true}],"count":1}`)
+	getRefsHandler := createAzureReposHandler(t, "refs", refs, http.StatusOK)
+	updateRefsHandler := createAzureReposHandler(t, "refs", updateResults, http.StatusOK)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			body, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			assert.Contains(t, string(body), `"oldObjectId":"`+commitHash+`"`)
+			updateRefsHandler(w, r)
+			return
+		}
+		getRefsHandler(w, r)
+	}))
+	defer server.Close()
+	client := buildClient(t, vcsutils.AzureRepos, true, server)
+	err := client.DeleteBranch(ctx, "", repo1, branch1)
+	assert.NoError(t, err)
+
+	err = client.DeleteBranch(ctx, "", repo1, branch2)
+	assert.Error(t, err)
+}
+
+func TestAzureRepos_TestGetBranch(t *testing.T) {
+	ctx := context.Background()
+	branchStats := []byte(`{"name":"` + branch1 + `","commit":{"commitId":"` + commitHash + `"}}`)
+	repository := []byte(`{"id":"1ac6e5c8-4b0c-4f8c-9d1c-7b3a7f8e0b2a","name":"` + repo1 + `","defaultBranch":"refs/heads/` + branch1 + `"}`)
+	policies := []byte(`{"value":[{"id":1,"isEnabled":false},{"id":2,"isEnabled":true}],"count":2}`)
+	branchHandler := createAzureReposHandler(t, "listBranches", branchStats, http.StatusOK)
+	repositoryHandler := createAzureReposHandler(t, "listRepositories", repository, http.StatusOK)
+	policiesHandler := createAzureReposHandler(t, "policyConfigurations", policies, http.StatusOK)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.Contains(r.RequestURI, "listBranches"):
+			branchHandler(w, r)
+		case strings.Contains(r.RequestURI, "policyConfigurations"):
+			policiesHandler(w, r)
+		default:
+			repositoryHandler(w, r)
+		}
+	}))
+	defer server.Close()
+	// The policy configurations API requires the project name
+	client, err := NewClientBuilder(vcsutils.AzureRepos).ApiEndpoint(server.URL).Token(token).Project(project).Build()
+	assert.NoError(t, err)
+	branchDetails, err := client.GetBranch(ctx, "", repo1, branch1)
+	assert.NoError(t, err)
+	assert.Equal(t, BranchDetails{Name: branch1, CommitSHA: commitHash, Protected: true, Default: true}, branchDetails)
+
+	badClient, badClientCleanup := createBadAzureReposClient(t, []byte{})
+	defer badClientCleanup()
+	_, err = badClient.GetBranch(ctx, "", repo1, branch1)
+	assert.Error(t, err)
+}
+
+func TestAzureRepos_TestCommitFiles(t *testing.T) {
+	ctx := context.Background()
+	refs := []byte(`{"value":[{"name":"refs/heads/` + branch1 + `","objectId":"` + commitHash + `"}],"count":1}`)
+	refsHandler := createAzureReposHandler(t, "refs", refs, http.StatusOK)
+	pushHandler := createAzureReposHandler(t, "pushes", []byte(`{"pushId":1}`), http.StatusCreated)
+	var push git.GitPush
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&push))
+			pushHandler(w, r)
+			return
+		}
+		refsHandler(w, r)
+	}))
+	defer server.Close()
+	client := buildClient(t, vcsutils.AzureRepos, true, server)
+	err := client.CommitFiles(ctx, "", repo1, branch1, "Update files", []CommitFileChange{
+		{Action: UpdateFile, Path: "go.mod", Content: []byte("module example")},
+		{Action: DeleteFile, Path: "old.txt"},
+		{Action: MoveFile, Path: "new.txt", PreviousPath: "moved.txt", Content: []byte("moved")},
+	}, CommitAuthor{Name: "frogger", Email: "frogger@jfrog.com"})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "refs/heads/"+branch1, *(*push.RefUpdates)[0].Name)
+	assert.Equal(t, commitHash, *(*push.RefUpdates)[0].OldObjectId)
+	commit := (*push.Commits)[0]
+	assert.Equal(t, "Update files", *commit.Comment)
+	assert.Equal(t, "frogger@jfrog.com", *commit.Author.Email)
+	changes, err := json.Marshal(commit.Changes)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `[
+		{"changeType":"edit","item":{"path":"/go.mod"},"newContent":{"content":"bW9kdWxlIGV4YW1wbGU=","contentType":"base64Encoded"}},
+		{"changeType":"delete","item":{"path":"/old.txt"}},
+		{"changeType":"delete","item":{"path":"/moved.txt"}},
+		{"changeType":"add","item":{"path":"/new.txt"},"newContent":{"content":"bW92ZWQ=","contentType":"base64Encoded"}}]`,
+		string(changes))
+
+	badClient, badClientCleanup := createBadAzureReposClient(t, []byte{})
+	defer badClientCleanup()
+	err = badClient.CommitFiles(ctx, "", repo1, branch1, "Update files", []CommitFileChange{{Action: CreateFile, Path: "go.mod"}}, CommitAuthor{})
+	assert.Error(t, err)
+}
+
+func TestAzureRepos_TestListTags(t *testing.T) {
+	ctx := context.Background()
+	response := []byte(`{"value":[{"name":"refs/tags/v1.0.0","objectId":"` + commitHash + `"},` +
+		`{"name":"refs/tags/v1.1.0","objectId":"1","peeledObjectId":"2"}],"count":2}`)
+	client, cleanUp := createServerAndClient(t, vcsutils.AzureRepos, true, response, "refs", createAzureReposHandler)
+	defer cleanUp()
+	tags, err := client.ListTags("", repo1).All(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []TagInfo{{Name: "v1.0.0", CommitSHA: commitHash}, {Name: "v1.1.0", CommitSHA: "2"}}, tags)
+
+	badClient, badClientCleanup := createBadAzureReposClient(t, []byte{})
+	defer badClientCleanup()
+	_, err = badClient.ListTags("", repo1).All(ctx)
 	assert.Error(t, err)
 }
 
+func TestAzureRepos_TestCreateTag(t *testing.T) {
+	ctx := context.Background()
+	response := []byte(`{"value":[{"name":"refs/tags/v1.0.0","success":true}],"count":1}`)
+	client, cleanUp := createServerAndClient(t, vcsutils.AzureRepos, true, response, "refs", createAzureReposHandler)
+	defer cleanUp()
+	err := client.CreateTag(ctx, "", repo1, "v1.0.0", commitHash, "")
+	assert.NoError(t, err)
+
+	var annotatedTag git.GitAnnotatedTag
+	annotatedTagHandler := createAzureReposHandler(t, "annotatedtags", []byte(`{"name":"v1.0.0"}`), http.StatusCreated)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&annotatedTag))
+		}
+		annotatedTagHandler(w, r)
+	}))
+	defer server.Close()
+	// The annotated tags API requires the project name
+	client, err = NewClientBuilder(vcsutils.AzureRepos).ApiEndpoint(server.URL).Token(token).Project(project).Build()
+	assert.NoError(t, err)
+	err = client.CreateTag(ctx, "", repo1, "v1.0.0", commitHash, "Release 1.0.0")
+	assert.NoError(t, err)
+	assert.Equal(t, "v1.0.0", *annotatedTag.Name)
+	assert.Equal(t, "Release 1.0.0", *annotatedTag.Message)
+	assert.Equal(t, commitHash, *annotatedTag.TaggedObject.ObjectId)
+
+	badClient, badClientCleanup := createBadAzureReposClient(t, []byte{})
+	defer badClientCleanup()
+	err = badClient.CreateTag(ctx, "", repo1, "v1.0.0", commitHash, "")
+	assert.Error(t, err)
+}
+
+func TestAzureRepos_TestDeleteTag(t *testing.T) {
+	ctx := context.Background()
+	refs := []byte(`{"value":[{"name":"refs/tags/v1.0.0","objectId":"` + commitHash + `"}],"count":1}`)
+	updateResults := []byte(`{"value":[{"name":"refs/tags/v1.0.0","success":true}],"count":1}`)
+	getRefsHandler := createAzureReposHandler(t, "refs", refs, http.StatusOK)
+	updateRefsHandler := createAzureReposHandler(t, "refs", updateResults, http.StatusOK)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			body, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			assert.Contains(t, string(body), `"oldObjectId":"`+commitHash+`"`)
+			updateRefsHandler(w, r)
+			return
+		}
+		getRefsHandler(w, r)
+	}))
+	defer server.Close()
+	client := buildClient(t, vcsutils.AzureRepos, true, server)
+	err := client.DeleteTag(ctx, "", repo1, "v1.0.0")
+	assert.NoError(t, err)
+
+	err = client.DeleteTag(ctx, "", repo1, "v2.0.0")
+	assert.Error(t, err)
+}
+
+func TestAzureRepos_TestReleasesNotSupported(t *testing.T) {
+	ctx := context.Background()
+	client, cleanUp := createServerAndClient(t, vcsutils.AzureRepos, true, nil, "", createAzureReposHandler)
+	defer cleanUp()
+	var unsupportedErr *UnsupportedFeatureError
+	_, err := client.CreateRelease(ctx, "", repo1, CreateReleaseOptions{TagName: "v1.0.0"})
+	assert.True(t, errors.As(err, &unsupportedErr))
+	_, err = client.ListReleases("", repo1).All(ctx)
+	assert.True(t, errors.As(err, &unsupportedErr))
+}
+
 func TestAzureRepos_TestDownloadRepository(t *testing.T) {
 	ctx := context.Background()
 	dir, err := os.MkdirTemp("", "")
@@ -184,13 +409,13 @@ func TestAzureRepos_TestListOpenPullRequests(t *testing.T) {
 	ctx := context.Background()
 	client, cleanUp := createServerAndClient(t, vcsutils.AzureRepos, true, jsonRes, "getPullRequests", createAzureReposHandler)
 	defer cleanUp()
-	pullRequestsInfo, err := client.ListOpenPullRequests(ctx, "", repo1)
+	pullRequestsInfo, err := client.ListOpenPullRequests("", repo1).All(ctx)
 	assert.NoError(t, err)
 	assert.True(t, reflect.DeepEqual(pullRequestsInfo, []PullRequestInfo{{ID: 1, Source: BranchInfo{Name: branch1, Repository: repo1}, Target: BranchInfo{Name: branch2, Repository: repo1}}}))
 
 	badClient, cleanUp := createBadAzureReposClient(t, []byte{})
 	defer cleanUp()
-	_, err = badClient.ListOpenPullRequests(ctx, "", repo1)
+	_, err = badClient.ListOpenPullRequests("", repo1).All(ctx)
 	assert.Error(t, err)
 }
 
@@ -228,14 +453,216 @@ func TestListPullRequestComments(t *testing.T) {
 	ctx := context.Background()
 	client, cleanUp := createServerAndClient(t, vcsutils.AzureRepos, true, jsonRes, "pullRequestComments", createAzureReposHandler)
 	defer cleanUp()
-	commentInfo, err := client.ListPullRequestComments(ctx, "", repo1, id1)
+	commentInfo, err := client.ListPullRequestComments("", repo1, id1).All(ctx)
 	expected := "Author: test author, Id: 1, Content:first comment\nAuthor: test author, Id: 2, Content:second comment\n"
 	assert.Equal(t, expected, commentInfo[0].Content)
 	assert.NoError(t, err)
 
 	badClient, cleanUp := createBadAzureReposClient(t, []byte{})
 	defer cleanUp()
-	_, err = badClient.ListPullRequestComments(ctx, "", repo1, id1)
+	_, err = badClient.ListPullRequestComments("", repo1, id1).All(ctx)
+	assert.Error(t, err)
+}
+
+func TestAzureRepos_EditPullRequestComment(t *testing.T) {
+	commentID := 1
+	jsonRes, err := json.Marshal(git.Comment{Id: &commentID})
+	assert.NoError(t, err)
+	ctx := context.Background()
+	client, cleanUp := createServerAndClient(t, vcsutils.AzureRepos, true, jsonRes, "pullRequestThreadComments", createAzureReposHandler)
+	defer cleanUp()
+	err = client.EditPullRequestComment(ctx, "", repo1, 2, 123, "test")
+	assert.NoError(t, err)
+
+	badClient, cleanUp := createBadAzureReposClient(t, []byte{})
+	defer cleanUp()
+	err = badClient.EditPullRequestComment(ctx, "", repo1, 2, 123, "test")
+	assert.Error(t, err)
+}
+
+func TestAzureRepos_DeletePullRequestComment(t *testing.T) {
+	threadID := 123
+	firstCommentID, secondCommentID := 1, 2
+	jsonRes, err := json.Marshal(git.GitPullRequestCommentThread{
+		Id: &threadID,
+		Comments: &[]git.Comment{
+			{Id: &firstCommentID, Content: vcsutils.PointerOf("first comment")},
+			{Id: &secondCommentID, IsDeleted: vcsutils.PointerOf(true)},
+		},
+	})
+	assert.NoError(t, err)
+	ctx := context.Background()
+	threadHandler := createAzureReposHandler(t, "pullRequestComments", jsonRes, http.StatusOK)
+	commentHandler := createAzureReposHandler(t, "pullRequestThreadComments", nil, http.StatusOK)
+	deletedComments := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			deletedComments++
+			commentHandler(w, r)
+			return
+		}
+		threadHandler(w, r)
+	}))
+	defer server.Close()
+	client := buildClient(t, vcsutils.AzureRepos, true, server)
+	err = client.DeletePullRequestComment(ctx, "", repo1, 2, int64(threadID))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, deletedComments)
+
+	badClient, cleanUp := createBadAzureReposClient(t, []byte{})
+	defer cleanUp()
+	err = badClient.DeletePullRequestComment(ctx, "", repo1, 2, int64(threadID))
+	assert.Error(t, err)
+}
+
+func TestAzureRepos_AddPullRequestReviewComments(t *testing.T) {
+	id := 123
+	jsonRes, err := json.Marshal(git.GitPullRequestCommentThread{Id: &id})
+	assert.NoError(t, err)
+	ctx := context.Background()
+	client, cleanUp := createServerAndClient(t, vcsutils.AzureRepos, true, jsonRes, "pullRequestComments", createAzureReposHandler)
+	defer cleanUp()
+	comments := []ReviewComment{{Path: "file1.txt", Line: 5, StartLine: 3, Content: "test"}}
+	err = client.AddPullRequestReviewComments(ctx, "", repo1, 2, comments)
+	assert.NoError(t, err)
+
+	badClient, cleanUp := createBadAzureReposClient(t, []byte{})
+	defer cleanUp()
+	err = badClient.AddPullRequestReviewComments(ctx, "", repo1, 2, comments)
+	assert.Error(t, err)
+}
+
+func TestAzureRepos_ListPullRequestReviewComments(t *testing.T) {
+	type ListPullRequestReviewCommentsResponse struct {
+		Value []git.GitPullRequestCommentThread
+		Count int
+	}
+	id1 := 1
+	id2 := 2
+	created := time.Date(2022, 5, 16, 11, 4, 7, 0, time.UTC)
+	res := ListPullRequestReviewCommentsResponse{
+		Value: []git.GitPullRequestCommentThread{
+			{
+				Id:            &id1,
+				PublishedDate: &azuredevops.Time{Time: created},
+				Comments:      &[]git.Comment{{Id: &id1, Content: vcsutils.PointerOf("review comment")}},
+				ThreadContext: &git.CommentThreadContext{
+					FilePath:      vcsutils.PointerOf("/file1.txt"),
+					LeftFileStart: &git.CommentPosition{Line: vcsutils.PointerOf(3), Offset: vcsutils.PointerOf(1)},
+					LeftFileEnd:   &git.CommentPosition{Line: vcsutils.PointerOf(5), Offset: vcsutils.PointerOf(1)},
+				},
+			},
+			{
+				Id:            &id2,
+				PublishedDate: &azuredevops.Time{Time: created},
+				Comments:      &[]git.Comment{{Id: &id2, Content: vcsutils.PointerOf("general comment")}},
+			},
+		},
+		Count: 2,
+	}
+	jsonRes, err := json.Marshal(res)
+	assert.NoError(t, err)
+	ctx := context.Background()
+	client, cleanUp := createServerAndClient(t, vcsutils.AzureRepos, true, jsonRes, "pullRequestComments", createAzureReposHandler)
+	defer cleanUp()
+	reviewComments, err := client.ListPullRequestReviewComments("", repo1, id1).All(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []ReviewCommentInfo{{
+		ID:        1,
+		Content:   "review comment",
+		Created:   created,
+		Path:      "file1.txt",
+		Line:      5,
+		StartLine: 3,
+		Side:      LeftSide,
+	}}, reviewComments)
+
+	badClient, cleanUp := createBadAzureReposClient(t, []byte{})
+	defer cleanUp()
+	_, err = badClient.ListPullRequestReviewComments("", repo1, id1).All(ctx)
+	assert.Error(t, err)
+}
+
+func TestAzureRepos_GetPullRequestDiff(t *testing.T) {
+	type iterationsResponse struct {
+		Value []git.GitPullRequestIteration
+		Count int
+	}
+	type fileDiffsResponse struct {
+		Value []git.FileDiff
+		Count int
+	}
+	iterations, err := json.Marshal(iterationsResponse{
+		Value: []git.GitPullRequestIteration{
+			{Id: vcsutils.PointerOf(1)},
+			{
+				Id:              vcsutils.PointerOf(2),
+				CommonRefCommit: &git.GitCommitRef{CommitId: vcsutils.PointerOf("base-sha")},
+				SourceRefCommit: &git.GitCommitRef{CommitId: vcsutils.PointerOf("source-sha")},
+			},
+		},
+		Count: 2,
+	})
+	assert.NoError(t, err)
+	iterationChanges, err := json.Marshal(git.GitPullRequestIterationChanges{
+		ChangeEntries: &[]git.GitPullRequestChange{
+			{ChangeType: &git.VersionControlChangeTypeValues.Edit, Item: git.GitItem{Path: vcsutils.PointerOf("/src/main.go")}},
+			{
+				ChangeType:   vcsutils.PointerOf(git.VersionControlChangeType("edit, rename")),
+				Item:         git.GitItem{Path: vcsutils.PointerOf("/new.txt")},
+				OriginalPath: vcsutils.PointerOf("/old.txt"),
+			},
+			{ChangeType: &git.VersionControlChangeTypeValues.Add, Item: git.GitItem{Path: vcsutils.PointerOf("/src"), GitObjectType: &git.GitObjectTypeValues.Tree}},
+		},
+	})
+	assert.NoError(t, err)
+	fileDiffs, err := json.Marshal(fileDiffsResponse{
+		Value: []git.FileDiff{{
+			Path: vcsutils.PointerOf("/src/main.go"),
+			LineDiffBlocks: &[]git.LineDiffBlock{
+				{ChangeType: &git.LineDiffBlockChangeTypeValues.None, OriginalLineNumberStart: vcsutils.PointerOf(1), OriginalLinesCount: vcsutils.PointerOf(2), ModifiedLineNumberStart: vcsutils.PointerOf(1), ModifiedLinesCount: vcsutils.PointerOf(2)},
+				{ChangeType: &git.LineDiffBlockChangeTypeValues.Edit, OriginalLineNumberStart: vcsutils.PointerOf(3), OriginalLinesCount: vcsutils.PointerOf(1), ModifiedLineNumberStart: vcsutils.PointerOf(3), ModifiedLinesCount: vcsutils.PointerOf(2)},
+			},
+		}},
+		Count: 1,
+	})
+	assert.NoError(t, err)
+	ctx := context.Background()
+	iterationsHandler := createAzureReposHandler(t, "pullRequestIterations", iterations, http.StatusOK)
+	iterationChangesHandler := createAzureReposHandler(t, "pullRequestIterationChanges", iterationChanges, http.StatusOK)
+	fileDiffsHandler := createAzureReposHandler(t, "fileDiffs", fileDiffs, http.StatusOK)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.Contains(r.RequestURI, "pullRequestIterationChanges"):
+			assert.Contains(t, r.RequestURI, "%24top=100")
+			iterationChangesHandler(w, r)
+		case strings.Contains(r.RequestURI, "fileDiffs"):
+			assert.Equal(t, http.MethodPost, r.Method)
+			fileDiffsHandler(w, r)
+		default:
+			iterationsHandler(w, r)
+		}
+	}))
+	defer server.Close()
+	client := buildClient(t, vcsutils.AzureRepos, true, server)
+
+	result, err := client.GetPullRequestDiff(ctx, "", repo1, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []vcsutils.FileDiff{
+		{
+			Path: "src/main.go", Status: vcsutils.FileModified, Additions: 2, Deletions: 1,
+			Hunks: []vcsutils.DiffHunk{{OldStart: 3, OldLines: 1, NewStart: 3, NewLines: 2}},
+		},
+		{Path: "new.txt", PreviousPath: "old.txt", Status: vcsutils.FileRenamed},
+	}, result)
+
+	result, err = client.ListPullRequestFiles("", repo1, 1).All(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, vcsutils.FileDiff{Path: "src/main.go", Status: vcsutils.FileModified, Additions: 2, Deletions: 1}, result[0])
+
+	badClient, cleanUp := createBadAzureReposClient(t, []byte{})
+	defer cleanUp()
+	_, err = badClient.GetPullRequestDiff(ctx, "", repo1, 1)
 	assert.Error(t, err)
 }
 
@@ -249,12 +676,17 @@ func TestAzureRepos_TestGetLatestCommit(t *testing.T) {
 
 	commit, err := client.GetLatestCommit(ctx, "", repo1, branch1)
 	assert.Equal(t, commit, CommitInfo{
-		Hash:          "86d6919952702f9ab03bc95b45687f145a663de0",
-		AuthorName:    "Test User",
-		CommitterName: "Test User",
-		Url:           "https://dev.azure.com/testuser/0b8072c4-ad86-4edb-a8f2-06dbc07e3e2d/_apis/git/repositories/94c1dba8-d9d9-4600-94b4-1a51acb43220/commits/86d6919952702f9ab03bc95b45687f145a663de0",
-		Timestamp:     1667812601,
-		Message:       "Updated package.json",
+		Hash:           "86d6919952702f9ab03bc95b45687f145a663de0",
+		AuthorName:     "Test User",
+		AuthorEmail:    "testuser@jfrog.com",
+		CommitterName:  "Test User",
+		CommitterEmail: "testuser@jfrog.com",
+		Url:            "https://dev.azure.com/testuser/0b8072c4-ad86-4edb-a8f2-06dbc07e3e2d/_apis/git/repositories/94c1dba8-d9d9-4600-94b4-1a51acb43220/commits/86d6919952702f9ab03bc95b45687f145a663de0",
+		WebURL:         "https://dev.azure.com/testuser/test/_git/test/commit/86d6919952702f9ab03bc95b45687f145a663de0",
+		Timestamp:      1667812601,
+		AuthorDate:     time.Unix(1667812601, 0).UTC(),
+		CommitDate:     time.Unix(1667812601, 0).UTC(),
+		Message:        "Updated package.json",
 	})
 	assert.NoError(t, err)
 
@@ -264,6 +696,49 @@ func TestAzureRepos_TestGetLatestCommit(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestAzureRepos_ListCommits(t *testing.T) {
+	ctx := context.Background()
+	response, err := os.ReadFile(filepath.Join("testdata", "azurerepos", "commits.json"))
+	assert.NoError(t, err)
+
+	client, cleanUp := createServerAndClient(t, vcsutils.AzureRepos, true, response, "searchCriteria.author=Test+User", createAzureReposHandler)
+	defer cleanUp()
+
+	commits, err := client.ListCommits("", repo1, ListCommitsOptions{Ref: branch1, Author: "Test User"}).All(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, commits, 3)
+	assert.Equal(t, CommitInfo{
+		Hash:           "86d6919952702f9ab03bc95b45687f145a663de0",
+		AuthorName:     "Test User",
+		AuthorEmail:    "testuser@jfrog.com",
+		CommitterName:  "Test User",
+		CommitterEmail: "testuser@jfrog.com",
+		Url:            "https://dev.azure.com/testuser/0b8072c4-ad86-4edb-a8f2-06dbc07e3e2d/_apis/git/repositories/94c1dba8-d9d9-4600-94b4-1a51acb43220/commits/86d6919952702f9ab03bc95b45687f145a663de0",
+		WebURL:         "https://dev.azure.com/testuser/test/_git/test/commit/86d6919952702f9ab03bc95b45687f145a663de0",
+		Timestamp:      1667812601,
+		AuthorDate:     time.Unix(1667812601, 0).UTC(),
+		CommitDate:     time.Unix(1667812601, 0).UTC(),
+		Message:        "Updated package.json",
+	}, commits[0])
+
+	badClient, cleanUp := createBadAzureReposClient(t, []byte{})
+	defer cleanUp()
+	_, err = badClient.ListCommits("", repo1, ListCommitsOptions{}).All(ctx)
+	assert.Error(t, err)
+}
+
+func TestCreateAzureReposCommitsCriteria(t *testing.T) {
+	since := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	criteria := createAzureReposCommitsCriteria(ListCommitsOptions{Ref: "86d6919952702f9ab03bc95b45687f145a663de0", Path: "/src", Since: since}, 50, 100)
+	assert.Equal(t, &git.GitQueryCommitsCriteria{
+		Top:         vcsutils.PointerOf(50),
+		Skip:        vcsutils.PointerOf(100),
+		ItemVersion: &git.GitVersionDescriptor{Version: vcsutils.PointerOf("86d6919952702f9ab03bc95b45687f145a663de0"), VersionType: &git.GitVersionTypeValues.Commit},
+		ItemPath:    vcsutils.PointerOf("/src"),
+		FromDate:    vcsutils.PointerOf("2023-01-01T00:00:00Z"),
+	}, criteria)
+}
+
 func TestAzureReposClient_AddSshKeyToRepository(t *testing.T) {
 	ctx := context.Background()
 	client, cleanUp := createServerAndClient(t, vcsutils.AzureRepos, true, "", "getLatestCommit", createAzureReposHandler)
@@ -278,11 +753,152 @@ func TestAzureReposClient_CreateLabel(t *testing.T) {
 	assert.Error(t, client.CreateLabel(ctx, owner, repo1, LabelInfo{}))
 }
 
+func TestAzureReposClient_LabelsManagement(t *testing.T) {
+	ctx := context.Background()
+	client, cleanUp := createServerAndClient(t, vcsutils.AzureRepos, true, "", "unsupportedTest", createAzureReposHandler)
+	defer cleanUp()
+	var unsupportedErr *UnsupportedFeatureError
+	_, err := client.ListLabels(owner, repo1).All(ctx)
+	assert.True(t, errors.As(err, &unsupportedErr))
+	assert.True(t, errors.As(client.UpdateLabel(ctx, owner, repo1, labelName, LabelInfo{}), &unsupportedErr))
+	assert.True(t, errors.As(client.DeleteLabel(ctx, owner, repo1, labelName), &unsupportedErr))
+}
+
+func TestAzureReposClient_AddPullRequestLabels(t *testing.T) {
+	ctx := context.Background()
+	jsonRes, err := json.Marshal(core.WebApiTagDefinition{Name: &labelName})
+	assert.NoError(t, err)
+	client, cleanUp := createServerAndClient(t, vcsutils.AzureRepos, true, jsonRes, "pullRequestLabels", createAzureReposHandler)
+	defer cleanUp()
+	err = client.AddPullRequestLabels(ctx, owner, repo1, 1, labelName, "other-label")
+	assert.NoError(t, err)
+
+	badClient, cleanUp := createBadAzureReposClient(t, []byte{})
+	defer cleanUp()
+	err = badClient.AddPullRequestLabels(ctx, owner, repo1, 1, labelName)
+	assert.Error(t, err)
+}
+
 func TestAzureReposClient_GetRepositoryInfo(t *testing.T) {
+	ctx := context.Background()
+	response := []byte(`{
+		"name": "repo-1",
+		"defaultBranch": "refs/heads/main",
+		"isFork": true,
+		"size": 2048,
+		"remoteUrl": "https://dev.azure.com/jfrog/froggit/_git/repo-1",
+		"sshUrl": "git@ssh.dev.azure.com:v3/jfrog/froggit/repo-1",
+		"webUrl": "https://dev.azure.com/jfrog/froggit/_git/repo-1",
+		"project": {"name": "froggit", "visibility": "public"},
+		"parentRepository": {"name": "upstream", "project": {"name": "frogs"}}
+	}`)
+	client, cleanUp := createServerAndClient(t, vcsutils.AzureRepos, true, response, "listRepositories", createAzureReposHandler)
+	defer cleanUp()
+
+	info, err := client.GetRepositoryInfo(ctx, owner, repo1)
+	require.NoError(t, err)
+	assert.Equal(t, RepositoryInfo{
+		RepositoryVisibility: Public,
+		CloneInfo: CloneInfo{
+			HTTP: "https://dev.azure.com/jfrog/froggit/_git/repo-1",
+			SSH:  "git@ssh.dev.azure.com:v3/jfrog/froggit/repo-1",
+		},
+		DefaultBranch: "main",
+		WebURL:        "https://dev.azure.com/jfrog/froggit/_git/repo-1",
+		Fork:          true,
+		Parent:        &RepositoryReference{Owner: "frogs", Name: "upstream"},
+		Size:          2048,
+	}, info)
+
+	badClient, badClientCleanup := createBadAzureReposClient(t, []byte{})
+	defer badClientCleanup()
+	_, err = badClient.GetRepositoryInfo(ctx, owner, repo1)
+	assert.Error(t, err)
+}
+
+func TestAzureReposClient_CreateRepository(t *testing.T) {
+	ctx := context.Background()
+	repositoryHandler := createAzureReposHandler(t, "listRepositories", []byte(`{"name":"new-repo"}`), http.StatusCreated)
+	pushHandler := createAzureReposHandler(t, "pushes", []byte(`{"pushId":1}`), http.StatusCreated)
+	var createOptions git.GitRepositoryCreateOptions
+	var push git.GitPush
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && strings.Contains(r.RequestURI, "pushes"):
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&push))
+			pushHandler(w, r)
+		case r.Method == http.MethodPost:
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&createOptions))
+			repositoryHandler(w, r)
+		default:
+			repositoryHandler(w, r)
+		}
+	}))
+	defer server.Close()
+	client := buildClient(t, vcsutils.AzureRepos, true, server)
+
+	err := client.CreateRepository(ctx, "", CreateRepositoryOptions{Name: "new-repo", InitReadme: true})
+	assert.NoError(t, err)
+	assert.Equal(t, "new-repo", *createOptions.Name)
+	// The README file is pushed to a new branch
+	assert.Equal(t, "refs/heads/main", *(*push.RefUpdates)[0].Name)
+	assert.Equal(t, azureReposEmptyObjectID, *(*push.RefUpdates)[0].OldObjectId)
+
+	badClient, badClientCleanup := createBadAzureReposClient(t, []byte{})
+	defer badClientCleanup()
+	err = badClient.CreateRepository(ctx, "", CreateRepositoryOptions{Name: "new-repo"})
+	assert.Error(t, err)
+}
+
+func TestAzureReposClient_ForkRepository(t *testing.T) {
+	ctx := context.Background()
+	parentID := "1ac6e5c8-4b0c-4f8c-9d1c-7b3a7f8e0b2a"
+	repository := []byte(`{"id":"` + parentID + `","name":"` + repo1 + `","project":{"name":"` + project + `"}}`)
+	repositoryHandler := createAzureReposHandler(t, "listRepositories", repository, http.StatusOK)
+	var createOptions git.GitRepositoryCreateOptions
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&createOptions))
+		}
+		repositoryHandler(w, r)
+	}))
+	defer server.Close()
+	client := buildClient(t, vcsutils.AzureRepos, true, server)
+
+	err := client.ForkRepository(ctx, "", repo1, "other-project")
+	assert.NoError(t, err)
+	assert.Equal(t, repo1, *createOptions.Name)
+	assert.Equal(t, parentID, createOptions.ParentRepository.Id.String())
+}
+
+func TestAzureReposClient_ArchiveRepository(t *testing.T) {
 	ctx := context.Background()
 	client, cleanUp := createServerAndClient(t, vcsutils.AzureRepos, true, "", "unsupportedTest", createAzureReposHandler)
 	defer cleanUp()
-	_, err := client.GetRepositoryInfo(ctx, owner, repo1)
+	var unsupportedErr *UnsupportedFeatureError
+	err := client.ArchiveRepository(ctx, "", repo1)
+	assert.True(t, errors.As(err, &unsupportedErr))
+}
+
+func TestAzureReposClient_DeleteRepository(t *testing.T) {
+	ctx := context.Background()
+	repositoryID := "1ac6e5c8-4b0c-4f8c-9d1c-7b3a7f8e0b2a"
+	repositoryHandler := createAzureReposHandler(t, "listRepositories", []byte(`{"id":"`+repositoryID+`","name":"`+repo1+`"}`), http.StatusOK)
+	deleted := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		deleted = deleted || r.Method == http.MethodDelete
+		repositoryHandler(w, r)
+	}))
+	defer server.Close()
+	client := buildClient(t, vcsutils.AzureRepos, true, server)
+
+	err := client.DeleteRepository(ctx, "", repo1)
+	assert.NoError(t, err)
+	assert.True(t, deleted)
+
+	badClient, badClientCleanup := createBadAzureReposClient(t, []byte{})
+	defer badClientCleanup()
+	err = badClient.DeleteRepository(ctx, "", repo1)
 	assert.Error(t, err)
 }
 
@@ -296,17 +912,33 @@ func TestAzureReposClient_GetCommitBySha(t *testing.T) {
 
 func TestAzureReposClient_ListPullRequestLabels(t *testing.T) {
 	ctx := context.Background()
-	client, cleanUp := createServerAndClient(t, vcsutils.AzureRepos, true, "", "unsupportedTest", createAzureReposHandler)
+	jsonRes, err := json.Marshal(map[string]interface{}{
+		"count": 2,
+		"value": []core.WebApiTagDefinition{{Name: &labelName}, {Name: vcsutils.PointerOf("other-label")}},
+	})
+	require.NoError(t, err)
+	client, cleanUp := createServerAndClient(t, vcsutils.AzureRepos, true, jsonRes, "pullRequestLabels", createAzureReposHandler)
+	defer cleanUp()
+	labels, err := client.ListPullRequestLabels(owner, repo1, 1).All(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{labelName, "other-label"}, labels)
+
+	badClient, cleanUp := createBadAzureReposClient(t, []byte{})
 	defer cleanUp()
-	_, err := client.ListPullRequestLabels(ctx, owner, repo1, 1)
+	_, err = badClient.ListPullRequestLabels(owner, repo1, 1).All(ctx)
 	assert.Error(t, err)
 }
 
 func TestAzureReposClient_UnlabelPullRequest(t *testing.T) {
 	ctx := context.Background()
-	client, cleanUp := createServerAndClient(t, vcsutils.AzureRepos, true, "", "unsupportedTest", createAzureReposHandler)
+	client, cleanUp := createServerAndClient(t, vcsutils.AzureRepos, true, "", "pullRequestLabels", createAzureReposHandler)
 	defer cleanUp()
-	err := client.UnlabelPullRequest(ctx, owner, repo1, "", 1)
+	err := client.UnlabelPullRequest(ctx, owner, repo1, labelName, 1)
+	assert.NoError(t, err)
+
+	badClient, cleanUp := createBadAzureReposClient(t, []byte{})
+	defer cleanUp()
+	err = badClient.UnlabelPullRequest(ctx, owner, repo1, labelName, 1)
 	assert.Error(t, err)
 }
 
@@ -350,14 +982,113 @@ func TestAzureReposClient_DeleteWebhook(t *testing.T) {
 	assert.Error(t, err)
 }
 
-func TestAzureReposClient_SetCommitStatus(t *testing.T) {
+func TestAzureReposClient_ListWebhooks(t *testing.T) {
 	ctx := context.Background()
 	client, cleanUp := createServerAndClient(t, vcsutils.AzureRepos, true, "", "unsupportedTest", createAzureReposHandler)
 	defer cleanUp()
-	err := client.SetCommitStatus(ctx, 1, owner, repo1, "", "", "", "")
+	var unsupportedErr *UnsupportedFeatureError
+	_, err := client.ListWebhooks(owner, repo1).All(ctx)
+	assert.True(t, errors.As(err, &unsupportedErr))
+	_, err = client.GetWebhook(ctx, owner, repo1, "1")
+	assert.True(t, errors.As(err, &unsupportedErr))
+	err = client.PingWebhook(ctx, owner, repo1, "1")
+	assert.True(t, errors.As(err, &unsupportedErr))
+}
+
+func TestAzureReposClient_OrganizationWebhooks(t *testing.T) {
+	ctx := context.Background()
+	client, cleanUp := createServerAndClient(t, vcsutils.AzureRepos, true, "", "unsupportedTest", createAzureReposHandler)
+	defer cleanUp()
+	var unsupportedErr *UnsupportedFeatureError
+	_, _, err := client.CreateOrganizationWebhook(ctx, owner, "https://jfrog.com/hook", vcsutils.Push)
+	assert.True(t, errors.As(err, &unsupportedErr))
+	err = client.UpdateOrganizationWebhook(ctx, owner, "https://jfrog.com/hook", "", "1", vcsutils.Push)
+	assert.True(t, errors.As(err, &unsupportedErr))
+	err = client.DeleteOrganizationWebhook(ctx, owner, "1")
+	assert.True(t, errors.As(err, &unsupportedErr))
+	_, err = client.ListOrganizationWebhooks(owner).All(ctx)
+	assert.True(t, errors.As(err, &unsupportedErr))
+}
+
+func TestAzureReposClient_SetCommitStatus(t *testing.T) {
+	ctx := context.Background()
+	ref := "86d6919952702f9ab03bc95b45687f145a663de0"
+	expectedStatus := git.GitStatus{
+		Context:     &git.GitStatusContext{Genre: vcsutils.PointerOf("ci"), Name: vcsutils.PointerOf("build")},
+		Description: vcsutils.PointerOf("Build failed"),
+		State:       &git.GitStatusStateValues.Failed,
+		TargetUrl:   vcsutils.PointerOf("https://ci.example.com/build/1"),
+	}
+	createHandler := func(t *testing.T, expectedURI string, response []byte, expectedStatusCode int) http.HandlerFunc {
+		azureReposHandler := createAzureReposHandler(t, expectedURI, response, expectedStatusCode)
+		return func(w http.ResponseWriter, r *http.Request) {
+			if strings.Contains(r.RequestURI, expectedURI) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				var status git.GitStatus
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&status))
+				assert.Equal(t, expectedStatus, status)
+			}
+			azureReposHandler(w, r)
+		}
+	}
+	client, cleanUp := createServerAndClient(t, vcsutils.AzureRepos, true, expectedStatus, "listCommitStatuses", createHandler)
+	defer cleanUp()
+	err := client.SetCommitStatus(ctx, owner, repo1, ref, CommitStatusOptions{
+		State:       Fail,
+		Key:         "ci/build",
+		Description: "Build failed",
+		DetailsURL:  "https://ci.example.com/build/1",
+	})
+	assert.NoError(t, err)
+
+	err = client.SetCommitStatus(ctx, owner, repo1, "", CommitStatusOptions{State: Fail})
+	assert.Error(t, err)
+
+	badClient, cleanUp := createBadAzureReposClient(t, []byte{})
+	defer cleanUp()
+	err = badClient.SetCommitStatus(ctx, owner, repo1, ref, CommitStatusOptions{State: Fail})
 	assert.Error(t, err)
 }
 
+func TestAzureReposClient_ListCommitStatuses(t *testing.T) {
+	ctx := context.Background()
+	ref := "86d6919952702f9ab03bc95b45687f145a663de0"
+	response := []byte(`{"count":2,"value":[
+		{"state":"succeeded","description":"Build passed","context":{"name":"build","genre":"ci"},"targetUrl":"https://ci.example.com/build/1",
+		"creationDate":"2023-01-01T10:00:00Z","updatedDate":"2023-01-01T10:01:00Z"},
+		{"state":"pending","context":{"name":"scan"},"creationDate":"2023-01-01T10:00:00Z","updatedDate":"2023-01-01T10:00:00Z"}]}`)
+	client, cleanUp := createServerAndClient(t, vcsutils.AzureRepos, true, response, "listCommitStatuses", createAzureReposHandler)
+	defer cleanUp()
+
+	statuses, err := client.ListCommitStatuses(owner, repo1, ref).All(ctx)
+	require.NoError(t, err)
+	createdAt := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+	assert.Equal(t, []CommitStatusInfo{
+		{State: Pass, Context: "ci/build", Description: "Build passed", DetailsURL: "https://ci.example.com/build/1", CreatedAt: createdAt, LastUpdatedAt: createdAt.Add(time.Minute)},
+		{State: InProgress, Context: "scan", CreatedAt: createdAt, LastUpdatedAt: createdAt},
+	}, statuses)
+
+	combined, err := client.GetCombinedCommitStatus(ctx, owner, repo1, ref)
+	require.NoError(t, err)
+	assert.Equal(t, InProgress, combined.State)
+
+	badClient, cleanUp := createBadAzureReposClient(t, []byte{})
+	defer cleanUp()
+	_, err = badClient.ListCommitStatuses(owner, repo1, ref).All(ctx)
+	assert.Error(t, err)
+}
+
+func TestAzureReposClient_CheckRun(t *testing.T) {
+	ctx := context.Background()
+	client, cleanUp := createServerAndClient(t, vcsutils.AzureRepos, true, "", "unsupportedTest", createAzureReposHandler)
+	defer cleanUp()
+	var unsupportedErr *UnsupportedFeatureError
+	_, err := client.CreateCheckRun(ctx, owner, repo1, CheckRunOptions{})
+	assert.True(t, errors.As(err, &unsupportedErr))
+	err = client.UpdateCheckRun(ctx, owner, repo1, "", CheckRunOptions{})
+	assert.True(t, errors.As(err, &unsupportedErr))
+}
+
 func TestAzureReposClient_GetLabel(t *testing.T) {
 	ctx := context.Background()
 	client, cleanUp := createServerAndClient(t, vcsutils.AzureRepos, true, "", "unsupportedTest", createAzureReposHandler)
@@ -443,6 +1174,21 @@ func TestAzureReposClient_GetModifiedFiles(t *testing.T) {
 	})
 }
 
+func TestAzureReposClient_GetFileChanges(t *testing.T) {
+	ctx := context.Background()
+	response, err := os.ReadFile(filepath.Join("testdata", "azurerepos", "compare_commits.json"))
+	require.NoError(t, err)
+	const expectedURI = "/_apis/ResourceAreas?%24skip=0&%24top=100&baseVersion=sha-1&diffCommonCommit=true&targetVersion=sha-2"
+	client, cleanUp := createServerAndClient(t, vcsutils.AzureRepos, true, response, expectedURI, createAzureReposHandler)
+	defer cleanUp()
+
+	fileChanges, err := client.GetFileChanges(ctx, "", repo1, "sha-1", "sha-2")
+	require.NoError(t, err)
+	require.Len(t, fileChanges, 19)
+	assert.Equal(t, FileChange{Path: "CustomerAddressModule/CustomerAddressModule.sln", Status: vcsutils.FileAdded}, fileChanges[0])
+	assert.Equal(t, FileChange{Path: "MyWebSite/MyWebSite/Web.config", Status: vcsutils.FileModified}, fileChanges[18])
+}
+
 func createAzureReposHandler(t *testing.T, expectedURI string, response []byte, expectedStatusCode int) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		base64Token := base64.StdEncoding.EncodeToString([]byte(":" + token))
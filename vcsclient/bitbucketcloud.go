@@ -5,15 +5,15 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"github.com/jfrog/gofrog/datastructures"
+	"io"
+	"mime/multipart"
 	"net/http"
 	"net/url"
-	"sort"
+	"path"
+	"strconv"
 	"strings"
 	"time"
 
-	"github.com/mitchellh/mapstructure"
-
 	"github.com/jfrog/froggit-go/vcsutils"
 	"github.com/ktrysmt/go-bitbucket"
 )
@@ -65,30 +65,272 @@ func (client *BitbucketCloudClient) ListRepositories(ctx context.Context) (map[s
 		return nil, err
 	}
 	for _, workspace := range workspaces.Workspaces {
-		repositoriesRes, err := bitbucketClient.Repositories.ListForAccount(&bitbucket.RepositoriesOptions{Owner: workspace.Slug})
+		repositories, err := client.listRepositories("/repositories/"+workspace.Slug, url.Values{}).All(ctx)
 		if err != nil {
 			return nil, err
 		}
-		for _, repo := range repositoriesRes.Items {
-			results[workspace.Slug] = append(results[workspace.Slug], repo.Slug)
+		for _, repo := range repositories {
+			results[workspace.Slug] = append(results[workspace.Slug], repo.Name)
 		}
 	}
 	return results, nil
 }
 
 // ListBranches on Bitbucket cloud
-func (client *BitbucketCloudClient) ListBranches(ctx context.Context, owner, repository string) ([]string, error) {
+func (client *BitbucketCloudClient) ListBranches(owner, repository string) *Pager[string] {
+	return newNumberedPager(1, func(ctx context.Context, page int) ([]string, int, error) {
+		bitbucketClient := client.buildBitbucketCloudClient(ctx)
+		branches, err := bitbucketClient.Repositories.Repository.ListBranches(&bitbucket.RepositoryBranchOptions{
+			Owner:    owner,
+			RepoSlug: repository,
+			PageNum:  page,
+		})
+		if err != nil {
+			return nil, 0, err
+		}
+		results := make([]string, 0, len(branches.Branches))
+		for _, branch := range branches.Branches {
+			results = append(results, branch.Name)
+		}
+		return results, getBitbucketCloudNextPage(page, branches.Next), nil
+	})
+}
+
+// CreateBranch on Bitbucket cloud
+func (client *BitbucketCloudClient) CreateBranch(ctx context.Context, owner, repository, branch, fromRef string) error {
+	err := validateParametersNotBlank(map[string]string{
+		"owner":      owner,
+		"repository": repository,
+		"branch":     branch,
+		"fromRef":    fromRef,
+	})
+	if err != nil {
+		return err
+	}
+	hash, err := client.getCommitHash(ctx, owner, repository, fromRef)
+	if err != nil {
+		return err
+	}
 	bitbucketClient := client.buildBitbucketCloudClient(ctx)
-	branches, err := bitbucketClient.Repositories.Repository.ListBranches(&bitbucket.RepositoryBranchOptions{Owner: owner, RepoSlug: repository})
+	_, err = bitbucketClient.Repositories.Repository.CreateBranch(&bitbucket.RepositoryBranchCreationOptions{
+		Owner:    owner,
+		RepoSlug: repository,
+		Name:     branch,
+		Target:   bitbucket.RepositoryBranchTarget{Hash: hash},
+	})
+	return err
+}
+
+// DeleteBranch on Bitbucket cloud
+func (client *BitbucketCloudClient) DeleteBranch(ctx context.Context, owner, repository, branch string) error {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository, "branch": branch})
 	if err != nil {
-		return nil, err
+		return err
 	}
+	bitbucketClient := client.buildBitbucketCloudClient(ctx)
+	return bitbucketClient.Repositories.Repository.DeleteBranch(&bitbucket.RepositoryBranchDeleteOptions{
+		Owner:    owner,
+		RepoSlug: repository,
+		RefName:  branch,
+	})
+}
 
-	results := make([]string, 0, len(branches.Branches))
-	for _, branch := range branches.Branches {
-		results = append(results, branch.Name)
+// GetBranch on Bitbucket cloud.
+// The branch is considered protected if it matches the pattern of a branch restriction.
+// Restrictions on branch types of the branching model are ignored.
+func (client *BitbucketCloudClient) GetBranch(ctx context.Context, owner, repository, branch string) (BranchDetails, error) {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository, "branch": branch})
+	if err != nil {
+		return BranchDetails{}, err
 	}
-	return results, nil
+	bitbucketClient := client.buildBitbucketCloudClient(ctx)
+	bitbucketBranch, err := bitbucketClient.Repositories.Repository.GetBranch(&bitbucket.RepositoryBranchOptions{
+		Owner:      owner,
+		RepoSlug:   repository,
+		BranchName: branch,
+	})
+	if err != nil {
+		return BranchDetails{}, err
+	}
+	repo, err := bitbucketClient.Repositories.Repository.Get(&bitbucket.RepositoryOptions{Owner: owner, RepoSlug: repository})
+	if err != nil {
+		return BranchDetails{}, err
+	}
+	protected, err := client.isBranchRestricted(ctx, owner, repository, branch)
+	if err != nil {
+		return BranchDetails{}, err
+	}
+	hash, _ := bitbucketBranch.Target["hash"].(string)
+	return BranchDetails{
+		Name:      bitbucketBranch.Name,
+		CommitSHA: hash,
+		Protected: protected,
+		Default:   bitbucketBranch.Name == repo.Mainbranch.Name,
+	}, nil
+}
+
+func (client *BitbucketCloudClient) isBranchRestricted(ctx context.Context, owner, repository, branch string) (bool, error) {
+	// The branch restrictions are not parsed by the go-bitbucket client
+	for page, hasNextPage := 1, true; hasNextPage; page++ {
+		urlPath := fmt.Sprintf("/repositories/%s/%s/branch-restrictions?page=%d", owner, repository, page)
+		var response branchRestrictionsResponse
+		if err := client.sendBitbucketCloudRequest(ctx, http.MethodGet, urlPath, nil, &response); err != nil {
+			return false, err
+		}
+		for _, restriction := range response.Values {
+			if restriction.BranchMatchKind != "glob" {
+				continue
+			}
+			if matched, err := path.Match(restriction.Pattern, branch); err == nil && matched {
+				return true, nil
+			}
+		}
+		hasNextPage = response.Next != ""
+	}
+	return false, nil
+}
+
+// CommitFiles on Bitbucket cloud
+func (client *BitbucketCloudClient) CommitFiles(ctx context.Context, owner, repository, branch, message string, changes []CommitFileChange, author CommitAuthor) error {
+	err := validateParametersNotBlank(map[string]string{
+		"owner":      owner,
+		"repository": repository,
+		"branch":     branch,
+		"message":    message,
+	})
+	if err != nil {
+		return err
+	}
+	if err = validateCommitFileChanges(changes); err != nil {
+		return err
+	}
+	body, contentType, err := createBitbucketCloudCommitForm(branch, message, changes, author)
+	if err != nil {
+		return err
+	}
+	// The go-bitbucket client can commit only a single file from the local file system
+	urlPath := fmt.Sprintf("/repositories/%s/%s/src", owner, repository)
+	return client.sendBitbucketCloudRawRequest(ctx, http.MethodPost, urlPath, contentType, body, nil)
+}
+
+// createBitbucketCloudCommitForm creates the multipart form of the src API.
+// Every added or modified file is a form file named after the file path. The removed files are listed in the 'files' field.
+// A moved file is removed from the previous path and added in the new path.
+func createBitbucketCloudCommitForm(branch, message string, changes []CommitFileChange, author CommitAuthor) (*bytes.Buffer, string, error) {
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+	fields := [][2]string{{"branch", branch}, {"message", message}}
+	if author != (CommitAuthor{}) {
+		fields = append(fields, [2]string{"author", fmt.Sprintf("%s <%s>", author.Name, author.Email)})
+	}
+	for _, change := range changes {
+		switch change.Action {
+		case DeleteFile:
+			fields = append(fields, [2]string{"files", change.Path})
+		case MoveFile:
+			fields = append(fields, [2]string{"files", change.PreviousPath})
+		}
+	}
+	for _, field := range fields {
+		if err := writer.WriteField(field[0], field[1]); err != nil {
+			return nil, "", err
+		}
+	}
+	for _, change := range changes {
+		if change.Action == DeleteFile {
+			continue
+		}
+		part, err := writer.CreateFormFile(change.Path, path.Base(change.Path))
+		if err != nil {
+			return nil, "", err
+		}
+		if _, err = part.Write(change.Content); err != nil {
+			return nil, "", err
+		}
+	}
+	return body, writer.FormDataContentType(), writer.Close()
+}
+
+// getCommitHash returns the commit hash of a branch. Branches and tags can be created only from a commit hash.
+func (client *BitbucketCloudClient) getCommitHash(ctx context.Context, owner, repository, ref string) (string, error) {
+	if isCommitSHA(ref) {
+		return ref, nil
+	}
+	commit, err := client.GetLatestCommit(ctx, owner, repository, ref)
+	return commit.Hash, err
+}
+
+// ListTags on Bitbucket cloud
+func (client *BitbucketCloudClient) ListTags(owner, repository string) *Pager[TagInfo] {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository})
+	if err != nil {
+		return newErrorPager[TagInfo](err)
+	}
+	return newNumberedPager(1, func(ctx context.Context, page int) ([]TagInfo, int, error) {
+		bitbucketClient := client.buildBitbucketCloudClient(ctx)
+		tags, err := bitbucketClient.Repositories.Repository.ListTags(&bitbucket.RepositoryTagOptions{
+			Owner:    owner,
+			RepoSlug: repository,
+			PageNum:  page,
+		})
+		if err != nil {
+			return nil, 0, err
+		}
+		results := make([]TagInfo, 0, len(tags.Tags))
+		for _, tag := range tags.Tags {
+			hash, _ := tag.Target["hash"].(string)
+			results = append(results, TagInfo{Name: tag.Name, CommitSHA: hash})
+		}
+		return results, getBitbucketCloudNextPage(page, tags.Next), nil
+	})
+}
+
+// CreateTag on Bitbucket cloud, which supports only lightweight tags
+func (client *BitbucketCloudClient) CreateTag(ctx context.Context, owner, repository, tag, fromRef, message string) error {
+	err := validateParametersNotBlank(map[string]string{
+		"owner":      owner,
+		"repository": repository,
+		"tag":        tag,
+		"fromRef":    fromRef,
+	})
+	if err != nil {
+		return err
+	}
+	if message != "" {
+		return &UnsupportedFeatureError{Provider: vcsutils.BitbucketCloud, Feature: "annotated tags"}
+	}
+	hash, err := client.getCommitHash(ctx, owner, repository, fromRef)
+	if err != nil {
+		return err
+	}
+	bitbucketClient := client.buildBitbucketCloudClient(ctx)
+	_, err = bitbucketClient.Repositories.Repository.CreateTag(&bitbucket.RepositoryTagCreationOptions{
+		Owner:    owner,
+		RepoSlug: repository,
+		Name:     tag,
+		Target:   bitbucket.RepositoryTagTarget{Hash: hash},
+	})
+	return err
+}
+
+// DeleteTag on Bitbucket cloud
+func (client *BitbucketCloudClient) DeleteTag(ctx context.Context, owner, repository, tag string) error {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository, "tag": tag})
+	if err != nil {
+		return err
+	}
+	urlPath := fmt.Sprintf("/repositories/%s/%s/refs/tags/%s", owner, repository, url.PathEscape(tag))
+	return client.sendBitbucketCloudRequest(ctx, http.MethodDelete, urlPath, nil, nil)
+}
+
+// CreateRelease on Bitbucket cloud, which doesn't support releases
+func (client *BitbucketCloudClient) CreateRelease(_ context.Context, _, _ string, _ CreateReleaseOptions) (ReleaseInfo, error) {
+	return ReleaseInfo{}, &UnsupportedFeatureError{Provider: vcsutils.BitbucketCloud, Feature: "releases"}
+}
+
+// ListReleases on Bitbucket cloud, which doesn't support releases
+func (client *BitbucketCloudClient) ListReleases(_, _ string) *Pager[ReleaseInfo] {
+	return newErrorPager[ReleaseInfo](&UnsupportedFeatureError{Provider: vcsutils.BitbucketCloud, Feature: "releases"})
 }
 
 // AddSshKeyToRepository on Bitbucket cloud, the deploy-key is always read-only.
@@ -196,9 +438,101 @@ func (client *BitbucketCloudClient) DeleteWebhook(ctx context.Context, owner, re
 	return err
 }
 
-// SetCommitStatus on Bitbucket cloud
-func (client *BitbucketCloudClient) SetCommitStatus(ctx context.Context, commitStatus CommitStatus, owner, repository,
-	ref, title, description, detailsURL string) error {
+// CreateOrganizationWebhook on Bitbucket cloud. The webhook is created on the workspace, which is the owner.
+func (client *BitbucketCloudClient) CreateOrganizationWebhook(ctx context.Context, owner, payloadURL string,
+	webhookEvents ...vcsutils.WebhookEvent) (string, string, error) {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "payloadURL": payloadURL})
+	if err != nil {
+		return "", "", err
+	}
+	token := vcsutils.CreateToken()
+	var webhook bitbucket.Webhook
+	err = client.sendBitbucketCloudRequest(ctx, http.MethodPost, fmt.Sprintf("/workspaces/%s/hooks", owner),
+		createBitbucketCloudWorkspaceHook(payloadURL, token, webhookEvents...), &webhook)
+	if err != nil {
+		return "", "", err
+	}
+	return strings.TrimRight(strings.TrimLeft(webhook.Uuid, "{"), "}"), token, nil
+}
+
+// UpdateOrganizationWebhook on Bitbucket cloud
+func (client *BitbucketCloudClient) UpdateOrganizationWebhook(ctx context.Context, owner, payloadURL, token, webhookID string,
+	webhookEvents ...vcsutils.WebhookEvent) error {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "payloadURL": payloadURL, "webhookID": webhookID})
+	if err != nil {
+		return err
+	}
+	return client.sendBitbucketCloudRequest(ctx, http.MethodPut, fmt.Sprintf("/workspaces/%s/hooks/%s", owner, webhookID),
+		createBitbucketCloudWorkspaceHook(payloadURL, token, webhookEvents...), nil)
+}
+
+// DeleteOrganizationWebhook on Bitbucket cloud
+func (client *BitbucketCloudClient) DeleteOrganizationWebhook(ctx context.Context, owner, webhookID string) error {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "webhookID": webhookID})
+	if err != nil {
+		return err
+	}
+	return client.sendBitbucketCloudRequest(ctx, http.MethodDelete, fmt.Sprintf("/workspaces/%s/hooks/%s", owner, webhookID), nil, nil)
+}
+
+// ListOrganizationWebhooks on Bitbucket cloud
+func (client *BitbucketCloudClient) ListOrganizationWebhooks(owner string) *Pager[WebhookInfo] {
+	err := validateParametersNotBlank(map[string]string{"owner": owner})
+	if err != nil {
+		return newErrorPager[WebhookInfo](err)
+	}
+	return client.listWebhooks(fmt.Sprintf("/workspaces/%s/hooks", owner))
+}
+
+// ListWebhooks on Bitbucket cloud
+func (client *BitbucketCloudClient) ListWebhooks(owner, repository string) *Pager[WebhookInfo] {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository})
+	if err != nil {
+		return newErrorPager[WebhookInfo](err)
+	}
+	return client.listWebhooks(fmt.Sprintf("/repositories/%s/%s/hooks", owner, repository))
+}
+
+func (client *BitbucketCloudClient) listWebhooks(webhooksPath string) *Pager[WebhookInfo] {
+	return newNumberedPager(1, func(ctx context.Context, page int) ([]WebhookInfo, int, error) {
+		var response webhooksResponse
+		if err := client.sendBitbucketCloudRequest(ctx, http.MethodGet, fmt.Sprintf("%s?page=%d", webhooksPath, page), nil, &response); err != nil {
+			return nil, 0, err
+		}
+		results := make([]WebhookInfo, 0, len(response.Values))
+		for i := range response.Values {
+			results = append(results, mapBitbucketCloudWebhookToWebhookInfo(&response.Values[i]))
+		}
+		return results, getBitbucketCloudNextPage(page, response.Next), nil
+	})
+}
+
+// GetWebhook on Bitbucket cloud
+func (client *BitbucketCloudClient) GetWebhook(ctx context.Context, owner, repository, webhookID string) (WebhookInfo, error) {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository, "webhookID": webhookID})
+	if err != nil {
+		return WebhookInfo{}, err
+	}
+	bitbucketClient := client.buildBitbucketCloudClient(ctx)
+	options := &bitbucket.WebhooksOptions{
+		Uuid:     webhookID,
+		Owner:    owner,
+		RepoSlug: repository,
+	}
+	webhook, err := bitbucketClient.Repositories.Webhooks.Get(options)
+	if err != nil {
+		return WebhookInfo{}, err
+	}
+	return mapBitbucketCloudWebhookToWebhookInfo(webhook), nil
+}
+
+// PingWebhook on Bitbucket cloud
+func (client *BitbucketCloudClient) PingWebhook(ctx context.Context, owner, repository, webhookID string) error {
+	return &UnsupportedFeatureError{Provider: vcsutils.BitbucketCloud, Feature: "webhook test deliveries"}
+}
+
+// SetCommitStatus on Bitbucket cloud. The build number isn't sent.
+func (client *BitbucketCloudClient) SetCommitStatus(ctx context.Context, owner, repository, ref string, status CommitStatusOptions) error {
 	bitbucketClient := client.buildBitbucketCloudClient(ctx)
 	commitOptions := &bitbucket.CommitsOptions{
 		Owner:    owner,
@@ -206,15 +540,114 @@ func (client *BitbucketCloudClient) SetCommitStatus(ctx context.Context, commitS
 		Revision: ref,
 	}
 	commitStatusOptions := &bitbucket.CommitStatusOptions{
-		State:       getBitbucketCommitState(commitStatus),
-		Key:         title,
-		Description: description,
-		Url:         detailsURL,
+		State:       getBitbucketCommitState(status.State),
+		Key:         status.Key,
+		Name:        status.Name,
+		Description: status.Description,
+		Url:         status.DetailsURL,
+	}
+	if status.State == Cancelled {
+		commitStatusOptions.State = "STOPPED"
 	}
 	_, err := bitbucketClient.Repositories.Commits.CreateCommitStatus(commitOptions, commitStatusOptions)
 	return err
 }
 
+// ListCommitStatuses on Bitbucket cloud
+func (client *BitbucketCloudClient) ListCommitStatuses(owner, repository, ref string) *Pager[CommitStatusInfo] {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository, "ref": ref})
+	if err != nil {
+		return newErrorPager[CommitStatusInfo](err)
+	}
+	return newNumberedPager(1, func(ctx context.Context, page int) ([]CommitStatusInfo, int, error) {
+		statusesPath := fmt.Sprintf("/repositories/%s/%s/commit/%s/statuses?page=%d", owner, repository, url.PathEscape(ref), page)
+		var response commitStatusesResponse
+		if err := client.sendBitbucketCloudRequest(ctx, http.MethodGet, statusesPath, nil, &response); err != nil {
+			return nil, 0, err
+		}
+		results := make([]CommitStatusInfo, 0, len(response.Values))
+		for _, status := range response.Values {
+			results = append(results, CommitStatusInfo{
+				State:         mapBitbucketCommitState(status.State),
+				Context:       status.Key,
+				Description:   status.Description,
+				DetailsURL:    status.Url,
+				CreatedAt:     status.CreatedOn.UTC(),
+				LastUpdatedAt: status.UpdatedOn.UTC(),
+			})
+		}
+		return results, getBitbucketCloudNextPage(page, response.Next), nil
+	})
+}
+
+// CreateCheckRun on Bitbucket cloud. The check run is published as a Code Insights report, whose ID is the check run name.
+func (client *BitbucketCloudClient) CreateCheckRun(ctx context.Context, owner, repository string, checkRun CheckRunOptions) (string, error) {
+	if err := client.publishCodeInsightsReport(ctx, owner, repository, checkRun.Name, checkRun); err != nil {
+		return "", err
+	}
+	return checkRun.Name, nil
+}
+
+// UpdateCheckRun on Bitbucket cloud. The Code Insights report is replaced, and the annotations are added to it.
+func (client *BitbucketCloudClient) UpdateCheckRun(ctx context.Context, owner, repository, checkRunID string, checkRun CheckRunOptions) error {
+	return client.publishCodeInsightsReport(ctx, owner, repository, checkRunID, checkRun)
+}
+
+func (client *BitbucketCloudClient) publishCodeInsightsReport(ctx context.Context, owner, repository, reportID string, checkRun CheckRunOptions) error {
+	err := validateParametersNotBlank(map[string]string{
+		"owner":              owner,
+		"repository":         repository,
+		"reportID":           reportID,
+		"checkRun.CommitSHA": checkRun.CommitSHA,
+	})
+	if err != nil {
+		return err
+	}
+	reportPath := fmt.Sprintf("/repositories/%s/%s/commit/%s/reports/%s", owner, repository, checkRun.CommitSHA, url.PathEscape(reportID))
+	report := codeInsightsReport{Title: checkRun.Title, Details: checkRun.Summary, ReportType: "TEST", Result: "PENDING", Link: checkRun.DetailsURL}
+	if report.Title == "" {
+		report.Title = reportID
+	}
+	if checkRun.State == Pass || checkRun.State == Skipped {
+		report.Result = "PASSED"
+	} else if checkRun.State != InProgress {
+		report.Result = "FAILED"
+	}
+	if err = client.sendBitbucketCloudRequest(ctx, http.MethodPut, reportPath, report, nil); err != nil {
+		return err
+	}
+	if len(checkRun.Annotations) == 0 {
+		return nil
+	}
+	for _, batch := range splitToBatches(checkRun.Annotations, bitbucketAnnotationsBatchSize) {
+		annotations := make([]codeInsightsAnnotation, 0, len(batch))
+		for _, annotation := range batch {
+			codeInsightsAnnotation := codeInsightsAnnotation{
+				ExternalID:     getBitbucketAnnotationExternalID(annotation),
+				AnnotationType: "BUG",
+				Summary:        annotation.Title,
+				Details:        annotation.Message,
+				Path:           annotation.Path,
+				Line:           annotation.StartLine,
+				Severity:       getBitbucketAnnotationSeverity(annotation.Level),
+			}
+			if codeInsightsAnnotation.Summary == "" {
+				codeInsightsAnnotation.Summary, codeInsightsAnnotation.Details = annotation.Message, ""
+			}
+			annotations = append(annotations, codeInsightsAnnotation)
+		}
+		if err = client.sendBitbucketCloudRequest(ctx, http.MethodPost, reportPath+"/annotations", annotations, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetCombinedCommitStatus on Bitbucket cloud
+func (client *BitbucketCloudClient) GetCombinedCommitStatus(ctx context.Context, owner, repository, ref string) (CombinedCommitStatus, error) {
+	return getCombinedCommitStatus(ctx, client.ListCommitStatuses(owner, repository, ref))
+}
+
 // DownloadRepository on Bitbucket cloud
 func (client *BitbucketCloudClient) DownloadRepository(ctx context.Context, owner, repository, branch,
 	localPath string) error {
@@ -277,28 +710,21 @@ func (client *BitbucketCloudClient) CreatePullRequest(ctx context.Context, owner
 	return err
 }
 
-// CreatePullRequest on Bitbucket cloud
-func (client *BitbucketCloudClient) ListOpenPullRequests(ctx context.Context, owner, repository string) (res []PullRequestInfo, err error) {
-	err = validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository})
-	if err != nil {
-		return nil, err
-	}
-	bitbucketClient := client.buildBitbucketCloudClient(ctx)
-	client.logger.Debug("fetching open pull requests in", repository)
-	options := &bitbucket.PullRequestsOptions{
-		Owner:    owner,
-		RepoSlug: repository,
-		States:   []string{"OPEN"},
-	}
-	pullRequests, err := bitbucketClient.Repositories.PullRequests.Gets(options)
+// ListOpenPullRequests on Bitbucket cloud
+func (client *BitbucketCloudClient) ListOpenPullRequests(owner, repository string) *Pager[PullRequestInfo] {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository})
 	if err != nil {
-		return
-	}
-	parsedPullRequests, err := extractPullRequestsFromResponse(pullRequests)
-	if err != nil {
-		return
-	}
-	return mapBitbucketCloudPullRequestToPullRequestInfo(parsedPullRequests), nil
+		return newErrorPager[PullRequestInfo](err)
+	}
+	return newNumberedPager(1, func(ctx context.Context, page int) ([]PullRequestInfo, int, error) {
+		client.logger.Debug("fetching open pull requests in", repository)
+		urlPath := fmt.Sprintf("/repositories/%s/%s/pullrequests?page=%d&state=OPEN", owner, repository, page)
+		var response pullRequestsResponse
+		if err := client.sendBitbucketCloudRequest(ctx, http.MethodGet, urlPath, nil, &response); err != nil {
+			return nil, 0, err
+		}
+		return mapBitbucketCloudPullRequestToPullRequestInfo(&response), getBitbucketCloudNextPage(page, response.Next), nil
+	})
 }
 
 // AddPullRequestComment on Bitbucket cloud
@@ -319,26 +745,136 @@ func (client *BitbucketCloudClient) AddPullRequestComment(ctx context.Context, o
 }
 
 // ListPullRequestComments on Bitbucket cloud
-func (client *BitbucketCloudClient) ListPullRequestComments(ctx context.Context, owner, repository string, pullRequestID int) (res []CommentInfo, err error) {
-	err = validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository})
+func (client *BitbucketCloudClient) ListPullRequestComments(owner, repository string, pullRequestID int) *Pager[CommentInfo] {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository})
+	if err != nil {
+		return newErrorPager[CommentInfo](err)
+	}
+	return newNumberedPager(1, func(ctx context.Context, page int) ([]CommentInfo, int, error) {
+		comments, err := client.getPullRequestComments(ctx, owner, repository, pullRequestID, page)
+		if err != nil {
+			return nil, 0, err
+		}
+		return mapBitbucketCloudCommentToCommentInfo(comments), getBitbucketCloudNextPage(page, comments.Next), nil
+	})
+}
+
+// getPullRequestComments returns a page of the comments of a pull request.
+// The comments are requested directly, because the go-bitbucket client always fetches all the pages.
+func (client *BitbucketCloudClient) getPullRequestComments(ctx context.Context, owner, repository string, pullRequestID, page int) (*commentsResponse, error) {
+	urlPath := fmt.Sprintf("/repositories/%s/%s/pullrequests/%d/comments?page=%d", owner, repository, pullRequestID, page)
+	var response commentsResponse
+	if err := client.sendBitbucketCloudRequest(ctx, http.MethodGet, urlPath, nil, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// EditPullRequestComment on Bitbucket cloud
+func (client *BitbucketCloudClient) EditPullRequestComment(ctx context.Context, owner, repository string, pullRequestID int, commentID int64, content string) error {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository, "content": content})
+	if err != nil {
+		return err
+	}
+	urlPath := fmt.Sprintf("/repositories/%s/%s/pullrequests/%d/comments/%d", owner, repository, pullRequestID, commentID)
+	request := bitbucketCloudCommentRequest{Content: commentContent{Raw: content}}
+	return client.sendBitbucketCloudRequest(ctx, http.MethodPut, urlPath, request, nil)
+}
+
+// DeletePullRequestComment on Bitbucket cloud
+func (client *BitbucketCloudClient) DeletePullRequestComment(ctx context.Context, owner, repository string, pullRequestID int, commentID int64) error {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository})
+	if err != nil {
+		return err
+	}
+	urlPath := fmt.Sprintf("/repositories/%s/%s/pullrequests/%d/comments/%d", owner, repository, pullRequestID, commentID)
+	return client.sendBitbucketCloudRequest(ctx, http.MethodDelete, urlPath, nil, nil)
+}
+
+// AddPullRequestReviewComments on Bitbucket cloud
+func (client *BitbucketCloudClient) AddPullRequestReviewComments(ctx context.Context, owner, repository string, pullRequestID int, comments []ReviewComment) error {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository})
+	if err != nil {
+		return err
+	}
+	if err = validateReviewComments(comments); err != nil {
+		return err
+	}
+	// Inline comments are not supported by the go-bitbucket client
+	urlPath := fmt.Sprintf("/repositories/%s/%s/pullrequests/%d/comments", owner, repository, pullRequestID)
+	for _, comment := range comments {
+		request := bitbucketCloudReviewCommentRequest{
+			Content: commentContent{Raw: comment.Content},
+			Inline:  createBitbucketCloudCommentInline(comment),
+		}
+		if err = client.sendBitbucketCloudRequest(ctx, http.MethodPost, urlPath, request, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ListPullRequestReviewComments on Bitbucket cloud
+func (client *BitbucketCloudClient) ListPullRequestReviewComments(owner, repository string, pullRequestID int) *Pager[ReviewCommentInfo] {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository})
+	if err != nil {
+		return newErrorPager[ReviewCommentInfo](err)
+	}
+	return newNumberedPager(1, func(ctx context.Context, page int) ([]ReviewCommentInfo, int, error) {
+		comments, err := client.getPullRequestComments(ctx, owner, repository, pullRequestID, page)
+		if err != nil {
+			return nil, 0, err
+		}
+		return mapBitbucketCloudCommentToReviewCommentInfo(comments), getBitbucketCloudNextPage(page, comments.Next), nil
+	})
+}
+
+// ListPullRequestFiles on Bitbucket cloud
+func (client *BitbucketCloudClient) ListPullRequestFiles(owner, repository string, pullRequestID int) *Pager[vcsutils.FileDiff] {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository})
+	if err != nil {
+		return newErrorPager[vcsutils.FileDiff](err)
+	}
+	// The diffstat of a pull request is not supported by the go-bitbucket client
+	return newNumberedPager(1, func(ctx context.Context, page int) ([]vcsutils.FileDiff, int, error) {
+		urlPath := fmt.Sprintf("/repositories/%s/%s/pullrequests/%d/diffstat?page=%d", owner, repository, pullRequestID, page)
+		var response diffStatResponse
+		if err := client.sendBitbucketCloudRequest(ctx, http.MethodGet, urlPath, nil, &response); err != nil {
+			return nil, 0, err
+		}
+		results := make([]vcsutils.FileDiff, 0, len(response.Values))
+		for _, diffStat := range response.Values {
+			results = append(results, mapBitbucketCloudDiffStatToFileDiff(diffStat))
+		}
+		return results, getBitbucketCloudNextPage(page, response.Next), nil
+	})
+}
+
+// GetPullRequestDiff on Bitbucket cloud
+func (client *BitbucketCloudClient) GetPullRequestDiff(ctx context.Context, owner, repository string, pullRequestID int) ([]vcsutils.FileDiff, error) {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository})
 	if err != nil {
 		return nil, err
 	}
 	bitbucketClient := client.buildBitbucketCloudClient(ctx)
-	options := &bitbucket.PullRequestsOptions{
+	response, err := bitbucketClient.Repositories.PullRequests.Diff(&bitbucket.PullRequestsOptions{
 		Owner:    owner,
 		RepoSlug: repository,
 		ID:       fmt.Sprint(pullRequestID),
-	}
-	comments, err := bitbucketClient.Repositories.PullRequests.GetComments(options)
+	})
 	if err != nil {
-		return
+		return nil, err
 	}
-	parsedComments, err := extractCommentsFromResponse(comments)
+	diffReader, ok := response.(io.ReadCloser)
+	if !ok {
+		return nil, fmt.Errorf("unexpected response type of the pull request diff: %T", response)
+	}
+	defer func() { _ = diffReader.Close() }()
+	diff, err := io.ReadAll(diffReader)
 	if err != nil {
-		return
+		return nil, err
 	}
-	return mapBitbucketCloudCommentToCommentInfo(parsedComments), nil
+	return vcsutils.ParseUnifiedDiff(string(diff))
 }
 
 // GetLatestCommit on Bitbucket cloud
@@ -378,36 +914,123 @@ func (client *BitbucketCloudClient) GetRepositoryInfo(ctx context.Context, owner
 	if err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository}); err != nil {
 		return RepositoryInfo{}, err
 	}
-	bitbucketClient := client.buildBitbucketCloudClient(ctx)
-	repo, err := bitbucketClient.Repositories.Repository.Get(&bitbucket.RepositoryOptions{
-		Owner:    owner,
-		RepoSlug: repository,
-	})
+	// The repository model of the Bitbucket client lacks the creation time, the update time and the size
+	repo := bitbucketCloudRepositoryResponse{}
+	err := client.sendBitbucketCloudRequest(ctx, http.MethodGet, fmt.Sprintf("/repositories/%s/%s", owner, repository), nil, &repo)
 	if err != nil {
 		return RepositoryInfo{}, err
 	}
 
-	holder := struct {
-		Clone []struct {
-			Name string `mapstructure:"name"`
-			HRef string `mapstructure:"href"`
-		} `mapstructure:"clone"`
-	}{}
+	return mapBitbucketCloudRepositoryToRepositoryInfo(repo), nil
+}
 
-	if err := mapstructure.Decode(repo.Links, &holder); err != nil {
-		return RepositoryInfo{}, err
+// StreamRepositories on Bitbucket cloud. The owner is the workspace.
+func (client *BitbucketCloudClient) StreamRepositories(ctx context.Context, options ListRepositoriesOptions) <-chan RepositoryResult {
+	listPath := "/repositories"
+	query := url.Values{}
+	if options.Owner != "" {
+		listPath += "/" + options.Owner
+	} else {
+		query.Set("role", "member")
+	}
+	if filter := getBitbucketCloudRepositoriesQuery(options); filter != "" {
+		query.Set("q", filter)
 	}
+	return streamRepositories(ctx, options, client.listRepositories(listPath, query))
+}
 
-	var info CloneInfo
-	for _, link := range holder.Clone {
-		switch strings.ToLower(link.Name) {
-		case "https":
-			info.HTTP = link.HRef
-		case "ssh":
-			info.SSH = link.HRef
+// listRepositories lists the repositories of the list path, filtered by the query
+func (client *BitbucketCloudClient) listRepositories(listPath string, query url.Values) *Pager[RepositoryListItem] {
+	return newNumberedPager(1, func(ctx context.Context, page int) ([]RepositoryListItem, int, error) {
+		pageQuery := url.Values{"page": {strconv.Itoa(page)}, "pagelen": {strconv.Itoa(repositoriesPageSize)}}
+		for key, values := range query {
+			pageQuery[key] = values
+		}
+		var reposPage bitbucketCloudRepositoriesResponse
+		if err := client.sendBitbucketCloudRequest(ctx, http.MethodGet, listPath+"?"+pageQuery.Encode(), nil, &reposPage); err != nil {
+			return nil, 0, err
 		}
+		results := make([]RepositoryListItem, 0, len(reposPage.Values))
+		for _, repo := range reposPage.Values {
+			owner, name, _ := strings.Cut(repo.FullName, "/")
+			results = append(results, RepositoryListItem{Owner: owner, Name: name, RepositoryInfo: mapBitbucketCloudRepositoryToRepositoryInfo(repo)})
+		}
+		return results, getBitbucketCloudNextPage(page, reposPage.Next), nil
+	})
+}
+
+// getBitbucketCloudRepositoriesQuery returns the Bitbucket query language filter of the listed repositories
+func getBitbucketCloudRepositoriesQuery(options ListRepositoriesOptions) string {
+	var conditions []string
+	if options.Visibility != nil {
+		// Internal repositories are created as private
+		conditions = append(conditions, fmt.Sprintf("is_private = %t", *options.Visibility != Public))
+	}
+	if !options.UpdatedSince.IsZero() {
+		conditions = append(conditions, "updated_on >= "+options.UpdatedSince.UTC().Format(time.RFC3339))
+	}
+	if options.Search != "" {
+		conditions = append(conditions, fmt.Sprintf("name ~ %q", options.Search))
+	}
+	return strings.Join(conditions, " AND ")
+}
+
+// CreateRepository on Bitbucket cloud
+func (client *BitbucketCloudClient) CreateRepository(ctx context.Context, owner string, options CreateRepositoryOptions) error {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository name": options.Name})
+	if err != nil {
+		return err
+	}
+	bitbucketClient := client.buildBitbucketCloudClient(ctx)
+	repo, err := bitbucketClient.Repositories.Repository.Create(&bitbucket.RepositoryOptions{
+		Owner:       owner,
+		RepoSlug:    options.Name,
+		Scm:         "git",
+		IsPrivate:   strconv.FormatBool(options.Visibility != Public),
+		Description: options.Description,
+	})
+	if err != nil || !options.InitReadme {
+		return err
+	}
+	// The first branch of the repository becomes its main branch
+	body, contentType, err := createBitbucketCloudCommitForm(getInitialBranch(options), initialCommitMessage,
+		[]CommitFileChange{createReadmeFileChange(options)}, CommitAuthor{})
+	if err != nil {
+		return err
+	}
+	urlPath := fmt.Sprintf("/repositories/%s/%s/src", owner, repo.Slug)
+	return client.sendBitbucketCloudRawRequest(ctx, http.MethodPost, urlPath, contentType, body, nil)
+}
+
+// ForkRepository on Bitbucket cloud
+func (client *BitbucketCloudClient) ForkRepository(ctx context.Context, owner, repository, targetOwner string) error {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository})
+	if err != nil {
+		return err
+	}
+	bitbucketClient := client.buildBitbucketCloudClient(ctx)
+	_, err = bitbucketClient.Repositories.Repository.Fork(&bitbucket.RepositoryForkOptions{
+		FromOwner: owner,
+		FromSlug:  repository,
+		Owner:     targetOwner,
+	})
+	return err
+}
+
+// ArchiveRepository on Bitbucket cloud, which doesn't support archived repositories
+func (client *BitbucketCloudClient) ArchiveRepository(_ context.Context, _, _ string) error {
+	return &UnsupportedFeatureError{Provider: vcsutils.BitbucketCloud, Feature: "archived repositories"}
+}
+
+// DeleteRepository on Bitbucket cloud
+func (client *BitbucketCloudClient) DeleteRepository(ctx context.Context, owner, repository string) error {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository})
+	if err != nil {
+		return err
 	}
-	return RepositoryInfo{RepositoryVisibility: getBitbucketCloudRepositoryVisibility(repo), CloneInfo: info}, nil
+	bitbucketClient := client.buildBitbucketCloudClient(ctx)
+	_, err = bitbucketClient.Repositories.Repository.Delete(&bitbucket.RepositoryOptions{Owner: owner, RepoSlug: repository})
+	return err
 }
 
 // GetCommitBySha on Bitbucket cloud
@@ -438,24 +1061,82 @@ func (client *BitbucketCloudClient) GetCommitBySha(ctx context.Context, owner, r
 	return mapBitbucketCloudCommitToCommitInfo(parsedCommit), nil
 }
 
+// ListCommits on Bitbucket cloud.
+// The commits aren't filtered by the author and by the commit time on Bitbucket cloud, so they are filtered after listing.
+// The most recent commits are listed first, so the listing stops at the first commit committed before Since.
+func (client *BitbucketCloudClient) ListCommits(owner, repository string, options ListCommitsOptions) *Pager[CommitInfo] {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository})
+	if err != nil {
+		return newErrorPager[CommitInfo](err)
+	}
+	listPath := fmt.Sprintf("/repositories/%s/%s/commits", owner, repository)
+	if options.Ref != "" {
+		listPath += "/" + url.PathEscape(options.Ref)
+	}
+	return newNumberedPager(1, func(ctx context.Context, page int) ([]CommitInfo, int, error) {
+		query := url.Values{"page": {strconv.Itoa(page)}}
+		if options.Path != "" {
+			query.Set("path", options.Path)
+		}
+		if options.PageSize > 0 {
+			query.Set("pagelen", strconv.Itoa(options.PageSize))
+		}
+		var response commitResponse
+		if err := client.sendBitbucketCloudRequest(ctx, http.MethodGet, listPath+"?"+query.Encode(), nil, &response); err != nil {
+			return nil, 0, err
+		}
+		results := make([]CommitInfo, 0, len(response.Values))
+		for _, commit := range response.Values {
+			if options.committedBeforeSince(commit.Date) {
+				return results, 0, nil
+			}
+			authorName, authorEmail := parseBitbucketCloudCommitAuthor(commit.Author.Raw)
+			if options.matchesCommit(authorName, authorEmail, commit.Date) {
+				results = append(results, mapBitbucketCloudCommitToCommitInfo(commit))
+			}
+		}
+		return results, getBitbucketCloudNextPage(page, response.Next), nil
+	})
+}
+
 // CreateLabel on Bitbucket cloud
 func (client *BitbucketCloudClient) CreateLabel(ctx context.Context, owner, repository string, labelInfo LabelInfo) error {
-	return errLabelsNotSupported
+	return errBitbucketCloudLabelsNotSupported
 }
 
 // GetLabel on Bitbucket cloud
 func (client *BitbucketCloudClient) GetLabel(ctx context.Context, owner, repository, name string) (*LabelInfo, error) {
-	return nil, errLabelsNotSupported
+	return nil, errBitbucketCloudLabelsNotSupported
+}
+
+// ListLabels on Bitbucket cloud
+func (client *BitbucketCloudClient) ListLabels(owner, repository string) *Pager[LabelInfo] {
+	return newErrorPager[LabelInfo](errBitbucketCloudLabelsNotSupported)
+}
+
+// UpdateLabel on Bitbucket cloud
+func (client *BitbucketCloudClient) UpdateLabel(ctx context.Context, owner, repository, name string, labelInfo LabelInfo) error {
+	return errBitbucketCloudLabelsNotSupported
+}
+
+// DeleteLabel on Bitbucket cloud
+func (client *BitbucketCloudClient) DeleteLabel(ctx context.Context, owner, repository, name string) error {
+	return errBitbucketCloudLabelsNotSupported
+}
+
+// AddPullRequestLabels on Bitbucket cloud
+func (client *BitbucketCloudClient) AddPullRequestLabels(ctx context.Context, owner, repository string, pullRequestID int, labels ...string) error {
+	return errBitbucketCloudLabelsNotSupported
 }
 
 // ListPullRequestLabels on Bitbucket cloud
-func (client *BitbucketCloudClient) ListPullRequestLabels(ctx context.Context, owner, repository string, pullRequestID int) ([]string, error) {
-	return nil, errLabelsNotSupported
+func (client *BitbucketCloudClient) ListPullRequestLabels(owner, repository string, pullRequestID int) *Pager[string] {
+	return newErrorPager[string](errBitbucketCloudLabelsNotSupported)
 }
 
 // UnlabelPullRequest on Bitbucket cloud
 func (client *BitbucketCloudClient) UnlabelPullRequest(ctx context.Context, owner, repository, name string, pullRequestID int) error {
-	return errLabelsNotSupported
+	return errBitbucketCloudLabelsNotSupported
 }
 
 // UploadCodeScanning on Bitbucket cloud
@@ -474,6 +1155,15 @@ func (client *BitbucketCloudClient) GetRepositoryEnvironmentInfo(ctx context.Con
 }
 
 func (client *BitbucketCloudClient) GetModifiedFiles(ctx context.Context, owner, repository, refBefore, refAfter string) ([]string, error) {
+	fileChanges, err := client.GetFileChanges(ctx, owner, repository, refBefore, refAfter)
+	if err != nil {
+		return nil, err
+	}
+	return getFileChangesPaths(fileChanges), nil
+}
+
+// GetFileChanges on Bitbucket cloud
+func (client *BitbucketCloudClient) GetFileChanges(ctx context.Context, owner, repository, refBefore, refAfter string) ([]FileChange, error) {
 	err := validateParametersNotBlank(map[string]string{
 		"owner":      owner,
 		"repository": repository,
@@ -496,7 +1186,7 @@ func (client *BitbucketCloudClient) GetModifiedFiles(ctx context.Context, owner,
 		Merge:   true,
 	}
 
-	fileNamesSet := datastructures.MakeSet[string]()
+	var fileChanges []FileChange
 	nextPage := 1
 
 	for nextPage > 0 {
@@ -513,18 +1203,73 @@ func (client *BitbucketCloudClient) GetModifiedFiles(ctx context.Context, owner,
 		}
 
 		for _, diffStat := range diffStatRes.DiffStats {
-			if path, ok := diffStat.New["path"].(string); ok {
-				fileNamesSet.Add(path)
-			}
-			if path, ok := diffStat.Old["path"].(string); ok {
-				fileNamesSet.Add(path)
+			newPath, _ := diffStat.New["path"].(string)
+			oldPath, _ := diffStat.Old["path"].(string)
+			fileChange := FileChange{Path: newPath, Status: getBitbucketCloudFileStatus(diffStat.Status)}
+			switch fileChange.Status {
+			case vcsutils.FileRemoved:
+				fileChange.Path = oldPath
+			case vcsutils.FileRenamed:
+				fileChange.PreviousPath = oldPath
 			}
+			fileChanges = append(fileChanges, fileChange)
 		}
 	}
-	_ = fileNamesSet.Remove("") // Make sure there are no blank filepath.
-	fileNamesList := fileNamesSet.ToSlice()
-	sort.Strings(fileNamesList)
-	return fileNamesList, nil
+	sortFileChanges(fileChanges)
+	return fileChanges, nil
+}
+
+// getBitbucketCloudNextPage returns the number of the page following the page, or zero if it has no next link
+func getBitbucketCloudNextPage(page int, next string) int {
+	if next == "" {
+		return 0
+	}
+	return page + 1
+}
+
+// sendBitbucketCloudRequest sends a request to an endpoint which isn't supported by the go-bitbucket client.
+// The request body and the response body are JSON encoded and decoded, unless they are nil.
+func (client *BitbucketCloudClient) sendBitbucketCloudRequest(ctx context.Context, method, urlPath string, requestBody, responseBody interface{}) error {
+	if requestBody == nil {
+		return client.sendBitbucketCloudRawRequest(ctx, method, urlPath, "", nil, responseBody)
+	}
+	body := new(bytes.Buffer)
+	if err := json.NewEncoder(body).Encode(requestBody); err != nil {
+		return err
+	}
+	return client.sendBitbucketCloudRawRequest(ctx, method, urlPath, "application/json", body, responseBody)
+}
+
+// sendBitbucketCloudRawRequest sends a request with an already encoded body of the given content type.
+// The content type is ignored if the body is nil.
+func (client *BitbucketCloudClient) sendBitbucketCloudRawRequest(ctx context.Context, method, urlPath, contentType string, body io.Reader, responseBody interface{}) error {
+	endpoint := client.vcsInfo.APIEndpoint
+	if endpoint == "" {
+		endpoint = bitbucket.DEFAULT_BITBUCKET_API_BASE_URL
+	}
+	req, err := http.NewRequestWithContext(ctx, method, endpoint+urlPath, body)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", contentType)
+	}
+	req.SetBasicAuth(client.vcsInfo.Username, client.vcsInfo.Token)
+
+	bitbucketClient := client.buildBitbucketCloudClient(ctx)
+	response, err := bitbucketClient.HttpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = response.Body.Close() }()
+
+	if err = vcsutils.CheckResponseStatusWithBody(response, http.StatusOK, http.StatusCreated, http.StatusNoContent); err != nil {
+		return err
+	}
+	if responseBody == nil {
+		return vcsutils.DiscardResponseBody(response)
+	}
+	return json.NewDecoder(response.Body).Decode(responseBody)
 }
 
 func extractCommitFromResponse(commits interface{}) (*commitResponse, error) {
@@ -539,18 +1284,6 @@ func extractCommitDetailsFromResponse(commit interface{}) (commitDetails, error)
 	return res, err
 }
 
-func extractCommentsFromResponse(comments interface{}) (*commentsResponse, error) {
-	var res commentsResponse
-	err := extractStructFromResponse(comments, &res)
-	return &res, err
-}
-
-func extractPullRequestsFromResponse(pullRequests interface{}) (*pullRequestsResponse, error) {
-	var res pullRequestsResponse
-	err := extractStructFromResponse(pullRequests, &res)
-	return &res, err
-}
-
 func extractStructFromResponse(response, aStructPointer interface{}) error {
 	b, err := json.Marshal(response)
 	if err != nil {
@@ -560,8 +1293,40 @@ func extractStructFromResponse(response, aStructPointer interface{}) error {
 	return err
 }
 
+type bitbucketCloudRepositoryResponse struct {
+	FullName    string `json:"full_name"`
+	Description string `json:"description"`
+	Language    string `json:"language"`
+	IsPrivate   bool   `json:"is_private"`
+	Size        int64  `json:"size"`
+	// Null in empty repositories
+	MainBranch struct {
+		Name string `json:"name"`
+	} `json:"mainbranch"`
+	Links struct {
+		HTML struct {
+			HRef string `json:"href"`
+		} `json:"html"`
+		Clone []struct {
+			Name string `json:"name"`
+			HRef string `json:"href"`
+		} `json:"clone"`
+	} `json:"links"`
+	Parent *struct {
+		FullName string `json:"full_name"`
+	} `json:"parent"`
+	CreatedOn time.Time `json:"created_on"`
+	UpdatedOn time.Time `json:"updated_on"`
+}
+
+type bitbucketCloudRepositoriesResponse struct {
+	Values []bitbucketCloudRepositoryResponse `json:"values"`
+	Next   string                             `json:"next"`
+}
+
 type pullRequestsResponse struct {
 	Values []pullRequestsDetails `json:"values"`
+	Next   string                `json:"next"`
 }
 
 type pullRequestsDetails struct {
@@ -583,6 +1348,7 @@ type pullRequestRepository struct {
 
 type commentsResponse struct {
 	Values []commentDetails `json:"values"`
+	Next   string           `json:"next"`
 }
 
 type commentDetails struct {
@@ -591,14 +1357,60 @@ type commentDetails struct {
 	IsDeleted bool           `json:"deleted"`
 	Content   commentContent `json:"content"`
 	Created   time.Time      `json:"created_on"`
+	Inline    *commentInline `json:"inline,omitempty"`
 }
 
 type commentContent struct {
 	Raw string `json:"raw"`
 }
 
+// The 'from' lines refer to the previous version of the file, and the 'to' lines refer to the new version
+type commentInline struct {
+	Path      string `json:"path"`
+	From      int    `json:"from,omitempty"`
+	To        int    `json:"to,omitempty"`
+	StartFrom int    `json:"start_from,omitempty"`
+	StartTo   int    `json:"start_to,omitempty"`
+}
+
+type bitbucketCloudCommentRequest struct {
+	Content commentContent `json:"content"`
+}
+
+type bitbucketCloudReviewCommentRequest struct {
+	Content commentContent `json:"content"`
+	Inline  commentInline  `json:"inline"`
+}
+
+type branchRestrictionsResponse struct {
+	Values []struct {
+		Kind            string `json:"kind"`
+		BranchMatchKind string `json:"branch_match_kind"`
+		Pattern         string `json:"pattern"`
+	} `json:"values"`
+	Next string `json:"next"`
+}
+
+type diffStatResponse struct {
+	Values []diffStatDetails `json:"values"`
+	Next   string            `json:"next"`
+}
+
+type diffStatDetails struct {
+	Status       string        `json:"status"`
+	LinesAdded   int           `json:"lines_added"`
+	LinesRemoved int           `json:"lines_removed"`
+	Old          *diffStatFile `json:"old"`
+	New          *diffStatFile `json:"new"`
+}
+
+type diffStatFile struct {
+	Path string `json:"path"`
+}
+
 type commitResponse struct {
 	Values []commitDetails `json:"values"`
+	Next   string          `json:"next"`
 }
 
 type commitDetails struct {
@@ -606,16 +1418,57 @@ type commitDetails struct {
 	Date    time.Time `json:"date"`
 	Message string    `json:"message"`
 	Author  struct {
-		User user `json:"user"`
+		// The name and the email of the Git author, for example "Frogger <frogger@example.com>"
+		Raw  string `json:"raw"`
+		User user   `json:"user"`
 	} `json:"author"`
 	Links struct {
 		Self link `json:"self"`
+		HTML link `json:"html"`
 	} `json:"links"`
 	Parents []struct {
 		Hash string `json:"hash"`
 	} `json:"parents"`
 }
 
+type webhooksResponse struct {
+	Values []bitbucket.Webhook `json:"values"`
+	Next   string              `json:"next"`
+}
+
+type commitStatusesResponse struct {
+	Values []commitStatusDetails `json:"values"`
+	Next   string                `json:"next"`
+}
+
+type commitStatusDetails struct {
+	State       string    `json:"state"`
+	Key         string    `json:"key"`
+	Description string    `json:"description"`
+	Url         string    `json:"url"`
+	CreatedOn   time.Time `json:"created_on"`
+	UpdatedOn   time.Time `json:"updated_on"`
+}
+
+type codeInsightsReport struct {
+	Title      string `json:"title"`
+	Details    string `json:"details,omitempty"`
+	ReportType string `json:"report_type"`
+	// PASSED, FAILED or PENDING
+	Result string `json:"result"`
+	Link   string `json:"link,omitempty"`
+}
+
+type codeInsightsAnnotation struct {
+	ExternalID     string `json:"external_id"`
+	AnnotationType string `json:"annotation_type"`
+	Summary        string `json:"summary"`
+	Details        string `json:"details,omitempty"`
+	Path           string `json:"path"`
+	Line           int    `json:"line,omitempty"`
+	Severity       string `json:"severity"`
+}
+
 type user struct {
 	DisplayName string `json:"display_name"`
 }
@@ -637,6 +1490,63 @@ func getBitbucketCloudWebhookID(r interface{}) (string, error) {
 	return strings.TrimRight(strings.TrimLeft(webhook.Uuid, "{"), "}"), nil
 }
 
+// The token is added to the payload URL, as in the repository webhooks created by the go-bitbucket client
+func createBitbucketCloudWorkspaceHook(payloadURL, token string, webhookEvents ...vcsutils.WebhookEvent) map[string]interface{} {
+	return map[string]interface{}{
+		"url":    payloadURL + "?token=" + url.QueryEscape(token),
+		"active": true,
+		"events": getBitbucketCloudWebhookEvents(webhookEvents...),
+	}
+}
+
+// Bitbucket cloud doesn't return the last delivery of the webhook
+func mapBitbucketCloudWebhookToWebhookInfo(webhook *bitbucket.Webhook) WebhookInfo {
+	return WebhookInfo{
+		ID:         strings.TrimRight(strings.TrimLeft(webhook.Uuid, "{"), "}"),
+		PayloadURL: removeWebhookToken(webhook.Url),
+		Events:     mapBitbucketCloudWebhookEvents(webhook.Events),
+		Active:     webhook.Active,
+	}
+}
+
+// CreateWebhook adds the token as a query parameter of the payload URL
+func removeWebhookToken(payloadURL string) string {
+	parsedURL, err := url.Parse(payloadURL)
+	if err != nil {
+		return payloadURL
+	}
+	query := parsedURL.Query()
+	query.Del("token")
+	parsedURL.RawQuery = query.Encode()
+	return parsedURL.String()
+}
+
+// Get a slice of Bitbucket cloud webhook events and return the matching webhook events
+func mapBitbucketCloudWebhookEvents(bitbucketEvents []string) []vcsutils.WebhookEvent {
+	events := make([]vcsutils.WebhookEvent, 0, len(bitbucketEvents))
+	for _, event := range bitbucketEvents {
+		switch event {
+		case "pullrequest:created":
+			events = append(events, vcsutils.PrOpened)
+		case "pullrequest:updated":
+			events = append(events, vcsutils.PrEdited)
+		case "pullrequest:rejected":
+			events = append(events, vcsutils.PrRejected)
+		case "pullrequest:fulfilled":
+			events = append(events, vcsutils.PrMerged)
+		case "repo:push":
+			events = append(events, vcsutils.Push, vcsutils.TagPushed, vcsutils.TagDeleted, vcsutils.BranchCreated, vcsutils.BranchDeleted)
+		case "pullrequest:comment_created":
+			events = append(events, vcsutils.PrCommentAdded)
+		case "pullrequest:changes_request_created":
+			events = append(events, vcsutils.PrReviewSubmitted)
+		case "pullrequest:approved":
+			events = append(events, vcsutils.PrApproved)
+		}
+	}
+	return events
+}
+
 // Get varargs of webhook events and return a slice of Bitbucket cloud webhook events
 func getBitbucketCloudWebhookEvents(webhookEvents ...vcsutils.WebhookEvent) []string {
 	events := make([]string, 0, len(webhookEvents))
@@ -650,9 +1560,16 @@ func getBitbucketCloudWebhookEvents(webhookEvents ...vcsutils.WebhookEvent) []st
 			events = append(events, "pullrequest:rejected")
 		case vcsutils.PrMerged:
 			events = append(events, "pullrequest:fulfilled")
-		case vcsutils.Push:
-			events = append(events, "repo:push")
+		case vcsutils.Push, vcsutils.TagPushed, vcsutils.TagDeleted, vcsutils.BranchCreated, vcsutils.BranchDeleted:
+			events = appendMissing(events, "repo:push")
+		case vcsutils.PrCommentAdded:
+			events = append(events, "pullrequest:comment_created")
+		case vcsutils.PrReviewSubmitted:
+			events = append(events, "pullrequest:changes_request_created")
+		case vcsutils.PrApproved:
+			events = append(events, "pullrequest:approved")
 		}
+		// Bitbucket cloud has no releases, so ReleasePublished isn't registered
 	}
 	return events
 }
@@ -680,17 +1597,28 @@ func mapBitbucketCloudCommitToCommitInfo(parsedCommit commitDetails) CommitInfo
 	for i, p := range parsedCommit.Parents {
 		parents[i] = p.Hash
 	}
+	_, authorEmail := parseBitbucketCloudCommitAuthor(parsedCommit.Author.Raw)
 	return CommitInfo{
 		Hash:          parsedCommit.Hash,
 		AuthorName:    parsedCommit.Author.User.DisplayName,
+		AuthorEmail:   authorEmail,
 		CommitterName: "", // not provided
 		Url:           parsedCommit.Links.Self.Href,
+		WebURL:        parsedCommit.Links.HTML.Href,
 		Timestamp:     parsedCommit.Date.UTC().Unix(),
-		Message:       parsedCommit.Message,
-		ParentHashes:  parents,
+		// The date of the commit is the author date
+		AuthorDate:   parsedCommit.Date.UTC(),
+		Message:      parsedCommit.Message,
+		ParentHashes: parents,
 	}
 }
 
+// parseBitbucketCloudCommitAuthor returns the name and the email of a raw commit author, such as "Frogger <frogger@example.com>"
+func parseBitbucketCloudCommitAuthor(rawAuthor string) (name, email string) {
+	name, email, _ = strings.Cut(rawAuthor, "<")
+	return strings.TrimSpace(name), strings.TrimSuffix(email, ">")
+}
+
 func mapBitbucketCloudCommentToCommentInfo(parsedComments *commentsResponse) []CommentInfo {
 	comments := make([]CommentInfo, len(parsedComments.Values))
 	for i, comment := range parsedComments.Values {
@@ -703,6 +1631,47 @@ func mapBitbucketCloudCommentToCommentInfo(parsedComments *commentsResponse) []C
 	return comments
 }
 
+func mapBitbucketCloudCommentToReviewCommentInfo(parsedComments *commentsResponse) []ReviewCommentInfo {
+	var comments []ReviewCommentInfo
+	for _, comment := range parsedComments.Values {
+		if comment.Inline == nil || comment.IsDeleted {
+			continue
+		}
+		reviewComment := ReviewCommentInfo{
+			ID:        comment.ID,
+			Content:   comment.Content.Raw,
+			Created:   comment.Created,
+			Path:      comment.Inline.Path,
+			Line:      comment.Inline.To,
+			StartLine: comment.Inline.StartTo,
+			Side:      RightSide,
+		}
+		if comment.Inline.To == 0 {
+			reviewComment.Line = comment.Inline.From
+			reviewComment.StartLine = comment.Inline.StartFrom
+			reviewComment.Side = LeftSide
+		}
+		comments = append(comments, reviewComment)
+	}
+	return comments
+}
+
+func createBitbucketCloudCommentInline(comment ReviewComment) commentInline {
+	inline := commentInline{Path: comment.Path}
+	startLine := 0
+	if comment.StartLine > 0 && comment.StartLine < comment.Line {
+		startLine = comment.StartLine
+	}
+	if comment.Side == LeftSide {
+		inline.From = comment.Line
+		inline.StartFrom = startLine
+	} else {
+		inline.To = comment.Line
+		inline.StartTo = startLine
+	}
+	return inline
+}
+
 func mapBitbucketCloudPullRequestToPullRequestInfo(parsedPullRequests *pullRequestsResponse) []PullRequestInfo {
 	pullRequests := make([]PullRequestInfo, len(parsedPullRequests.Values))
 	for i, pullRequest := range parsedPullRequests.Values {
@@ -721,8 +1690,72 @@ func mapBitbucketCloudPullRequestToPullRequestInfo(parsedPullRequests *pullReque
 	return pullRequests
 }
 
-func getBitbucketCloudRepositoryVisibility(repo *bitbucket.Repository) RepositoryVisibility {
-	if repo.Is_private {
+func mapBitbucketCloudDiffStatToFileDiff(diffStat diffStatDetails) vcsutils.FileDiff {
+	fileDiff := vcsutils.FileDiff{
+		Status:    getBitbucketCloudFileStatus(diffStat.Status),
+		Additions: diffStat.LinesAdded,
+		Deletions: diffStat.LinesRemoved,
+	}
+	if diffStat.New != nil {
+		fileDiff.Path = diffStat.New.Path
+	}
+	if diffStat.Old != nil {
+		switch fileDiff.Status {
+		case vcsutils.FileRemoved:
+			fileDiff.Path = diffStat.Old.Path
+		case vcsutils.FileRenamed:
+			fileDiff.PreviousPath = diffStat.Old.Path
+		}
+	}
+	return fileDiff
+}
+
+// Any other status, such as a merge conflict, is considered a modification
+func getBitbucketCloudFileStatus(status string) vcsutils.FileStatus {
+	switch status {
+	case "added":
+		return vcsutils.FileAdded
+	case "removed":
+		return vcsutils.FileRemoved
+	case "renamed":
+		return vcsutils.FileRenamed
+	default:
+		return vcsutils.FileModified
+	}
+}
+
+func mapBitbucketCloudRepositoryToRepositoryInfo(repo bitbucketCloudRepositoryResponse) RepositoryInfo {
+	var info CloneInfo
+	for _, link := range repo.Links.Clone {
+		switch strings.ToLower(link.Name) {
+		case "https":
+			info.HTTP = link.HRef
+		case "ssh":
+			info.SSH = link.HRef
+		}
+	}
+	repositoryInfo := RepositoryInfo{
+		RepositoryVisibility: getBitbucketCloudRepositoryVisibility(repo.IsPrivate),
+		CloneInfo:            info,
+		DefaultBranch:        repo.MainBranch.Name,
+		Description:          repo.Description,
+		WebURL:               repo.Links.HTML.HRef,
+		Fork:                 repo.Parent != nil,
+		Size:                 repo.Size,
+		CreatedAt:            repo.CreatedOn.UTC(),
+		// Bitbucket cloud doesn't return the time of the last push, the update time includes pushes
+		LastPushedAt: repo.UpdatedOn.UTC(),
+		Language:     repo.Language,
+	}
+	if repo.Parent != nil {
+		parentOwner, parentName, _ := strings.Cut(repo.Parent.FullName, "/")
+		repositoryInfo.Parent = &RepositoryReference{Owner: parentOwner, Name: parentName}
+	}
+	return repositoryInfo
+}
+
+func getBitbucketCloudRepositoryVisibility(isPrivate bool) RepositoryVisibility {
+	if isPrivate {
 		return Private
 	}
 	return Public
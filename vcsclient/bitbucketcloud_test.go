@@ -57,10 +57,8 @@ func TestBitbucketCloud_ConnectionWhenContextTimesOut(t *testing.T) {
 
 func TestBitbucketCloud_ListRepositories(t *testing.T) {
 	ctx := context.Background()
-	mockResponse := map[string][]bitbucket.Repository{
-		"values": {{Slug: repo1}, {Slug: repo2}},
-	}
-	client, cleanUp := createServerAndClient(t, vcsutils.BitbucketCloud, true, mockResponse, "/repositories/"+username, createBitbucketCloudHandler)
+	mockResponse := []byte(`{"values":[{"full_name":"frogger/repo-1"},{"full_name":"frogger/repo-2"}]}`)
+	client, cleanUp := createServerAndClient(t, vcsutils.BitbucketCloud, true, mockResponse, "/repositories/"+username+"?page=1&pagelen=100", createBitbucketCloudHandler)
 	defer cleanUp()
 
 	actualRepositories, err := client.ListRepositories(ctx)
@@ -68,19 +66,176 @@ func TestBitbucketCloud_ListRepositories(t *testing.T) {
 	assert.Equal(t, map[string][]string{username: {repo1, repo2}}, actualRepositories)
 }
 
+func TestBitbucketCloud_StreamRepositories(t *testing.T) {
+	ctx := context.Background()
+	query := "q=is_private+%3D+true+AND+updated_on+%3E%3D+2023-01-01T00%3A00%3A00Z+AND+name+~+%22repo%22&role=member"
+	client, _, cleanUp := createRoutingServerAndClient(t, vcsutils.BitbucketCloud, true, map[string]interface{}{
+		"/repositories?page=1&pagelen=100&" + query: []byte(`{"next":"page=2","values":[
+			{"full_name":"jfrog/repo-1","is_private":true,"mainbranch":{"name":"main"},"updated_on":"2023-02-01T00:00:00Z"}]}`),
+		"/repositories?page=2&pagelen=100&" + query: []byte(`{"values":[
+			{"full_name":"frogger/repo-2","is_private":true,"updated_on":"2023-03-01T00:00:00Z"}]}`),
+	})
+	defer cleanUp()
+
+	options := ListRepositoriesOptions{Visibility: vcsutils.PointerOf(Private), UpdatedSince: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), Search: "repo"}
+	repositories, err := collectRepositories(client.StreamRepositories(ctx, options))
+	assert.NoError(t, err)
+	assert.Equal(t, []RepositoryListItem{
+		{Owner: owner, Name: repo1, RepositoryInfo: RepositoryInfo{RepositoryVisibility: Private, DefaultBranch: "main", LastPushedAt: time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)}},
+		{Owner: username, Name: repo2, RepositoryInfo: RepositoryInfo{RepositoryVisibility: Private, LastPushedAt: time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC)}},
+	}, repositories)
+
+	// The repositories of an owner are listed from its workspace
+	client, _, cleanUp = createRoutingServerAndClient(t, vcsutils.BitbucketCloud, true, map[string]interface{}{
+		"/repositories/jfrog?page=1&pagelen=100": []byte(`{"values":[{"full_name":"jfrog/repo-1"}]}`),
+	})
+	defer cleanUp()
+	repositories, err = collectRepositories(client.StreamRepositories(ctx, ListRepositoriesOptions{Owner: owner}))
+	assert.NoError(t, err)
+	assert.Equal(t, []RepositoryListItem{{Owner: owner, Name: repo1}}, repositories)
+}
+
 func TestBitbucketCloud_ListBranches(t *testing.T) {
 	ctx := context.Background()
 	mockResponse := map[string][]bitbucket.BranchModel{
 		"values": {{Name: branch1}, {Name: branch2}},
 	}
-	client, cleanUp := createServerAndClient(t, vcsutils.BitbucketCloud, true, mockResponse, "/repositories/jfrog/repo-1/refs/branches?", createBitbucketCloudHandler)
+	client, cleanUp := createServerAndClient(t, vcsutils.BitbucketCloud, true, mockResponse, "/repositories/jfrog/repo-1/refs/branches?page=1", createBitbucketCloudHandler)
 	defer cleanUp()
 
-	actualRepositories, err := client.ListBranches(ctx, owner, repo1)
+	actualRepositories, err := client.ListBranches(owner, repo1).All(ctx)
 	assert.NoError(t, err)
 	assert.ElementsMatch(t, actualRepositories, []string{branch1, branch2})
 }
 
+func TestBitbucketCloud_CreateBranch(t *testing.T) {
+	ctx := context.Background()
+	client, _, cleanUp := createRoutingServerAndClient(t, vcsutils.BitbucketCloud, true, map[string]interface{}{
+		"/repositories/jfrog/repo-1/commits/" + branch2 + "?pagelen=1": map[string]interface{}{
+			"values": []map[string]interface{}{{"hash": commitHash}},
+		},
+		"/repositories/jfrog/repo-1/refs/branches": bitbucket.BranchModel{Name: branch1},
+	})
+	defer cleanUp()
+
+	err := client.CreateBranch(ctx, owner, repo1, branch1, branch2)
+	assert.NoError(t, err)
+}
+
+func TestBitbucketCloud_DeleteBranch(t *testing.T) {
+	ctx := context.Background()
+	client, cleanUp := createServerAndClient(t, vcsutils.BitbucketCloud, true, nil,
+		"/repositories/jfrog/repo-1/refs/branches/"+branch1, createBitbucketCloudHandler)
+	defer cleanUp()
+
+	err := client.DeleteBranch(ctx, owner, repo1, branch1)
+	assert.NoError(t, err)
+}
+
+func TestBitbucketCloud_GetBranch(t *testing.T) {
+	ctx := context.Background()
+	client, _, cleanUp := createRoutingServerAndClient(t, vcsutils.BitbucketCloud, true, map[string]interface{}{
+		"/repositories/jfrog/repo-1/refs/branches/" + branch1: map[string]interface{}{
+			"name":   branch1,
+			"target": map[string]interface{}{"hash": commitHash},
+		},
+		"/repositories/jfrog/repo-1": map[string]interface{}{
+			"slug":       repo1,
+			"mainbranch": map[string]interface{}{"name": branch2},
+		},
+		"/repositories/jfrog/repo-1/branch-restrictions?page=1": map[string]interface{}{
+			"values": []map[string]interface{}{{"kind": "push", "branch_match_kind": "glob", "pattern": "branch-*"}},
+		},
+	})
+	defer cleanUp()
+
+	branchDetails, err := client.GetBranch(ctx, owner, repo1, branch1)
+	assert.NoError(t, err)
+	assert.Equal(t, BranchDetails{Name: branch1, CommitSHA: commitHash, Protected: true}, branchDetails)
+}
+
+func TestBitbucketCloud_CommitFiles(t *testing.T) {
+	ctx := context.Background()
+	client, requestBodies, cleanUp := createRoutingServerAndClient(t, vcsutils.BitbucketCloud, true, map[string]interface{}{
+		"/repositories/jfrog/repo-1/src": []byte{},
+	})
+	defer cleanUp()
+
+	err := client.CommitFiles(ctx, owner, repo1, branch1, "Update files", []CommitFileChange{
+		{Action: UpdateFile, Path: "go.mod", Content: []byte("module example")},
+		{Action: DeleteFile, Path: "old.txt"},
+		{Action: MoveFile, Path: "new.txt", PreviousPath: "moved.txt", Content: []byte("moved")},
+	}, CommitAuthor{Name: "frogger", Email: "frogger@jfrog.com"})
+	assert.NoError(t, err)
+
+	body := string(requestBodies["POST /repositories/jfrog/repo-1/src"])
+	assert.Contains(t, body, "name=\"branch\"\r\n\r\n"+branch1+"\r\n")
+	assert.Contains(t, body, "name=\"author\"\r\n\r\nfrogger <frogger@jfrog.com>\r\n")
+	assert.Contains(t, body, "name=\"files\"\r\n\r\nold.txt\r\n")
+	assert.Contains(t, body, "name=\"files\"\r\n\r\nmoved.txt\r\n")
+	assert.Contains(t, body, "name=\"go.mod\"; filename=\"go.mod\"")
+	assert.Contains(t, body, "name=\"new.txt\"; filename=\"new.txt\"")
+	assert.NotContains(t, body, "name=\"old.txt\"")
+}
+
+func TestBitbucketCloud_ListTags(t *testing.T) {
+	ctx := context.Background()
+	client, _, cleanUp := createRoutingServerAndClient(t, vcsutils.BitbucketCloud, true, map[string]interface{}{
+		"/repositories/jfrog/repo-1/refs/tags?page=1": map[string]interface{}{
+			"values": []map[string]interface{}{{"name": "v1.0.0", "target": map[string]interface{}{"hash": commitHash}}},
+			"next":   "https://api.bitbucket.org/2.0/repositories/jfrog/repo-1/refs/tags?page=2",
+		},
+		"/repositories/jfrog/repo-1/refs/tags?page=2": map[string]interface{}{
+			"values": []map[string]interface{}{{"name": "v1.1.0", "target": map[string]interface{}{"hash": "1"}}},
+		},
+	})
+	defer cleanUp()
+
+	tags, err := client.ListTags(owner, repo1).All(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []TagInfo{{Name: "v1.0.0", CommitSHA: commitHash}, {Name: "v1.1.0", CommitSHA: "1"}}, tags)
+}
+
+func TestBitbucketCloud_CreateTag(t *testing.T) {
+	ctx := context.Background()
+	client, requestBodies, cleanUp := createRoutingServerAndClient(t, vcsutils.BitbucketCloud, true, map[string]interface{}{
+		"/repositories/jfrog/repo-1/commits/" + branch1 + "?pagelen=1": map[string]interface{}{
+			"values": []map[string]interface{}{{"hash": commitHash}},
+		},
+		"/repositories/jfrog/repo-1/refs/tags": map[string]interface{}{"name": "v1.0.0"},
+	})
+	defer cleanUp()
+
+	err := client.CreateTag(ctx, owner, repo1, "v1.0.0", branch1, "")
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"name":"v1.0.0","target":{"hash":"`+commitHash+`"}}`, string(requestBodies["POST /repositories/jfrog/repo-1/refs/tags"]))
+
+	var unsupportedErr *UnsupportedFeatureError
+	err = client.CreateTag(ctx, owner, repo1, "v1.0.0", branch1, "Release 1.0.0")
+	assert.True(t, errors.As(err, &unsupportedErr))
+}
+
+func TestBitbucketCloud_DeleteTag(t *testing.T) {
+	ctx := context.Background()
+	client, cleanUp := createServerAndClient(t, vcsutils.BitbucketCloud, true, nil,
+		"/repositories/jfrog/repo-1/refs/tags/v1.0.0", createBitbucketCloudHandler)
+	defer cleanUp()
+
+	err := client.DeleteTag(ctx, owner, repo1, "v1.0.0")
+	assert.NoError(t, err)
+}
+
+func TestBitbucketCloud_ReleasesNotSupported(t *testing.T) {
+	ctx := context.Background()
+	client, cleanUp := createServerAndClient(t, vcsutils.BitbucketCloud, true, nil, "", createBitbucketCloudHandler)
+	defer cleanUp()
+	var unsupportedErr *UnsupportedFeatureError
+	_, err := client.CreateRelease(ctx, owner, repo1, CreateReleaseOptions{TagName: "v1.0.0"})
+	assert.True(t, errors.As(err, &unsupportedErr))
+	_, err = client.ListReleases(owner, repo1).All(ctx)
+	assert.True(t, errors.As(err, &unsupportedErr))
+}
+
 func TestBitbucketCloud_CreateWebhook(t *testing.T) {
 	ctx := context.Background()
 	id, err := uuid.NewUUID()
@@ -120,17 +275,145 @@ func TestBitbucketCloud_DeleteWebhook(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestBitbucketCloud_ListWebhooks(t *testing.T) {
+	ctx := context.Background()
+	id, err := uuid.NewUUID()
+	assert.NoError(t, err)
+	webhook := map[string]interface{}{
+		"uuid":   "{" + id.String() + "}",
+		"url":    "https://jfrog.com/hook?token=abc",
+		"active": true,
+		"events": []string{"pullrequest:created", "repo:push"},
+	}
+	client, _, cleanUp := createRoutingServerAndClient(t, vcsutils.BitbucketCloud, true, map[string]interface{}{
+		"/repositories/jfrog/repo-1/hooks?page=1":                       map[string]interface{}{"values": []interface{}{webhook}},
+		fmt.Sprintf("/repositories/jfrog/repo-1/hooks/%s", id.String()): webhook,
+	})
+	defer cleanUp()
+
+	expected := WebhookInfo{
+		ID:         id.String(),
+		PayloadURL: "https://jfrog.com/hook",
+		Events:     []vcsutils.WebhookEvent{vcsutils.PrOpened, vcsutils.Push, vcsutils.TagPushed, vcsutils.TagDeleted, vcsutils.BranchCreated, vcsutils.BranchDeleted},
+		Active:     true,
+	}
+	webhooks, err := client.ListWebhooks(owner, repo1).All(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []WebhookInfo{expected}, webhooks)
+
+	webhookInfo, err := client.GetWebhook(ctx, owner, repo1, id.String())
+	require.NoError(t, err)
+	assert.Equal(t, expected, webhookInfo)
+
+	err = client.PingWebhook(ctx, owner, repo1, id.String())
+	assert.ErrorAs(t, err, new(*UnsupportedFeatureError))
+}
+
+func TestBitbucketCloud_OrganizationWebhooks(t *testing.T) {
+	ctx := context.Background()
+	id, err := uuid.NewUUID()
+	assert.NoError(t, err)
+	webhook := map[string]interface{}{
+		"uuid":   "{" + id.String() + "}",
+		"url":    "https://jfrog.com/hook?token=abc",
+		"active": true,
+		"events": []string{"repo:push"},
+	}
+	client, bodies, cleanUp := createRoutingServerAndClient(t, vcsutils.BitbucketCloud, true, map[string]interface{}{
+		"/workspaces/jfrog/hooks":                webhook,
+		"/workspaces/jfrog/hooks/" + id.String(): webhook,
+		"/workspaces/jfrog/hooks?page=1":         map[string]interface{}{"values": []interface{}{webhook}},
+	})
+	defer cleanUp()
+
+	webhookID, token, err := client.CreateOrganizationWebhook(ctx, owner, "https://jfrog.com/hook", vcsutils.Push)
+	require.NoError(t, err)
+	assert.Equal(t, id.String(), webhookID)
+	assert.JSONEq(t, `{"url":"https://jfrog.com/hook?token=`+token+`","active":true,"events":["repo:push"]}`,
+		string(bodies["POST /workspaces/jfrog/hooks"]))
+
+	err = client.UpdateOrganizationWebhook(ctx, owner, "https://jfrog.com/hook", token, webhookID, vcsutils.PrMerged)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"url":"https://jfrog.com/hook?token=`+token+`","active":true,"events":["pullrequest:fulfilled"]}`,
+		string(bodies["PUT /workspaces/jfrog/hooks/"+id.String()]))
+
+	webhooks, err := client.ListOrganizationWebhooks(owner).All(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []WebhookInfo{{ID: id.String(), PayloadURL: "https://jfrog.com/hook", Events: []vcsutils.WebhookEvent{vcsutils.Push, vcsutils.TagPushed, vcsutils.TagDeleted, vcsutils.BranchCreated, vcsutils.BranchDeleted}, Active: true}}, webhooks)
+
+	assert.NoError(t, client.DeleteOrganizationWebhook(ctx, owner, webhookID))
+}
+
 func TestBitbucketCloud_SetCommitStatus(t *testing.T) {
 	ctx := context.Background()
 	ref := "9caf1c431fb783b669f0f909bd018b40f2ea3808"
 	client, cleanUp := createServerAndClient(t, vcsutils.BitbucketCloud, true, nil, fmt.Sprintf("/repositories/jfrog/repo-1/commit/%s/statuses/build", ref), createBitbucketCloudHandler)
 	defer cleanUp()
 
-	err := client.SetCommitStatus(ctx, Pass, owner, repo1, ref, "Commit status title", "Commit status description",
-		"https://httpbin.org/anything")
+	err := client.SetCommitStatus(ctx, owner, repo1, ref, CommitStatusOptions{
+		State:       Cancelled,
+		Key:         "frogbot",
+		Name:        "Commit status title",
+		Description: "Commit status description",
+		DetailsURL:  "https://httpbin.org/anything",
+	})
 	assert.NoError(t, err)
 }
 
+func TestBitbucketCloud_ListCommitStatuses(t *testing.T) {
+	ctx := context.Background()
+	ref := "9caf1c431fb783b669f0f909bd018b40f2ea3808"
+	client, _, cleanUp := createRoutingServerAndClient(t, vcsutils.BitbucketCloud, true, map[string]interface{}{
+		fmt.Sprintf("/repositories/jfrog/repo-1/commit/%s/statuses?page=1", ref): []byte(`{"values":[
+			{"state":"INPROGRESS","key":"build","name":"Build","url":"https://ci.example.com/build/1","description":"Building",
+			"created_on":"2023-01-01T10:00:00+00:00","updated_on":"2023-01-01T10:01:00+00:00"}],
+			"next":"https://api.bitbucket.org/2.0/repositories/jfrog/repo-1/commit/9caf1c4/statuses?page=2"}`),
+		fmt.Sprintf("/repositories/jfrog/repo-1/commit/%s/statuses?page=2", ref): []byte(`{"values":[
+			{"state":"STOPPED","key":"scan","created_on":"2023-01-01T10:00:00+00:00","updated_on":"2023-01-01T10:00:00+00:00"}]}`),
+	})
+	defer cleanUp()
+
+	statuses, err := client.ListCommitStatuses(owner, repo1, ref).All(ctx)
+	require.NoError(t, err)
+	createdAt := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+	assert.Equal(t, []CommitStatusInfo{
+		{State: InProgress, Context: "build", Description: "Building", DetailsURL: "https://ci.example.com/build/1", CreatedAt: createdAt, LastUpdatedAt: createdAt.Add(time.Minute)},
+		{State: Cancelled, Context: "scan", CreatedAt: createdAt, LastUpdatedAt: createdAt},
+	}, statuses)
+
+	combined, err := client.GetCombinedCommitStatus(ctx, owner, repo1, ref)
+	require.NoError(t, err)
+	assert.Equal(t, Cancelled, combined.State)
+}
+
+func TestBitbucketCloud_CreateCheckRun(t *testing.T) {
+	ctx := context.Background()
+	ref := "9caf1c431fb783b669f0f909bd018b40f2ea3808"
+	reportURI := fmt.Sprintf("/repositories/jfrog/repo-1/commit/%s/reports/frogbot", ref)
+	client, bodies, cleanUp := createRoutingServerAndClient(t, vcsutils.BitbucketCloud, true, map[string]interface{}{
+		reportURI:                  []byte("{}"),
+		reportURI + "/annotations": []byte("[]"),
+	})
+	defer cleanUp()
+
+	checkRun := CheckRunOptions{
+		Name:        "frogbot",
+		CommitSHA:   ref,
+		State:       Pass,
+		Annotations: []CheckRunAnnotation{{Path: "main.go", StartLine: 3, Level: NoticeAnnotation, Message: "Outdated dependency"}},
+	}
+	checkRunID, err := client.CreateCheckRun(ctx, owner, repo1, checkRun)
+	require.NoError(t, err)
+	assert.Equal(t, "frogbot", checkRunID)
+	assert.JSONEq(t, `{"title":"frogbot","report_type":"TEST","result":"PASSED"}`, string(bodies[http.MethodPut+" "+reportURI]))
+	assert.JSONEq(t, fmt.Sprintf(`[{"external_id":"%s","annotation_type":"BUG","summary":"Outdated dependency","path":"main.go","line":3,"severity":"LOW"}]`,
+		getBitbucketAnnotationExternalID(checkRun.Annotations[0])), string(bodies[http.MethodPost+" "+reportURI+"/annotations"]))
+
+	err = client.UpdateCheckRun(ctx, owner, repo1, checkRunID, CheckRunOptions{CommitSHA: ref, State: InProgress, Title: "Scanning"})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"title":"Scanning","report_type":"TEST","result":"PENDING"}`, string(bodies[http.MethodPut+" "+reportURI]))
+}
+
 func TestBitbucketCloud_DownloadRepository(t *testing.T) {
 	ctx := context.Background()
 	dir, err := os.MkdirTemp("", "")
@@ -160,10 +443,10 @@ func TestBitbucketCloud_ListOpenPullRequests(t *testing.T) {
 	response, err := os.ReadFile(filepath.Join("testdata", "bitbucketcloud", "pull_requests_list_response.json"))
 	assert.NoError(t, err)
 	client, cleanUp := createServerAndClient(t, vcsutils.BitbucketCloud, true, response,
-		fmt.Sprintf("/repositories/%s/%s/pullrequests/?state=OPEN", owner, repo1), createBitbucketCloudHandler)
+		fmt.Sprintf("/repositories/%s/%s/pullrequests?page=1&state=OPEN", owner, repo1), createBitbucketCloudHandler)
 	defer cleanUp()
 
-	result, err := client.ListOpenPullRequests(ctx, owner, repo1)
+	result, err := client.ListOpenPullRequests(owner, repo1).All(ctx)
 
 	require.NoError(t, err)
 	assert.Len(t, result, 3)
@@ -188,10 +471,10 @@ func TestBitbucketCloud_ListPullRequestComments(t *testing.T) {
 	response, err := os.ReadFile(filepath.Join("testdata", "bitbucketcloud", "pull_request_comments_list_response.json"))
 	assert.NoError(t, err)
 	client, cleanUp := createServerAndClient(t, vcsutils.BitbucketCloud, true, response,
-		fmt.Sprintf("/repositories/%s/%s/pullrequests/1/comments/", owner, repo1), createBitbucketCloudHandler)
+		fmt.Sprintf("/repositories/%s/%s/pullrequests/1/comments?page=1", owner, repo1), createBitbucketCloudHandler)
 	defer cleanUp()
 
-	result, err := client.ListPullRequestComments(ctx, owner, repo1, 1)
+	result, err := client.ListPullRequestComments(owner, repo1, 1).All(ctx)
 
 	require.NoError(t, err)
 	expectedCreated, err := time.Parse(time.RFC3339, "2022-05-16T11:04:07.075827+00:00")
@@ -204,6 +487,99 @@ func TestBitbucketCloud_ListPullRequestComments(t *testing.T) {
 	}, result[0])
 }
 
+func TestBitbucketCloud_EditPullRequestComment(t *testing.T) {
+	ctx := context.Background()
+	expectedBody := []byte(`{"content":{"raw":"Comment content"}}` + "\n")
+	client, cleanUp := createBodyHandlingServerAndClient(t, vcsutils.BitbucketCloud, true, nil,
+		fmt.Sprintf("/repositories/%s/%s/pullrequests/1/comments/2", owner, repo1), http.StatusOK,
+		expectedBody, http.MethodPut, createBitbucketCloudWithBodyHandler)
+	defer cleanUp()
+
+	err := client.EditPullRequestComment(ctx, owner, repo1, 1, 2, "Comment content")
+	assert.NoError(t, err)
+}
+
+func TestBitbucketCloud_DeletePullRequestComment(t *testing.T) {
+	ctx := context.Background()
+	client, cleanUp := createBodyHandlingServerAndClient(t, vcsutils.BitbucketCloud, true, nil,
+		fmt.Sprintf("/repositories/%s/%s/pullrequests/1/comments/2", owner, repo1), http.StatusOK,
+		[]byte{}, http.MethodDelete, createBitbucketCloudWithBodyHandler)
+	defer cleanUp()
+
+	err := client.DeletePullRequestComment(ctx, owner, repo1, 1, 2)
+	assert.NoError(t, err)
+}
+
+func TestBitbucketCloud_AddPullRequestReviewComments(t *testing.T) {
+	ctx := context.Background()
+	expectedBody := []byte(`{"content":{"raw":"Comment content"},"inline":{"path":"file1.txt","to":5}}` + "\n")
+	client, cleanUp := createBodyHandlingServerAndClient(t, vcsutils.BitbucketCloud, true, nil,
+		fmt.Sprintf("/repositories/%s/%s/pullrequests/1/comments", owner, repo1), http.StatusCreated,
+		expectedBody, http.MethodPost, createBitbucketCloudWithBodyHandler)
+	defer cleanUp()
+
+	err := client.AddPullRequestReviewComments(ctx, owner, repo1, 1, []ReviewComment{{Path: "file1.txt", Line: 5, Content: "Comment content"}})
+	assert.NoError(t, err)
+}
+
+func TestBitbucketCloud_ListPullRequestReviewComments(t *testing.T) {
+	ctx := context.Background()
+	response, err := os.ReadFile(filepath.Join("testdata", "bitbucketcloud", "pull_request_review_comments_list_response.json"))
+	assert.NoError(t, err)
+	client, cleanUp := createServerAndClient(t, vcsutils.BitbucketCloud, true, response,
+		fmt.Sprintf("/repositories/%s/%s/pullrequests/1/comments?page=1", owner, repo1), createBitbucketCloudHandler)
+	defer cleanUp()
+
+	result, err := client.ListPullRequestReviewComments(owner, repo1, 1).All(ctx)
+
+	require.NoError(t, err)
+	expectedCreated, err := time.Parse(time.RFC3339, "2022-05-16T11:04:07.075827+00:00")
+	assert.NoError(t, err)
+	assert.Equal(t, []ReviewCommentInfo{{
+		ID:      301545840,
+		Content: "Consider renaming this variable",
+		Created: expectedCreated,
+		Path:    "src/main.go",
+		Line:    12,
+		Side:    RightSide,
+	}}, result)
+}
+
+func TestBitbucketCloud_ListPullRequestFiles(t *testing.T) {
+	ctx := context.Background()
+	response, err := os.ReadFile(filepath.Join("testdata", "bitbucketcloud", "pull_request_diffstat_response.json"))
+	assert.NoError(t, err)
+	client, cleanUp := createServerAndClient(t, vcsutils.BitbucketCloud, true, response,
+		fmt.Sprintf("/repositories/%s/%s/pullrequests/1/diffstat?page=1", owner, repo1), createBitbucketCloudHandler)
+	defer cleanUp()
+
+	result, err := client.ListPullRequestFiles(owner, repo1, 1).All(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []vcsutils.FileDiff{
+		{Path: "src/main.go", Status: vcsutils.FileModified, Additions: 1, Deletions: 1},
+		{Path: "old.txt", Status: vcsutils.FileRemoved, Deletions: 2},
+		{Path: "after.txt", PreviousPath: "before.txt", Status: vcsutils.FileRenamed},
+	}, result)
+}
+
+func TestBitbucketCloud_GetPullRequestDiff(t *testing.T) {
+	ctx := context.Background()
+	response, err := os.ReadFile(filepath.Join("testdata", "bitbucketcloud", "pull_request_diff_response.diff"))
+	assert.NoError(t, err)
+	client, cleanUp := createServerAndClient(t, vcsutils.BitbucketCloud, true, response,
+		fmt.Sprintf("/repositories/%s/%s/pullrequests/1/diff", owner, repo1), createBitbucketCloudHandler)
+	defer cleanUp()
+
+	result, err := client.GetPullRequestDiff(ctx, owner, repo1, 1)
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+	assert.Equal(t, "src/main.go", result[0].Path)
+	assert.Equal(t, 1, result[0].Additions)
+	assert.Equal(t, 1, result[0].Deletions)
+	assert.Len(t, result[0].Hunks, 1)
+	assert.Equal(t, vcsutils.FileDiff{Path: "new.txt", PreviousPath: "old.txt", Status: vcsutils.FileRenamed}, result[1])
+}
+
 func TestBitbucketCloud_GetLatestCommit(t *testing.T) {
 	ctx := context.Background()
 	response, err := os.ReadFile(filepath.Join("testdata", "bitbucketcloud", "commit_list_response.json"))
@@ -219,14 +595,72 @@ func TestBitbucketCloud_GetLatestCommit(t *testing.T) {
 	assert.Equal(t, CommitInfo{
 		Hash:          "ec05bacb91d757b4b6b2a11a0676471020e89fb5",
 		AuthorName:    "user",
+		AuthorEmail:   "user@example.com",
 		CommitterName: "",
 		Url:           "https://api.bitbucket.org/2.0/repositories/user2/setup-jfrog-cli/commit/ec05bacb91d757b4b6b2a11a0676471020e89fb5",
+		WebURL:        "https://bitbucket.org/user2/setup-jfrog-cli/commits/ec05bacb91d757b4b6b2a11a0676471020e89fb5",
 		Timestamp:     1591040823,
+		AuthorDate:    time.Date(2020, 6, 1, 19, 47, 3, 0, time.UTC),
 		Message:       "Fix README.md: yaml\n",
 		ParentHashes:  []string{"774aa0fb252bccbc2a7e01060ef4d4be0b0eeaa9", "def26c6128ebe11fac555fe58b59227e9655dc4d"},
 	}, result)
 }
 
+func TestBitbucketCloud_ListCommits(t *testing.T) {
+	ctx := context.Background()
+	response, err := os.ReadFile(filepath.Join("testdata", "bitbucketcloud", "commit_list_response.json"))
+	assert.NoError(t, err)
+
+	client, cleanUp := createServerAndClient(t, vcsutils.BitbucketCloud, true, response,
+		fmt.Sprintf("/repositories/%s/%s/commits/master?page=1&pagelen=20&path=README.md", owner, repo1), createBitbucketCloudHandler)
+	defer cleanUp()
+
+	// The commits are filtered by the author and by the commit time after listing
+	options := ListCommitsOptions{
+		Ref:      "master",
+		Path:     "README.md",
+		Author:   "user@example.com",
+		Since:    time.Date(2020, 6, 1, 19, 40, 0, 0, time.UTC),
+		PageSize: 20,
+	}
+	result, err := client.ListCommits(owner, repo1, options).All(ctx)
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+	assert.Equal(t, CommitInfo{
+		Hash:          "ec05bacb91d757b4b6b2a11a0676471020e89fb5",
+		AuthorName:    "user",
+		AuthorEmail:   "user@example.com",
+		CommitterName: "",
+		Url:           "https://api.bitbucket.org/2.0/repositories/user2/setup-jfrog-cli/commit/ec05bacb91d757b4b6b2a11a0676471020e89fb5",
+		WebURL:        "https://bitbucket.org/user2/setup-jfrog-cli/commits/ec05bacb91d757b4b6b2a11a0676471020e89fb5",
+		Timestamp:     1591040823,
+		AuthorDate:    time.Date(2020, 6, 1, 19, 47, 3, 0, time.UTC),
+		Message:       "Fix README.md: yaml\n",
+		ParentHashes:  []string{"774aa0fb252bccbc2a7e01060ef4d4be0b0eeaa9", "def26c6128ebe11fac555fe58b59227e9655dc4d"},
+	}, result[0])
+}
+
+func TestBitbucketCloud_ListCommitsSince(t *testing.T) {
+	ctx := context.Background()
+	// The next page isn't requested, since the listing stops at the first commit committed before Since
+	client, _, cleanUp := createRoutingServerAndClient(t, vcsutils.BitbucketCloud, true, map[string]interface{}{
+		fmt.Sprintf("/repositories/%s/%s/commits?page=1", owner, repo1): commitResponse{
+			Values: []commitDetails{
+				{Hash: "new", Date: time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)},
+				{Hash: "old", Date: time.Date(2022, 12, 30, 0, 0, 0, 0, time.UTC)},
+			},
+			Next: fmt.Sprintf("https://api.bitbucket.org/2.0/repositories/%s/%s/commits?page=2", owner, repo1),
+		},
+	})
+	defer cleanUp()
+
+	options := ListCommitsOptions{Since: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)}
+	result, err := client.ListCommits(owner, repo1, options).All(ctx)
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	assert.Equal(t, "new", result[0].Hash)
+}
+
 func TestBitbucketCloud_GetLatestCommitNotFound(t *testing.T) {
 	ctx := context.Background()
 	response := []byte(`<!DOCTYPE html><html lang="en"></html>`)
@@ -321,9 +755,12 @@ func TestBitbucketCloud_GetCommitBySha(t *testing.T) {
 	assert.Equal(t, CommitInfo{
 		Hash:          sha,
 		AuthorName:    "user",
+		AuthorEmail:   "user@example.com",
 		CommitterName: "",
 		Url:           "https://api.bitbucket.org/2.0/repositories/user2/setup-jfrog-cli/commit/f62ea5359e7af59880b4a5e23e0ce6c1b32b5d3c",
+		WebURL:        "https://bitbucket.org/user2/setup-jfrog-cli/commits/f62ea5359e7af59880b4a5e23e0ce6c1b32b5d3c",
 		Timestamp:     1591030449,
+		AuthorDate:    time.Date(2020, 6, 1, 16, 54, 9, 0, time.UTC),
 		Message:       "Update image name\n",
 		ParentHashes:  []string{"f62ea5359e7af59880b4a5e23e0ce6c1b32b5d3c"},
 	}, result)
@@ -381,18 +818,85 @@ func TestBitbucketCloud_GetRepositoryInfo(t *testing.T) {
 				HTTP: "https://bitbucket.org/jfrog/jfrog-setup-cli.git",
 				SSH:  "git@bitbucket.org:jfrog/jfrog-setup-cli.git",
 			},
+			DefaultBranch: "master",
+			WebURL:        "https://bitbucket.org/jfrog/jfrog-setup-cli",
+			Size:          458232,
+			CreatedAt:     time.Date(2020, 6, 1, 18, 20, 0, 848673000, time.UTC),
+			LastPushedAt:  time.Date(2020, 6, 22, 12, 32, 49, 604972000, time.UTC),
 		},
 		res,
 	)
 }
 
+func TestBitbucketCloud_GetRepositoryInfoFork(t *testing.T) {
+	ctx := context.Background()
+	response := []byte(`{"is_private":true,"language":"go","mainbranch":null,"parent":{"full_name":"frogs/upstream"}}`)
+	client, cleanUp := createServerAndClientReturningStatus(t, vcsutils.BitbucketCloud, true, response,
+		fmt.Sprintf("/repositories/%s/%s", owner, repo1), http.StatusOK, createBitbucketCloudHandler)
+	defer cleanUp()
+
+	res, err := client.GetRepositoryInfo(ctx, owner, repo1)
+	require.NoError(t, err)
+	assert.Equal(t, Private, res.RepositoryVisibility)
+	assert.Empty(t, res.DefaultBranch)
+	assert.Equal(t, "go", res.Language)
+	assert.True(t, res.Fork)
+	assert.Equal(t, &RepositoryReference{Owner: "frogs", Name: "upstream"}, res.Parent)
+}
+
+func TestBitbucketCloud_CreateRepository(t *testing.T) {
+	ctx := context.Background()
+	client, requestBodies, cleanUp := createRoutingServerAndClient(t, vcsutils.BitbucketCloud, true, map[string]interface{}{
+		"/repositories/jfrog/new-repo":     map[string]interface{}{"slug": "new-repo"},
+		"/repositories/jfrog/new-repo/src": []byte{},
+	})
+	defer cleanUp()
+
+	err := client.CreateRepository(ctx, owner, CreateRepositoryOptions{Name: "new-repo", Visibility: Internal, DefaultBranch: "develop", InitReadme: true})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"name":"new-repo","scm":"git","is_private":true}`, string(requestBodies["POST /repositories/jfrog/new-repo"]))
+	readmeBody := string(requestBodies["POST /repositories/jfrog/new-repo/src"])
+	assert.Contains(t, readmeBody, "name=\"branch\"\r\n\r\ndevelop\r\n")
+	assert.Contains(t, readmeBody, "name=\"README.md\"; filename=\"README.md\"")
+}
+
+func TestBitbucketCloud_ForkRepository(t *testing.T) {
+	ctx := context.Background()
+	client, requestBodies, cleanUp := createRoutingServerAndClient(t, vcsutils.BitbucketCloud, true, map[string]interface{}{
+		"/repositories/jfrog/repo-1/forks": map[string]interface{}{"slug": repo1},
+	})
+	defer cleanUp()
+
+	err := client.ForkRepository(ctx, owner, repo1, "frogs")
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"workspace":{"slug":"frogs"}}`, string(requestBodies["POST /repositories/jfrog/repo-1/forks"]))
+}
+
+func TestBitbucketCloud_ArchiveRepository(t *testing.T) {
+	ctx := context.Background()
+	client, cleanUp := createServerAndClient(t, vcsutils.BitbucketCloud, true, nil, "", createBitbucketCloudHandler)
+	defer cleanUp()
+	var unsupportedErr *UnsupportedFeatureError
+	err := client.ArchiveRepository(ctx, owner, repo1)
+	assert.True(t, errors.As(err, &unsupportedErr))
+}
+
+func TestBitbucketCloud_DeleteRepository(t *testing.T) {
+	ctx := context.Background()
+	client, cleanUp := createServerAndClient(t, vcsutils.BitbucketCloud, true, nil, "/repositories/jfrog/repo-1", createBitbucketCloudHandler)
+	defer cleanUp()
+
+	err := client.DeleteRepository(ctx, owner, repo1)
+	assert.NoError(t, err)
+}
+
 func TestBitbucketCloud_CreateLabel(t *testing.T) {
 	ctx := context.Background()
 	client, err := NewClientBuilder(vcsutils.BitbucketCloud).Build()
 	assert.NoError(t, err)
 
 	err = client.CreateLabel(ctx, owner, repo1, LabelInfo{})
-	assert.ErrorIs(t, err, errLabelsNotSupported)
+	assert.ErrorIs(t, err, errBitbucketCloudLabelsNotSupported)
 }
 
 func TestBitbucketCloudClient_DownloadFileFromRepo(t *testing.T) {
@@ -410,7 +914,7 @@ func TestBitbucketCloud_GetLabel(t *testing.T) {
 	assert.NoError(t, err)
 
 	_, err = client.GetLabel(ctx, owner, repo1, labelName)
-	assert.ErrorIs(t, err, errLabelsNotSupported)
+	assert.ErrorIs(t, err, errBitbucketCloudLabelsNotSupported)
 }
 
 func TestBitbucketCloud_ListPullRequestLabels(t *testing.T) {
@@ -418,8 +922,23 @@ func TestBitbucketCloud_ListPullRequestLabels(t *testing.T) {
 	client, err := NewClientBuilder(vcsutils.BitbucketCloud).Build()
 	assert.NoError(t, err)
 
-	_, err = client.ListPullRequestLabels(ctx, owner, repo1, 1)
-	assert.ErrorIs(t, err, errLabelsNotSupported)
+	_, err = client.ListPullRequestLabels(owner, repo1, 1).All(ctx)
+	assert.ErrorIs(t, err, errBitbucketCloudLabelsNotSupported)
+}
+
+func TestBitbucketCloud_LabelsManagement(t *testing.T) {
+	ctx := context.Background()
+	client, err := NewClientBuilder(vcsutils.BitbucketCloud).Build()
+	assert.NoError(t, err)
+
+	_, err = client.ListLabels(owner, repo1).All(ctx)
+	assert.ErrorIs(t, err, errBitbucketCloudLabelsNotSupported)
+	err = client.UpdateLabel(ctx, owner, repo1, labelName, LabelInfo{})
+	assert.ErrorIs(t, err, errBitbucketCloudLabelsNotSupported)
+	err = client.DeleteLabel(ctx, owner, repo1, labelName)
+	assert.ErrorIs(t, err, errBitbucketCloudLabelsNotSupported)
+	err = client.AddPullRequestLabels(ctx, owner, repo1, 1, labelName)
+	assert.ErrorIs(t, err, errBitbucketCloudLabelsNotSupported)
 }
 
 func TestBitbucketCloud_UnlabelPullRequest(t *testing.T) {
@@ -428,7 +947,7 @@ func TestBitbucketCloud_UnlabelPullRequest(t *testing.T) {
 	assert.NoError(t, err)
 
 	err = client.UnlabelPullRequest(ctx, owner, repo1, labelName, 1)
-	assert.ErrorIs(t, err, errLabelsNotSupported)
+	assert.ErrorIs(t, err, errBitbucketCloudLabelsNotSupported)
 }
 
 func TestBitbucketCloud_GetRepositoryEnvironmentInfo(t *testing.T) {
@@ -441,8 +960,8 @@ func TestBitbucketCloud_GetRepositoryEnvironmentInfo(t *testing.T) {
 }
 
 func TestBitbucketCloud_getRepositoryVisibility(t *testing.T) {
-	assert.Equal(t, Private, getBitbucketCloudRepositoryVisibility(&bitbucket.Repository{Is_private: true}))
-	assert.Equal(t, Public, getBitbucketCloudRepositoryVisibility(&bitbucket.Repository{Is_private: false}))
+	assert.Equal(t, Private, getBitbucketCloudRepositoryVisibility(true))
+	assert.Equal(t, Public, getBitbucketCloudRepositoryVisibility(false))
 }
 
 func TestBitbucketCloudClient_GetModifiedFiles(t *testing.T) {
@@ -490,6 +1009,23 @@ func TestBitbucketCloudClient_GetModifiedFiles(t *testing.T) {
 	})
 }
 
+func TestBitbucketCloudClient_GetFileChanges(t *testing.T) {
+	ctx := context.Background()
+	response, err := os.ReadFile(filepath.Join("testdata", "bitbucketcloud", "compare_commits.json"))
+	assert.NoError(t, err)
+	client, cleanUp := createServerAndClient(t, vcsutils.BitbucketCloud, true, response,
+		fmt.Sprintf("/repositories/%s/%s/diffstat/sha-1..sha-2?page=1", owner, repo1), createBitbucketCloudHandler)
+	defer cleanUp()
+
+	fileChanges, err := client.GetFileChanges(ctx, owner, repo1, "sha-1", "sha-2")
+	require.NoError(t, err)
+	assert.Equal(t, []FileChange{
+		{Path: "setup.py", Status: vcsutils.FileModified},
+		{Path: "some/full.py", Status: vcsutils.FileModified},
+		{Path: "some/full.py", Status: vcsutils.FileModified},
+	}, fileChanges)
+}
+
 func createBitbucketCloudHandler(t *testing.T, expectedURI string, response []byte, expectedStatusCode int) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(expectedStatusCode)
@@ -508,3 +1044,9 @@ func createBitbucketCloudHandler(t *testing.T, expectedURI string, response []by
 		assert.Equal(t, basicAuthHeader, r.Header.Get("Authorization"))
 	}
 }
+
+func TestGetBitbucketCloudWebhookEvents(t *testing.T) {
+	assert.Equal(t, []string{"repo:push", "pullrequest:comment_created", "pullrequest:changes_request_created", "pullrequest:approved"},
+		getBitbucketCloudWebhookEvents(vcsutils.Push, vcsutils.TagDeleted, vcsutils.BranchDeleted, vcsutils.PrCommentAdded,
+			vcsutils.PrReviewSubmitted, vcsutils.PrApproved, vcsutils.ReleasePublished))
+}
@@ -1,23 +1,63 @@
 package vcsclient
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
+	"fmt"
+
+	"github.com/jfrog/froggit-go/vcsutils"
 )
 
-var errLabelsNotSupported = errors.New("labels are not supported on Bitbucket")
+// The maximal number of Code Insights annotations in a request
+const bitbucketAnnotationsBatchSize = 100
+
+var errBitbucketServerLabelsNotSupported = &UnsupportedFeatureError{Provider: vcsutils.BitbucketServer, Feature: "labels"}
+var errBitbucketCloudLabelsNotSupported = &UnsupportedFeatureError{Provider: vcsutils.BitbucketCloud, Feature: "labels"}
 var errBitbucketCodeScanningNotSupported = errors.New("code scanning is not supported on Bitbucket")
 
 var errBitbucketDownloadFileFromRepoNotSupported = errors.New("download file from repo is currently not supported on Bitbucket")
 var errBitbucketGetRepoEnvironmentInfoNotSupported = errors.New("get repository environment info is currently not supported on Bitbucket")
 
+// getBitbucketCommitState returns the state of a Bitbucket build status.
+// Bitbucket server doesn't have a cancelled state, and therefore a cancelled build status fails.
 func getBitbucketCommitState(commitState CommitStatus) string {
 	switch commitState {
-	case Pass:
+	case Pass, Skipped:
 		return "SUCCESSFUL"
-	case Fail, Error:
+	case Fail, Error, Cancelled:
 		return "FAILED"
 	case InProgress:
 		return "INPROGRESS"
 	}
 	return ""
 }
+
+func mapBitbucketCommitState(state string) CommitStatus {
+	switch state {
+	case "SUCCESSFUL":
+		return Pass
+	case "FAILED":
+		return Fail
+	case "STOPPED", "CANCELLED":
+		return Cancelled
+	}
+	return InProgress
+}
+
+func getBitbucketAnnotationSeverity(level AnnotationLevel) string {
+	switch level {
+	case WarningAnnotation:
+		return "MEDIUM"
+	case FailureAnnotation:
+		return "HIGH"
+	}
+	return "LOW"
+}
+
+// getBitbucketAnnotationExternalID returns an ID derived from the annotation content,
+// so that publishing the same annotation again replaces it rather than duplicating it.
+func getBitbucketAnnotationExternalID(annotation CheckRunAnnotation) string {
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%s:%d:%s:%s", annotation.Path, annotation.StartLine, annotation.Title, annotation.Message)))
+	return hex.EncodeToString(hash[:16])
+}
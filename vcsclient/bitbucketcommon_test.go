@@ -11,5 +11,7 @@ func TestBitbucketClient_getBitbucketCommitState(t *testing.T) {
 	assert.Equal(t, "FAILED", getBitbucketCommitState(Fail))
 	assert.Equal(t, "FAILED", getBitbucketCommitState(Error))
 	assert.Equal(t, "INPROGRESS", getBitbucketCommitState(InProgress))
-	assert.Equal(t, "", getBitbucketCommitState(5))
+	assert.Equal(t, "SUCCESSFUL", getBitbucketCommitState(Skipped))
+	assert.Equal(t, "FAILED", getBitbucketCommitState(Cancelled))
+	assert.Equal(t, "", getBitbucketCommitState(7))
 }
@@ -6,24 +6,30 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"github.com/jfrog/gofrog/datastructures"
 	"io"
+	"mime/multipart"
 	"net/http"
-	"sort"
+	"net/url"
+	"path"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	bitbucketv1 "github.com/gfleury/go-bitbucket-v1"
 	"github.com/jfrog/froggit-go/vcsutils"
-	"github.com/mitchellh/mapstructure"
 	"golang.org/x/oauth2"
 )
 
+var errBitbucketServerCommitActionNotSupported = &UnsupportedFeatureError{Provider: vcsutils.BitbucketServer, Feature: "deleting and moving files"}
+
 // BitbucketServerClient API version 1.0
 type BitbucketServerClient struct {
 	vcsInfo VcsInfo
 	logger  Log
+	// Whether the server supports build statuses in repositories, detected once by its version
+	repositoryBuildStatusSupported *bool
+	buildStatusMutex               sync.Mutex
 }
 
 // NewBitbucketServerClient create a new BitbucketServerClient
@@ -36,18 +42,21 @@ func NewBitbucketServerClient(vcsInfo VcsInfo, logger Log) (*BitbucketServerClie
 }
 
 func (client *BitbucketServerClient) buildBitbucketClient(ctx context.Context) (*bitbucketv1.DefaultApiService, error) {
-	// Bitbucket API Endpoint ends with '/rest'
-	if !strings.HasSuffix(client.vcsInfo.APIEndpoint, "/rest") {
-		client.vcsInfo.APIEndpoint += "/rest"
-	}
-
 	bbClient := bitbucketv1.NewAPIClient(ctx, &bitbucketv1.Configuration{
 		HTTPClient: client.buildHTTPClient(ctx),
-		BasePath:   client.vcsInfo.APIEndpoint,
+		BasePath:   client.restEndpoint(),
 	})
 	return bbClient.DefaultApi, nil
 }
 
+// restEndpoint returns the REST API endpoint of the server, which ends with '/rest'
+func (client *BitbucketServerClient) restEndpoint() string {
+	if strings.HasSuffix(client.vcsInfo.APIEndpoint, "/rest") {
+		return client.vcsInfo.APIEndpoint
+	}
+	return client.vcsInfo.APIEndpoint + "/rest"
+}
+
 func (client *BitbucketServerClient) buildHTTPClient(ctx context.Context) *http.Client {
 	httpClient := &http.Client{}
 	if client.vcsInfo.Token != "" {
@@ -74,43 +83,128 @@ func (client *BitbucketServerClient) ListRepositories(ctx context.Context) (map[
 	if err != nil {
 		return nil, err
 	}
-	projects, err := client.listProjects(bitbucketClient)
+	projects, err := client.listProjects(ctx, bitbucketClient)
 	if err != nil {
 		return nil, err
 	}
 
 	results := make(map[string][]string)
 	for _, project := range projects {
-		var apiResponse *bitbucketv1.APIResponse
-		for isLastReposPage, nextReposPageStart := true, 0; isLastReposPage; isLastReposPage, nextReposPageStart = bitbucketv1.HasNextPage(apiResponse) {
-			// Get all repositories for which the authenticated user has the REPO_READ permission
-			apiResponse, err = bitbucketClient.GetRepositoriesWithOptions(project, createPaginationOptions(nextReposPageStart))
+		// Get all repositories for which the authenticated user has the REPO_READ permission
+		repositories, err := newNumberedPager(0, func(ctx context.Context, start int) ([]string, int, error) {
+			apiResponse, err := bitbucketClient.GetRepositoriesWithOptions(project, createPaginationOptions(start))
 			if err != nil {
-				return nil, err
+				return nil, 0, err
 			}
-
 			repos, err := bitbucketv1.GetRepositoriesResponse(apiResponse)
 			if err != nil {
-				return nil, err
+				return nil, 0, err
 			}
+			slugs := make([]string, 0, len(repos))
 			for _, repo := range repos {
-				results[project] = append(results[project], repo.Slug)
+				slugs = append(slugs, repo.Slug)
 			}
+			_, nextPageStart := bitbucketv1.HasNextPage(apiResponse)
+			return slugs, nextPageStart, nil
+		}).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if len(repositories) > 0 {
+			results[project] = repositories
 		}
 	}
 	return results, nil
 }
 
 // ListBranches on Bitbucket server
-func (client *BitbucketServerClient) ListBranches(ctx context.Context, owner, repository string) ([]string, error) {
+func (client *BitbucketServerClient) ListBranches(owner, repository string) *Pager[string] {
+	return newNumberedPager(0, func(ctx context.Context, start int) ([]string, int, error) {
+		bitbucketClient, err := client.buildBitbucketClient(ctx)
+		if err != nil {
+			return nil, 0, err
+		}
+		apiResponse, err := bitbucketClient.GetBranches(owner, repository, createPaginationOptions(start))
+		if err != nil {
+			return nil, 0, err
+		}
+		branches, err := bitbucketv1.GetBranchesResponse(apiResponse)
+		if err != nil {
+			return nil, 0, err
+		}
+		results := make([]string, 0, len(branches))
+		for _, branch := range branches {
+			results = append(results, branch.ID)
+		}
+		_, nextPageStart := bitbucketv1.HasNextPage(apiResponse)
+		return results, nextPageStart, nil
+	})
+}
+
+// CreateBranch on Bitbucket server
+func (client *BitbucketServerClient) CreateBranch(ctx context.Context, owner, repository, branch, fromRef string) error {
+	err := validateParametersNotBlank(map[string]string{
+		"owner":      owner,
+		"repository": repository,
+		"branch":     branch,
+		"fromRef":    fromRef,
+	})
+	if err != nil {
+		return err
+	}
+	// The go-bitbucket-v1 client doesn't send the branch details in the create branch request
+	url := fmt.Sprintf("%s/branch-utils/1.0/projects/%s/repos/%s/branches", client.restEndpoint(), owner, repository)
+	return client.sendBitbucketServerRequest(ctx, http.MethodPost, url, bitbucketServerBranchRequest{Name: branch, StartPoint: fromRef}, nil)
+}
+
+// DeleteBranch on Bitbucket server
+func (client *BitbucketServerClient) DeleteBranch(ctx context.Context, owner, repository, branch string) error {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository, "branch": branch})
+	if err != nil {
+		return err
+	}
+	url := fmt.Sprintf("%s/branch-utils/1.0/projects/%s/repos/%s/branches", client.restEndpoint(), owner, repository)
+	return client.sendBitbucketServerRequest(ctx, http.MethodDelete, url, bitbucketServerBranchRequest{Name: branchRefPrefix + branch}, nil)
+}
+
+// GetBranch on Bitbucket server.
+// The branch is considered protected if it has branch permissions restrictions, which are defined on the exact branch name.
+func (client *BitbucketServerClient) GetBranch(ctx context.Context, owner, repository, branch string) (BranchDetails, error) {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository, "branch": branch})
+	if err != nil {
+		return BranchDetails{}, err
+	}
 	bitbucketClient, err := client.buildBitbucketClient(ctx)
 	if err != nil {
-		return nil, err
+		return BranchDetails{}, err
+	}
+	bitbucketBranch, err := findBitbucketServerBranch(bitbucketClient, owner, repository, branch)
+	if err != nil {
+		return BranchDetails{}, err
+	}
+
+	restrictionsURL := fmt.Sprintf("%s/branch-permissions/2.0/projects/%s/repos/%s/restrictions?matcherType=BRANCH&matcherId=%s",
+		client.restEndpoint(), owner, repository, url.QueryEscape(bitbucketBranch.ID))
+	var restrictions bitbucketServerRestrictionsResponse
+	if err = client.sendBitbucketServerRequest(ctx, http.MethodGet, restrictionsURL, nil, &restrictions); err != nil {
+		return BranchDetails{}, err
 	}
-	var results []string
+	return BranchDetails{
+		Name:      bitbucketBranch.DisplayID,
+		CommitSHA: bitbucketBranch.LatestCommit,
+		Protected: len(restrictions.Values) > 0,
+		Default:   bitbucketBranch.IsDefault,
+	}, nil
+}
+
+// findBitbucketServerBranch searches the branch by its name, since the branches API returns all the branches containing the filter text
+func findBitbucketServerBranch(bitbucketClient *bitbucketv1.DefaultApiService, owner, repository, branch string) (*bitbucketv1.Branch, error) {
 	var apiResponse *bitbucketv1.APIResponse
+	var err error
 	for isLastPage, nextPageStart := true, 0; isLastPage; isLastPage, nextPageStart = bitbucketv1.HasNextPage(apiResponse) {
-		apiResponse, err = bitbucketClient.GetBranches(owner, repository, createPaginationOptions(nextPageStart))
+		options := createPaginationOptions(nextPageStart)
+		options["filterText"] = branch
+		apiResponse, err = bitbucketClient.GetBranches(owner, repository, options)
 		if err != nil {
 			return nil, err
 		}
@@ -118,13 +212,150 @@ func (client *BitbucketServerClient) ListBranches(ctx context.Context, owner, re
 		if err != nil {
 			return nil, err
 		}
+		for i := range branches {
+			if branches[i].DisplayID == branch {
+				return &branches[i], nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("branch '%s' was not found in the repository %s/%s", branch, owner, repository)
+}
 
-		for _, branch := range branches {
-			results = append(results, branch.ID)
+// CommitFiles on Bitbucket server.
+// The file edit API commits a single file, so every change is committed separately, in the order of the changes.
+// Therefore, the changes of multiple files are neither a single commit nor atomic. If a change fails, the previous changes
+// stay committed, and the returned error tells their files.
+// Deleting and moving files isn't supported, and the author of the commits is always the authenticated user.
+func (client *BitbucketServerClient) CommitFiles(ctx context.Context, owner, repository, branch, message string, changes []CommitFileChange, _ CommitAuthor) error {
+	err := validateParametersNotBlank(map[string]string{
+		"owner":      owner,
+		"repository": repository,
+		"branch":     branch,
+		"message":    message,
+	})
+	if err != nil {
+		return err
+	}
+	if err = validateCommitFileChanges(changes); err != nil {
+		return err
+	}
+	// Validate all the changes before committing any of them
+	for _, change := range changes {
+		if change.Action == DeleteFile || change.Action == MoveFile {
+			return errBitbucketServerCommitActionNotSupported
+		}
+	}
+	bitbucketClient, err := client.buildBitbucketClient(ctx)
+	if err != nil {
+		return err
+	}
+	bitbucketBranch, err := findBitbucketServerBranch(bitbucketClient, owner, repository, branch)
+	if err != nil {
+		return err
+	}
+	headCommit := bitbucketBranch.LatestCommit
+	committedPaths := make([]string, 0, len(changes))
+	for _, change := range changes {
+		if headCommit, err = client.commitFile(ctx, owner, repository, branch, message, headCommit, change); err != nil {
+			if len(committedPaths) > 0 {
+				return fmt.Errorf("failed to commit %s, after the changes of %s were already committed to branch %s: %w",
+					change.Path, strings.Join(committedPaths, ", "), branch, err)
+			}
+			return err
 		}
+		committedPaths = append(committedPaths, change.Path)
 	}
+	return nil
+}
 
-	return results, nil
+// commitFile commits a single file on top of the head commit of the branch, and returns the new commit ID
+func (client *BitbucketServerClient) commitFile(ctx context.Context, owner, repository, branch, message, headCommit string, change CommitFileChange) (string, error) {
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+	if err := writer.WriteField("branch", branch); err != nil {
+		return "", err
+	}
+	if err := writer.WriteField("message", message); err != nil {
+		return "", err
+	}
+	// The source commit is used to detect conflicting changes of an existing file, and must not be sent for a new file
+	if change.Action == UpdateFile {
+		if err := writer.WriteField("sourceCommitId", headCommit); err != nil {
+			return "", err
+		}
+	}
+	part, err := writer.CreateFormFile("content", path.Base(change.Path))
+	if err != nil {
+		return "", err
+	}
+	if _, err = part.Write(change.Content); err != nil {
+		return "", err
+	}
+	if err = writer.Close(); err != nil {
+		return "", err
+	}
+
+	fileURL := fmt.Sprintf("%s/api/1.0/projects/%s/repos/%s/browse/%s", client.restEndpoint(), owner, repository, escapeURLPath(change.Path))
+	var commit bitbucketv1.Commit
+	err = client.sendBitbucketServerRawRequest(ctx, http.MethodPut, fileURL, writer.FormDataContentType(), body, &commit)
+	return commit.ID, err
+}
+
+// ListTags on Bitbucket server
+func (client *BitbucketServerClient) ListTags(owner, repository string) *Pager[TagInfo] {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository})
+	if err != nil {
+		return newErrorPager[TagInfo](err)
+	}
+	// The go-bitbucket-v1 client doesn't send the pagination options in the get tags request
+	return newNumberedPager(0, func(ctx context.Context, start int) ([]TagInfo, int, error) {
+		tagsURL := fmt.Sprintf("%s/api/1.0/projects/%s/repos/%s/tags?start=%d", client.restEndpoint(), owner, repository, start)
+		var tagsPage bitbucketServerTagsResponse
+		if err := client.sendBitbucketServerRequest(ctx, http.MethodGet, tagsURL, nil, &tagsPage); err != nil {
+			return nil, 0, err
+		}
+		results := make([]TagInfo, 0, len(tagsPage.Values))
+		for _, tag := range tagsPage.Values {
+			results = append(results, TagInfo{Name: tag.DisplayID, CommitSHA: tag.LatestCommit})
+		}
+		return results, getBitbucketServerNextPageStart(tagsPage.IsLastPage, tagsPage.NextPageStart), nil
+	})
+}
+
+// CreateTag on Bitbucket server
+func (client *BitbucketServerClient) CreateTag(ctx context.Context, owner, repository, tag, fromRef, message string) error {
+	err := validateParametersNotBlank(map[string]string{
+		"owner":      owner,
+		"repository": repository,
+		"tag":        tag,
+		"fromRef":    fromRef,
+	})
+	if err != nil {
+		return err
+	}
+	// The go-bitbucket-v1 client doesn't send the tag details in the create tag request
+	tagsURL := fmt.Sprintf("%s/api/1.0/projects/%s/repos/%s/tags", client.restEndpoint(), owner, repository)
+	return client.sendBitbucketServerRequest(ctx, http.MethodPost, tagsURL, bitbucketServerTagRequest{Name: tag, StartPoint: fromRef, Message: message}, nil)
+}
+
+// DeleteTag on Bitbucket server
+func (client *BitbucketServerClient) DeleteTag(ctx context.Context, owner, repository, tag string) error {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository, "tag": tag})
+	if err != nil {
+		return err
+	}
+	tagURL := fmt.Sprintf("%s/git/1.0/projects/%s/repos/%s/tags/%s", client.restEndpoint(), owner, repository, escapeURLPath(tag))
+	return client.sendBitbucketServerRequest(ctx, http.MethodDelete, tagURL, nil, nil)
+}
+
+// CreateRelease on Bitbucket server, which doesn't support releases
+func (client *BitbucketServerClient) CreateRelease(_ context.Context, _, _ string, _ CreateReleaseOptions) (ReleaseInfo, error) {
+	return ReleaseInfo{}, &UnsupportedFeatureError{Provider: vcsutils.BitbucketServer, Feature: "releases"}
+}
+
+// ListReleases on Bitbucket server, which doesn't support releases
+func (client *BitbucketServerClient) ListReleases(_, _ string) *Pager[ReleaseInfo] {
+	return newErrorPager[ReleaseInfo](&UnsupportedFeatureError{Provider: vcsutils.BitbucketServer, Feature: "releases"})
 }
 
 // AddSshKeyToRepository on Bitbucket server
@@ -151,16 +382,28 @@ func (client *BitbucketServerClient) AddSshKeyToRepository(ctx context.Context,
 		Permission: accessPermission,
 	}
 
+	return client.sendBitbucketServerRequest(ctx, http.MethodPost, url, addKeyRequest, nil)
+}
+
+// sendBitbucketServerRequest sends a request to an endpoint which isn't properly supported by the go-bitbucket-v1 client.
+// The request body is JSON encoded, unless it is nil. The response is decoded into the response body, unless it is nil.
+func (client *BitbucketServerClient) sendBitbucketServerRequest(ctx context.Context, method, url string, requestBody, responseBody interface{}) error {
 	body := new(bytes.Buffer)
-	err = json.NewEncoder(body).Encode(addKeyRequest)
-	if err != nil {
-		return err
+	if requestBody != nil {
+		if err := json.NewEncoder(body).Encode(requestBody); err != nil {
+			return err
+		}
 	}
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, body)
+	return client.sendBitbucketServerRawRequest(ctx, method, url, "application/json", body, responseBody)
+}
+
+// sendBitbucketServerRawRequest sends a request with an already encoded body of the given content type
+func (client *BitbucketServerClient) sendBitbucketServerRawRequest(ctx context.Context, method, url, contentType string, body io.Reader, responseBody interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
 	if err != nil {
 		return err
 	}
-	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Content-Type", contentType)
 
 	httpClient := client.buildHTTPClient(ctx)
 	response, err := httpClient.Do(req)
@@ -176,8 +419,152 @@ func (client *BitbucketServerClient) AddSshKeyToRepository(ctx context.Context,
 		}
 		return fmt.Errorf("status: %v, body: %s", response.Status, bodyBytes)
 	}
-	_ = vcsutils.DiscardResponseBody(response)
-	return nil
+	if responseBody == nil {
+		_ = vcsutils.DiscardResponseBody(response)
+		return nil
+	}
+	return json.NewDecoder(response.Body).Decode(responseBody)
+}
+
+type bitbucketServerBranchRequest struct {
+	Name       string `json:"name"`
+	StartPoint string `json:"startPoint,omitempty"`
+}
+
+type bitbucketServerTagRequest struct {
+	Name       string `json:"name"`
+	StartPoint string `json:"startPoint"`
+	Message    string `json:"message,omitempty"`
+}
+
+type bitbucketServerCreateRepositoryRequest struct {
+	Name          string `json:"name"`
+	ScmID         string `json:"scmId"`
+	Public        bool   `json:"public"`
+	Description   string `json:"description,omitempty"`
+	DefaultBranch string `json:"defaultBranch,omitempty"`
+}
+
+type bitbucketServerForkRequest struct {
+	Project *bitbucketServerProjectKey `json:"project,omitempty"`
+}
+
+type bitbucketServerProjectKey struct {
+	Key string `json:"key"`
+}
+
+type bitbucketServerArchiveRequest struct {
+	Archived bool `json:"archived"`
+}
+
+type bitbucketServerRepository struct {
+	Slug    string `json:"slug"`
+	Project struct {
+		Key string `json:"key"`
+	} `json:"project"`
+	Public      bool   `json:"public"`
+	Description string `json:"description"`
+	// Returned since Bitbucket server 8.0
+	Archived bool `json:"archived"`
+	Origin   *struct {
+		Slug    string `json:"slug"`
+		Project struct {
+			Key string `json:"key"`
+		} `json:"project"`
+	} `json:"origin"`
+	Links struct {
+		Clone []struct {
+			Name string `json:"name"`
+			HRef string `json:"href"`
+		} `json:"clone"`
+		Self []struct {
+			HRef string `json:"href"`
+		} `json:"self"`
+	} `json:"links"`
+}
+
+type bitbucketServerRepositoriesResponse struct {
+	Values        []bitbucketServerRepository `json:"values"`
+	IsLastPage    bool                        `json:"isLastPage"`
+	NextPageStart int                         `json:"nextPageStart"`
+}
+
+type bitbucketServerTagsResponse struct {
+	Values        []bitbucketv1.Tag `json:"values"`
+	IsLastPage    bool              `json:"isLastPage"`
+	NextPageStart int               `json:"nextPageStart"`
+}
+
+type bitbucketServerBuildStatus struct {
+	State       string `json:"state"`
+	Key         string `json:"key"`
+	Name        string `json:"name,omitempty"`
+	Url         string `json:"url"`
+	Description string `json:"description,omitempty"`
+	BuildNumber string `json:"buildNumber,omitempty"`
+}
+
+type bitbucketServerBuildStatusesResponse struct {
+	Values        []bitbucketv1.BuildStatus `json:"values"`
+	IsLastPage    bool                      `json:"isLastPage"`
+	NextPageStart int                       `json:"nextPageStart"`
+}
+
+type bitbucketServerWebhook struct {
+	ID         int                               `json:"id"`
+	URL        string                            `json:"url"`
+	Events     []string                          `json:"events"`
+	Active     bool                              `json:"active"`
+	Statistics *bitbucketServerWebhookStatistics `json:"statistics,omitempty"`
+}
+
+type bitbucketServerWebhookStatistics struct {
+	LastSuccess *bitbucketServerWebhookInvocation `json:"lastSuccess,omitempty"`
+	LastFailure *bitbucketServerWebhookInvocation `json:"lastFailure,omitempty"`
+	LastError   *bitbucketServerWebhookInvocation `json:"lastError,omitempty"`
+}
+
+type bitbucketServerWebhookInvocation struct {
+	Start int64 `json:"start"`
+}
+
+type bitbucketServerWebhooksResponse struct {
+	Values        []bitbucketServerWebhook `json:"values"`
+	IsLastPage    bool                     `json:"isLastPage"`
+	NextPageStart int                      `json:"nextPageStart"`
+}
+
+type bitbucketServerWebhookTestResponse struct {
+	Response *struct {
+		StatusCode int `json:"statusCode"`
+	} `json:"response,omitempty"`
+}
+
+type bitbucketServerInsightReport struct {
+	Title   string `json:"title"`
+	Details string `json:"details,omitempty"`
+	// PASS or FAIL, or empty while the check is in progress
+	Result string `json:"result,omitempty"`
+	Link   string `json:"link,omitempty"`
+}
+
+type bitbucketServerInsightAnnotations struct {
+	Annotations []bitbucketServerInsightAnnotation `json:"annotations"`
+}
+
+type bitbucketServerInsightAnnotation struct {
+	ExternalID string `json:"externalId"`
+	Path       string `json:"path"`
+	Line       int    `json:"line"`
+	Message    string `json:"message"`
+	Severity   string `json:"severity"`
+}
+
+type bitbucketServerRestrictionsResponse struct {
+	Values []struct {
+		ID   int    `json:"id"`
+		Type string `json:"type"`
+	} `json:"values"`
 }
 
 type bitbucketServerAddSSHKeyRequest struct {
@@ -190,6 +577,11 @@ type bitbucketServerSSHKey struct {
 	Label string `json:"label"`
 }
 
+type bitbucketServerUpdateCommentRequest struct {
+	Text    string `json:"text"`
+	Version int    `json:"version"`
+}
+
 // CreateWebhook on Bitbucket server
 func (client *BitbucketServerClient) CreateWebhook(ctx context.Context, owner, repository, _, payloadURL string,
 	webhookEvents ...vcsutils.WebhookEvent) (string, string, error) {
@@ -240,20 +632,321 @@ func (client *BitbucketServerClient) DeleteWebhook(ctx context.Context, owner, r
 	return err
 }
 
-// SetCommitStatus on Bitbucket server
-func (client *BitbucketServerClient) SetCommitStatus(ctx context.Context, commitStatus CommitStatus, _, _, ref, title,
-	description, detailsURL string) error {
-	bitbucketClient, err := client.buildBitbucketClient(ctx)
+// ListWebhooks on Bitbucket server
+func (client *BitbucketServerClient) ListWebhooks(owner, repository string) *Pager[WebhookInfo] {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository})
+	if err != nil {
+		return newErrorPager[WebhookInfo](err)
+	}
+	return newNumberedPager(0, func(ctx context.Context, start int) ([]WebhookInfo, int, error) {
+		webhooksURL := fmt.Sprintf("%s/api/1.0/projects/%s/repos/%s/webhooks?statistics=true&start=%d",
+			client.restEndpoint(), owner, repository, start)
+		var webhooksPage bitbucketServerWebhooksResponse
+		if err := client.sendBitbucketServerRequest(ctx, http.MethodGet, webhooksURL, nil, &webhooksPage); err != nil {
+			return nil, 0, err
+		}
+		results := make([]WebhookInfo, 0, len(webhooksPage.Values))
+		for _, webhook := range webhooksPage.Values {
+			results = append(results, mapBitbucketServerWebhookToWebhookInfo(webhook))
+		}
+		return results, getBitbucketServerNextPageStart(webhooksPage.IsLastPage, webhooksPage.NextPageStart), nil
+	})
+}
+
+// GetWebhook on Bitbucket server
+func (client *BitbucketServerClient) GetWebhook(ctx context.Context, owner, repository, webhookID string) (WebhookInfo, error) {
+	webhook, err := client.getWebhook(ctx, owner, repository, webhookID)
+	if err != nil {
+		return WebhookInfo{}, err
+	}
+	return mapBitbucketServerWebhookToWebhookInfo(webhook), nil
+}
+
+func (client *BitbucketServerClient) getWebhook(ctx context.Context, owner, repository, webhookID string) (bitbucketServerWebhook, error) {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository, "webhookID": webhookID})
+	if err != nil {
+		return bitbucketServerWebhook{}, err
+	}
+	webhookIDInt32, err := strconv.ParseInt(webhookID, 10, 32)
+	if err != nil {
+		return bitbucketServerWebhook{}, err
+	}
+	webhookURL := fmt.Sprintf("%s/api/1.0/projects/%s/repos/%s/webhooks/%d?statistics=true",
+		client.restEndpoint(), owner, repository, webhookIDInt32)
+	var webhook bitbucketServerWebhook
+	err = client.sendBitbucketServerRequest(ctx, http.MethodGet, webhookURL, nil, &webhook)
+	return webhook, err
+}
+
+// PingWebhook on Bitbucket server. Bitbucket server sends a test request to the payload URL of the webhook.
+func (client *BitbucketServerClient) PingWebhook(ctx context.Context, owner, repository, webhookID string) error {
+	webhook, err := client.getWebhook(ctx, owner, repository, webhookID)
+	if err != nil {
+		return err
+	}
+	testURL := fmt.Sprintf("%s/api/1.0/projects/%s/repos/%s/webhooks/test?webhookId=%d&url=%s",
+		client.restEndpoint(), owner, repository, webhook.ID, url.QueryEscape(webhook.URL))
+	var testResponse bitbucketServerWebhookTestResponse
+	if err = client.sendBitbucketServerRequest(ctx, http.MethodPost, testURL, nil, &testResponse); err != nil {
+		return err
+	}
+	if testResponse.Response == nil {
+		return fmt.Errorf("the test request of webhook %s couldn't reach %s", webhookID, webhook.URL)
+	}
+	if testResponse.Response.StatusCode >= 300 {
+		return fmt.Errorf("the test request of webhook %s was responded with status %d", webhookID, testResponse.Response.StatusCode)
+	}
+	return nil
+}
+
+// CreateOrganizationWebhook on Bitbucket server. The webhook is created on the project, whose key is the owner.
+func (client *BitbucketServerClient) CreateOrganizationWebhook(ctx context.Context, owner, payloadURL string,
+	webhookEvents ...vcsutils.WebhookEvent) (string, string, error) {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "payloadURL": payloadURL})
+	if err != nil {
+		return "", "", err
+	}
+	token := vcsutils.CreateToken()
+	webhooksURL := fmt.Sprintf("%s/api/1.0/projects/%s/webhooks", client.restEndpoint(), owner)
+	var webhook bitbucketServerWebhook
+	err = client.sendBitbucketServerRequest(ctx, http.MethodPost, webhooksURL, createBitbucketServerHook(token, payloadURL, webhookEvents...), &webhook)
+	if err != nil {
+		return "", "", err
+	}
+	return strconv.Itoa(webhook.ID), token, nil
+}
+
+// UpdateOrganizationWebhook on Bitbucket server
+func (client *BitbucketServerClient) UpdateOrganizationWebhook(ctx context.Context, owner, payloadURL, token, webhookID string,
+	webhookEvents ...vcsutils.WebhookEvent) error {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "payloadURL": payloadURL, "webhookID": webhookID})
+	if err != nil {
+		return err
+	}
+	webhookURL, err := client.getProjectWebhookURL(ctx, owner, webhookID)
+	if err != nil {
+		return err
+	}
+	return client.sendBitbucketServerRequest(ctx, http.MethodPut, webhookURL, createBitbucketServerHook(token, payloadURL, webhookEvents...), nil)
+}
+
+// DeleteOrganizationWebhook on Bitbucket server
+func (client *BitbucketServerClient) DeleteOrganizationWebhook(ctx context.Context, owner, webhookID string) error {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "webhookID": webhookID})
+	if err != nil {
+		return err
+	}
+	webhookURL, err := client.getProjectWebhookURL(ctx, owner, webhookID)
+	if err != nil {
+		return err
+	}
+	return client.sendBitbucketServerRequest(ctx, http.MethodDelete, webhookURL, nil, nil)
+}
+
+func (client *BitbucketServerClient) getProjectWebhookURL(ctx context.Context, owner, webhookID string) (string, error) {
+	webhookIDInt32, err := strconv.ParseInt(webhookID, 10, 32)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s/api/1.0/projects/%s/webhooks/%d", client.restEndpoint(), owner, webhookIDInt32), nil
+}
+
+// ListOrganizationWebhooks on Bitbucket server
+func (client *BitbucketServerClient) ListOrganizationWebhooks(owner string) *Pager[WebhookInfo] {
+	err := validateParametersNotBlank(map[string]string{"owner": owner})
+	if err != nil {
+		return newErrorPager[WebhookInfo](err)
+	}
+	return newNumberedPager(0, func(ctx context.Context, start int) ([]WebhookInfo, int, error) {
+		webhooksURL := fmt.Sprintf("%s/api/1.0/projects/%s/webhooks?statistics=true&start=%d", client.restEndpoint(), owner, start)
+		var webhooksPage bitbucketServerWebhooksResponse
+		if err := client.sendBitbucketServerRequest(ctx, http.MethodGet, webhooksURL, nil, &webhooksPage); err != nil {
+			return nil, 0, err
+		}
+		results := make([]WebhookInfo, 0, len(webhooksPage.Values))
+		for _, webhook := range webhooksPage.Values {
+			results = append(results, mapBitbucketServerWebhookToWebhookInfo(webhook))
+		}
+		return results, getBitbucketServerNextPageStart(webhooksPage.IsLastPage, webhooksPage.NextPageStart), nil
+	})
+}
+
+// SetCommitStatus on Bitbucket server. Bitbucket server 7.4 and above sets a build status of the commit in the repository.
+// Older versions set a build status of the commit, which is shown in all the repositories of the commit.
+func (client *BitbucketServerClient) SetCommitStatus(ctx context.Context, owner, repository, ref string, status CommitStatusOptions) error {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository, "ref": ref})
+	if err != nil {
+		return err
+	}
+	bitbucketClient, err := client.buildBitbucketClient(ctx)
+	if err != nil {
+		return err
+	}
+	buildStatus := bitbucketServerBuildStatus{
+		State:       getBitbucketCommitState(status.State),
+		Key:         status.Key,
+		Name:        status.Name,
+		Url:         status.DetailsURL,
+		Description: status.Description,
+		BuildNumber: status.BuildNumber,
+	}
+	repositoryScoped, err := client.isRepositoryBuildStatusSupported(bitbucketClient)
+	if err != nil {
+		return err
+	}
+	if !repositoryScoped {
+		_, err = bitbucketClient.SetCommitStatus(ref, bitbucketv1.BuildStatus{
+			State:       buildStatus.State,
+			Key:         buildStatus.Key,
+			Name:        buildStatus.Name,
+			Url:         buildStatus.Url,
+			Description: buildStatus.Description,
+		})
+		return err
+	}
+	buildsURL := fmt.Sprintf("%s/api/1.0/projects/%s/repos/%s/commits/%s/builds", client.restEndpoint(), owner, repository, ref)
+	return client.sendBitbucketServerRequest(ctx, http.MethodPost, buildsURL, buildStatus, nil)
+}
+
+// isRepositoryBuildStatusSupported returns true if the Bitbucket server version is 7.4 or above.
+// The version is fetched on the first successful call only.
+func (client *BitbucketServerClient) isRepositoryBuildStatusSupported(bitbucketClient *bitbucketv1.DefaultApiService) (bool, error) {
+	client.buildStatusMutex.Lock()
+	defer client.buildStatusMutex.Unlock()
+	if client.repositoryBuildStatusSupported == nil {
+		supported, err := isBitbucketServerRepositoryBuildStatusSupported(bitbucketClient)
+		if err != nil {
+			return false, err
+		}
+		client.repositoryBuildStatusSupported = &supported
+	}
+	return *client.repositoryBuildStatusSupported, nil
+}
+
+func isBitbucketServerRepositoryBuildStatusSupported(bitbucketClient *bitbucketv1.DefaultApiService) (bool, error) {
+	apiResponse, err := bitbucketClient.GetApplicationProperties()
+	if err != nil {
+		return false, err
+	}
+	version, _ := apiResponse.Values["version"].(string)
+	versionParts := strings.SplitN(version, ".", 3)
+	if len(versionParts) < 2 {
+		return false, fmt.Errorf("unexpected Bitbucket server version: %q", version)
+	}
+	major, err := strconv.Atoi(versionParts[0])
+	if err != nil {
+		return false, err
+	}
+	minor, err := strconv.Atoi(versionParts[1])
+	if err != nil {
+		return false, err
+	}
+	return major > 7 || (major == 7 && minor >= 4), nil
+}
+
+// ListCommitStatuses on Bitbucket server.
+// The build statuses are reported on commits, and therefore a branch or a tag ref is resolved to its latest commit.
+func (client *BitbucketServerClient) ListCommitStatuses(owner, repository, ref string) *Pager[CommitStatusInfo] {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository, "ref": ref})
+	if err != nil {
+		return newErrorPager[CommitStatusInfo](err)
+	}
+	commitID := ref
+	return newNumberedPager(0, func(ctx context.Context, start int) ([]CommitStatusInfo, int, error) {
+		if !isCommitSHA(commitID) {
+			latestCommit, err := client.GetLatestCommit(ctx, owner, repository, ref)
+			if err != nil || latestCommit.Hash == "" {
+				return nil, 0, err
+			}
+			commitID = latestCommit.Hash
+		}
+		statusesURL := fmt.Sprintf("%s/build-status/1.0/commits/%s?start=%d", client.restEndpoint(), commitID, start)
+		var statusesPage bitbucketServerBuildStatusesResponse
+		if err := client.sendBitbucketServerRequest(ctx, http.MethodGet, statusesURL, nil, &statusesPage); err != nil {
+			return nil, 0, err
+		}
+		results := make([]CommitStatusInfo, 0, len(statusesPage.Values))
+		for _, status := range statusesPage.Values {
+			dateAdded := time.UnixMilli(status.DateAdded).UTC()
+			results = append(results, CommitStatusInfo{
+				State:         mapBitbucketCommitState(status.State),
+				Context:       status.Key,
+				Description:   status.Description,
+				DetailsURL:    status.Url,
+				CreatedAt:     dateAdded,
+				LastUpdatedAt: dateAdded,
+			})
+		}
+		return results, getBitbucketServerNextPageStart(statusesPage.IsLastPage, statusesPage.NextPageStart), nil
+	})
+}
+
+// CreateCheckRun on Bitbucket server. The check run is published as a Code Insights report, whose key is the check run name.
+func (client *BitbucketServerClient) CreateCheckRun(ctx context.Context, owner, repository string, checkRun CheckRunOptions) (string, error) {
+	if err := client.publishInsightReport(ctx, owner, repository, checkRun.Name, checkRun); err != nil {
+		return "", err
+	}
+	return checkRun.Name, nil
+}
+
+// UpdateCheckRun on Bitbucket server. The Code Insights report is replaced, and the annotations are added to it.
+func (client *BitbucketServerClient) UpdateCheckRun(ctx context.Context, owner, repository, checkRunID string, checkRun CheckRunOptions) error {
+	return client.publishInsightReport(ctx, owner, repository, checkRunID, checkRun)
+}
+
+func (client *BitbucketServerClient) publishInsightReport(ctx context.Context, owner, repository, key string, checkRun CheckRunOptions) error {
+	err := validateParametersNotBlank(map[string]string{
+		"owner":              owner,
+		"repository":         repository,
+		"key":                key,
+		"checkRun.CommitSHA": checkRun.CommitSHA,
+	})
 	if err != nil {
 		return err
 	}
-	_, err = bitbucketClient.SetCommitStatus(ref, bitbucketv1.BuildStatus{
-		State:       getBitbucketCommitState(commitStatus),
-		Key:         title,
-		Description: description,
-		Url:         detailsURL,
-	})
-	return err
+	reportURL := fmt.Sprintf("%s/insights/1.0/projects/%s/repos/%s/commits/%s/reports/%s",
+		client.restEndpoint(), owner, repository, checkRun.CommitSHA, url.PathEscape(key))
+	report := bitbucketServerInsightReport{Title: checkRun.Title, Details: checkRun.Summary, Link: checkRun.DetailsURL}
+	if report.Title == "" {
+		report.Title = key
+	}
+	if checkRun.State != InProgress {
+		report.Result = "FAIL"
+		if checkRun.State == Pass || checkRun.State == Skipped {
+			report.Result = "PASS"
+		}
+	}
+	if err = client.sendBitbucketServerRequest(ctx, http.MethodPut, reportURL, report, nil); err != nil {
+		return err
+	}
+	if len(checkRun.Annotations) == 0 {
+		return nil
+	}
+	for _, batch := range splitToBatches(checkRun.Annotations, bitbucketAnnotationsBatchSize) {
+		annotations := bitbucketServerInsightAnnotations{Annotations: make([]bitbucketServerInsightAnnotation, 0, len(batch))}
+		for _, annotation := range batch {
+			message := annotation.Message
+			if annotation.Title != "" {
+				message = annotation.Title + ": " + message
+			}
+			annotations.Annotations = append(annotations.Annotations, bitbucketServerInsightAnnotation{
+				ExternalID: getBitbucketAnnotationExternalID(annotation),
+				Path:       annotation.Path,
+				Line:       annotation.StartLine,
+				Message:    message,
+				Severity:   getBitbucketAnnotationSeverity(annotation.Level),
+			})
+		}
+		if err = client.sendBitbucketServerRequest(ctx, http.MethodPost, reportURL+"/annotations", annotations, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetCombinedCommitStatus on Bitbucket server
+func (client *BitbucketServerClient) GetCombinedCommitStatus(ctx context.Context, owner, repository, ref string) (CombinedCommitStatus, error) {
+	return getCombinedCommitStatus(ctx, client.ListCommitStatuses(owner, repository, ref))
 }
 
 // DownloadRepository on Bitbucket server
@@ -281,7 +974,7 @@ func (client *BitbucketServerClient) DownloadRepository(ctx context.Context, own
 	return vcsutils.CreateDotGitFolderWithRemote(
 		localPath,
 		vcsutils.RemoteName,
-		vcsutils.GetGenericGitRemoteUrl(fmt.Sprintf("%s/scm", strings.TrimSuffix(client.vcsInfo.APIEndpoint, "/rest")), owner, repository))
+		vcsutils.GetGenericGitRemoteUrl(fmt.Sprintf("%s/scm", strings.TrimSuffix(client.restEndpoint(), "/rest")), owner, repository))
 }
 
 // CreatePullRequest on Bitbucket server
@@ -314,22 +1007,21 @@ func (client *BitbucketServerClient) CreatePullRequest(ctx context.Context, owne
 }
 
 // ListOpenPullRequests on Bitbucket server
-func (client *BitbucketServerClient) ListOpenPullRequests(ctx context.Context, owner, repository string) ([]PullRequestInfo, error) {
-	bitbucketClient, err := client.buildBitbucketClient(ctx)
-	if err != nil {
-		return nil, err
-	}
-	var results []PullRequestInfo
-	var apiResponse *bitbucketv1.APIResponse
-	for isLastPage, nextPageStart := true, 0; isLastPage; isLastPage, nextPageStart = bitbucketv1.HasNextPage(apiResponse) {
-		apiResponse, err = bitbucketClient.GetPullRequestsPage(owner, repository, createPaginationOptions(nextPageStart))
+func (client *BitbucketServerClient) ListOpenPullRequests(owner, repository string) *Pager[PullRequestInfo] {
+	return newNumberedPager(0, func(ctx context.Context, start int) ([]PullRequestInfo, int, error) {
+		bitbucketClient, err := client.buildBitbucketClient(ctx)
 		if err != nil {
-			return nil, err
+			return nil, 0, err
+		}
+		apiResponse, err := bitbucketClient.GetPullRequestsPage(owner, repository, createPaginationOptions(start))
+		if err != nil {
+			return nil, 0, err
 		}
 		pullRequests, err := bitbucketv1.GetPullRequestsResponse(apiResponse)
 		if err != nil {
-			return nil, err
+			return nil, 0, err
 		}
+		var results []PullRequestInfo
 		for _, pullRequest := range pullRequests {
 			if pullRequest.Open {
 				results = append(results, PullRequestInfo{
@@ -343,8 +1035,9 @@ func (client *BitbucketServerClient) ListOpenPullRequests(ctx context.Context, o
 				})
 			}
 		}
-	}
-	return results, nil
+		_, nextPageStart := bitbucketv1.HasNextPage(apiResponse)
+		return results, nextPageStart, nil
+	})
 }
 
 // AddPullRequestComment on Bitbucket server
@@ -365,23 +1058,14 @@ func (client *BitbucketServerClient) AddPullRequestComment(ctx context.Context,
 }
 
 // ListPullRequestComments on Bitbucket server
-func (client *BitbucketServerClient) ListPullRequestComments(ctx context.Context, owner, repository string, pullRequestID int) ([]CommentInfo, error) {
-	bitbucketClient, err := client.buildBitbucketClient(ctx)
-	if err != nil {
-		return nil, err
-	}
-	var results []CommentInfo
-	var apiResponse *bitbucketv1.APIResponse
-	for isLastPage, nextPageStart := true, 0; isLastPage; isLastPage, nextPageStart = bitbucketv1.HasNextPage(apiResponse) {
-		apiResponse, err = bitbucketClient.GetActivities(owner, repository, int64(pullRequestID), createPaginationOptions(nextPageStart))
-		if err != nil {
-			return nil, err
-		}
-		activities, err := bitbucketv1.GetActivitiesResponse(apiResponse)
+func (client *BitbucketServerClient) ListPullRequestComments(owner, repository string, pullRequestID int) *Pager[CommentInfo] {
+	return newNumberedPager(0, func(ctx context.Context, start int) ([]CommentInfo, int, error) {
+		activities, nextPageStart, err := client.getPullRequestActivities(ctx, owner, repository, pullRequestID, start)
 		if err != nil {
-			return nil, err
+			return nil, 0, err
 		}
-		for _, activity := range activities.Values {
+		var results []CommentInfo
+		for _, activity := range activities {
 			// Add activity only if from type new comment.
 			if activity.Action == "COMMENTED" && activity.CommentAction == "ADDED" {
 				results = append(results, CommentInfo{
@@ -391,8 +1075,112 @@ func (client *BitbucketServerClient) ListPullRequestComments(ctx context.Context
 				})
 			}
 		}
+		return results, nextPageStart, nil
+	})
+}
+
+// getPullRequestActivities returns the activities page which starts at the start offset, and the start of the next page
+func (client *BitbucketServerClient) getPullRequestActivities(ctx context.Context, owner, repository string, pullRequestID, start int) ([]bitbucketv1.Activity, int, error) {
+	bitbucketClient, err := client.buildBitbucketClient(ctx)
+	if err != nil {
+		return nil, 0, err
 	}
-	return results, nil
+	apiResponse, err := bitbucketClient.GetActivities(owner, repository, int64(pullRequestID), createPaginationOptions(start))
+	if err != nil {
+		return nil, 0, err
+	}
+	activities, err := bitbucketv1.GetActivitiesResponse(apiResponse)
+	if err != nil {
+		return nil, 0, err
+	}
+	_, nextPageStart := bitbucketv1.HasNextPage(apiResponse)
+	return activities.Values, nextPageStart, nil
+}
+
+// EditPullRequestComment on Bitbucket server
+func (client *BitbucketServerClient) EditPullRequestComment(ctx context.Context, owner, repository string, pullRequestID int, commentID int64, content string) error {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository, "content": content})
+	if err != nil {
+		return err
+	}
+	bitbucketClient, err := client.buildBitbucketClient(ctx)
+	if err != nil {
+		return err
+	}
+	// The current version of the comment is required, to prevent overriding concurrent changes
+	version, err := getBitbucketServerCommentVersion(bitbucketClient, owner, repository, pullRequestID, commentID)
+	if err != nil {
+		return err
+	}
+	// The go-bitbucket-v1 client doesn't send the comment in the update request
+	url := fmt.Sprintf("%s/api/1.0/projects/%s/repos/%s/pull-requests/%d/comments/%d", client.restEndpoint(), owner, repository, pullRequestID, commentID)
+	return client.sendBitbucketServerRequest(ctx, http.MethodPut, url, bitbucketServerUpdateCommentRequest{Text: content, Version: version}, nil)
+}
+
+// DeletePullRequestComment on Bitbucket server
+func (client *BitbucketServerClient) DeletePullRequestComment(ctx context.Context, owner, repository string, pullRequestID int, commentID int64) error {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository})
+	if err != nil {
+		return err
+	}
+	bitbucketClient, err := client.buildBitbucketClient(ctx)
+	if err != nil {
+		return err
+	}
+	version, err := getBitbucketServerCommentVersion(bitbucketClient, owner, repository, pullRequestID, commentID)
+	if err != nil {
+		return err
+	}
+	// The go-bitbucket-v1 client fails to decode the empty response of a successful deletion
+	url := fmt.Sprintf("%s/api/1.0/projects/%s/repos/%s/pull-requests/%d/comments/%d?version=%d", client.restEndpoint(), owner, repository, pullRequestID, commentID, version)
+	return client.sendBitbucketServerRequest(ctx, http.MethodDelete, url, nil, nil)
+}
+
+// AddPullRequestReviewComments on Bitbucket server
+func (client *BitbucketServerClient) AddPullRequestReviewComments(ctx context.Context, owner, repository string, pullRequestID int, comments []ReviewComment) error {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository})
+	if err != nil {
+		return err
+	}
+	if err = validateReviewComments(comments); err != nil {
+		return err
+	}
+	bitbucketClient, err := client.buildBitbucketClient(ctx)
+	if err != nil {
+		return err
+	}
+	for _, comment := range comments {
+		_, err = bitbucketClient.CreatePullRequestComment(owner, repository, pullRequestID, bitbucketv1.Comment{
+			Text:   comment.Content,
+			Anchor: createBitbucketServerCommentAnchor(comment),
+		}, []string{"application/json"})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ListPullRequestReviewComments on Bitbucket server
+func (client *BitbucketServerClient) ListPullRequestReviewComments(owner, repository string, pullRequestID int) *Pager[ReviewCommentInfo] {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository})
+	if err != nil {
+		return newErrorPager[ReviewCommentInfo](err)
+	}
+	return newNumberedPager(0, func(ctx context.Context, start int) ([]ReviewCommentInfo, int, error) {
+		activities, nextPageStart, err := client.getPullRequestActivities(ctx, owner, repository, pullRequestID, start)
+		if err != nil {
+			return nil, 0, err
+		}
+		var results []ReviewCommentInfo
+		for _, activity := range activities {
+			// Add activity only if from type new comment, anchored to a file line.
+			if activity.Action == bitbucketv1.ActionCommented && activity.CommentAction == "ADDED" && activity.CommentAnchor.Path != "" {
+				results = append(results, mapBitbucketServerActivityToReviewCommentInfo(activity))
+			}
+		}
+		return results, nextPageStart, nil
+	})
 }
 
 type projectsResponse struct {
@@ -401,6 +1189,39 @@ type projectsResponse struct {
 	} `json:"values,omitempty"`
 }
 
+// ListPullRequestFiles on Bitbucket server
+func (client *BitbucketServerClient) ListPullRequestFiles(owner, repository string, pullRequestID int) *Pager[vcsutils.FileDiff] {
+	// The pull request diff is returned in a single response
+	return newSinglePagePager(func(ctx context.Context) ([]vcsutils.FileDiff, error) {
+		fileDiffs, err := client.GetPullRequestDiff(ctx, owner, repository, pullRequestID)
+		if err != nil {
+			return nil, err
+		}
+		// The numbers of added and removed lines are available only by parsing the diff
+		for i := range fileDiffs {
+			fileDiffs[i].Hunks = nil
+		}
+		return fileDiffs, nil
+	})
+}
+
+// GetPullRequestDiff on Bitbucket server
+func (client *BitbucketServerClient) GetPullRequestDiff(ctx context.Context, owner, repository string, pullRequestID int) ([]vcsutils.FileDiff, error) {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository})
+	if err != nil {
+		return nil, err
+	}
+	bitbucketClient, err := client.buildBitbucketClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	apiResponse, err := bitbucketClient.GetPullRequestDiffRaw(owner, repository, pullRequestID, nil)
+	if err != nil {
+		return nil, err
+	}
+	return vcsutils.ParseUnifiedDiff(string(apiResponse.Payload))
+}
+
 // GetLatestCommit on Bitbucket server
 func (client *BitbucketServerClient) GetLatestCommit(ctx context.Context, owner, repository, branch string) (CommitInfo, error) {
 	err := validateParametersNotBlank(map[string]string{
@@ -447,40 +1268,160 @@ func (client *BitbucketServerClient) GetRepositoryInfo(ctx context.Context, owne
 		return RepositoryInfo{}, err
 	}
 
-	repo, err := bitbucketClient.GetRepository(owner, repository)
+	var repo bitbucketServerRepository
+	if err = client.sendBitbucketServerRequest(ctx, http.MethodGet, client.getRepositoryURL(owner, repository), nil, &repo); err != nil {
+		return RepositoryInfo{}, err
+	}
+	repositoryInfo := mapBitbucketServerRepositoryToRepositoryInfo(repo)
+	repositoryInfo.DefaultBranch, err = getBitbucketServerDefaultBranch(bitbucketClient, owner, repository)
 	if err != nil {
 		return RepositoryInfo{}, err
 	}
+	return repositoryInfo, nil
+}
 
-	holder := struct {
-		Links struct {
-			Clone []struct {
-				Name string `mapstructure:"name"`
-				HRef string `mapstructure:"href"`
-			} `mapstructure:"clone"`
-		} `mapstructure:"links"`
-		Public bool `mapstructure:"public"`
-	}{}
+// The repository response doesn't include the default branch
+func getBitbucketServerDefaultBranch(bitbucketClient *bitbucketv1.DefaultApiService, owner, repository string) (string, error) {
+	apiResponse, err := bitbucketClient.GetDefaultBranch(owner, repository)
+	if err != nil {
+		// Empty repositories have no default branch
+		if apiResponse != nil && apiResponse.Response != nil && apiResponse.StatusCode == http.StatusNotFound {
+			return "", nil
+		}
+		return "", err
+	}
+	defaultBranch, err := bitbucketv1.GetBranchResponse(apiResponse)
+	if err != nil {
+		return "", err
+	}
+	return defaultBranch.DisplayID, nil
+}
 
-	if err := mapstructure.Decode(repo.Values, &holder); err != nil {
-		return RepositoryInfo{}, err
+// StreamRepositories on Bitbucket server. The owner is the project key.
+// The default branch of every listed repository is fetched in a separate request.
+func (client *BitbucketServerClient) StreamRepositories(ctx context.Context, options ListRepositoriesOptions) <-chan RepositoryResult {
+	if !options.UpdatedSince.IsZero() {
+		return streamRepositories(ctx, options, newErrorPager[RepositoryListItem](
+			&UnsupportedFeatureError{Provider: vcsutils.BitbucketServer, Feature: "repository push times"}))
 	}
+	repositories := newNumberedPager(0, func(ctx context.Context, start int) ([]RepositoryListItem, int, error) {
+		bitbucketClient, err := client.buildBitbucketClient(ctx)
+		if err != nil {
+			return nil, 0, err
+		}
+		listURL := fmt.Sprintf("%s&start=%d", client.getRepositoriesListURL(options), start)
+		var reposPage bitbucketServerRepositoriesResponse
+		if err = client.sendBitbucketServerRequest(ctx, http.MethodGet, listURL, nil, &reposPage); err != nil {
+			return nil, 0, err
+		}
+		var results []RepositoryListItem
+		for _, repo := range reposPage.Values {
+			item := RepositoryListItem{Owner: repo.Project.Key, Name: repo.Slug, RepositoryInfo: mapBitbucketServerRepositoryToRepositoryInfo(repo)}
+			// Avoid fetching the default branch of filtered out repositories
+			if !options.matches(item) {
+				continue
+			}
+			if item.DefaultBranch, err = getBitbucketServerDefaultBranch(bitbucketClient, item.Owner, item.Name); err != nil {
+				return nil, 0, err
+			}
+			results = append(results, item)
+		}
+		return results, getBitbucketServerNextPageStart(reposPage.IsLastPage, reposPage.NextPageStart), nil
+	})
+	return streamRepositories(ctx, options, repositories)
+}
 
-	var info CloneInfo
-	for _, cloneLink := range holder.Links.Clone {
-		switch cloneLink.Name {
-		case "http":
-			info.HTTP = cloneLink.HRef
-		case "ssh":
-			info.SSH = cloneLink.HRef
+// getRepositoriesListURL returns the URL of the repositories of the owner project, or of all the accessible repositories.
+// The filters are also applied after listing, since older Bitbucket server versions ignore the archived filter.
+func (client *BitbucketServerClient) getRepositoriesListURL(options ListRepositoriesOptions) string {
+	if options.Owner != "" {
+		return fmt.Sprintf("%s/api/1.0/projects/%s/repos?limit=%d", client.restEndpoint(), options.Owner, repositoriesPageSize)
+	}
+	query := url.Values{"limit": {strconv.Itoa(repositoriesPageSize)}, "archived": {"ALL"}}
+	if options.Archived != nil {
+		query.Set("archived", "ACTIVE")
+		if *options.Archived {
+			query.Set("archived", "ARCHIVED")
 		}
 	}
+	if options.Visibility != nil {
+		// Internal repositories are created as private
+		query.Set("visibility", "private")
+		if *options.Visibility == Public {
+			query.Set("visibility", "public")
+		}
+	}
+	return fmt.Sprintf("%s/api/1.0/repos?%s", client.restEndpoint(), query.Encode())
+}
+
+// CreateRepository on Bitbucket server
+func (client *BitbucketServerClient) CreateRepository(ctx context.Context, owner string, options CreateRepositoryOptions) error {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository name": options.Name})
+	if err != nil {
+		return err
+	}
+	createRequest := bitbucketServerCreateRepositoryRequest{
+		Name:          options.Name,
+		ScmID:         "git",
+		Public:        options.Visibility == Public,
+		Description:   options.Description,
+		DefaultBranch: options.DefaultBranch,
+	}
+	// The README file is committed to the default branch, so it must be known
+	if options.InitReadme {
+		createRequest.DefaultBranch = getInitialBranch(options)
+	}
+	repositoriesURL := fmt.Sprintf("%s/api/1.0/projects/%s/repos", client.restEndpoint(), owner)
+	var repo bitbucketv1.Repository
+	if err = client.sendBitbucketServerRequest(ctx, http.MethodPost, repositoriesURL, createRequest, &repo); err != nil {
+		return err
+	}
+	if !options.InitReadme {
+		return nil
+	}
+	_, err = client.commitFile(ctx, owner, repo.Slug, createRequest.DefaultBranch, initialCommitMessage, "", createReadmeFileChange(options))
+	return err
+}
+
+// ForkRepository on Bitbucket server
+func (client *BitbucketServerClient) ForkRepository(ctx context.Context, owner, repository, targetOwner string) error {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository})
+	if err != nil {
+		return err
+	}
+	// Without a target project, the fork is created in the personal project of the authenticated user
+	var forkRequest bitbucketServerForkRequest
+	if targetOwner != "" {
+		forkRequest.Project = &bitbucketServerProjectKey{Key: targetOwner}
+	}
+	return client.sendBitbucketServerRequest(ctx, http.MethodPost, client.getRepositoryURL(owner, repository), forkRequest, nil)
+}
+
+// ArchiveRepository on Bitbucket server
+func (client *BitbucketServerClient) ArchiveRepository(ctx context.Context, owner, repository string) error {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository})
+	if err != nil {
+		return err
+	}
+	return client.sendBitbucketServerRequest(ctx, http.MethodPut, client.getRepositoryURL(owner, repository), bitbucketServerArchiveRequest{Archived: true}, nil)
+}
+
+// DeleteRepository on Bitbucket server
+func (client *BitbucketServerClient) DeleteRepository(ctx context.Context, owner, repository string) error {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository})
+	if err != nil {
+		return err
+	}
+	return client.sendBitbucketServerRequest(ctx, http.MethodDelete, client.getRepositoryURL(owner, repository), nil, nil)
+}
 
-	return RepositoryInfo{RepositoryVisibility: getBitbucketServerRepositoryVisibility(holder.Public), CloneInfo: info}, nil
+// getRepositoryURL returns the REST API URL of the repository. The client must be built first, to set the REST API endpoint.
+func (client *BitbucketServerClient) getRepositoryURL(owner, repository string) string {
+	return fmt.Sprintf("%s/api/1.0/projects/%s/repos/%s", client.restEndpoint(), owner, repository)
 }
 
 // GetCommitBySha on Bitbucket server
-func (client BitbucketServerClient) GetCommitBySha(ctx context.Context, owner, repository, sha string) (CommitInfo, error) {
+func (client *BitbucketServerClient) GetCommitBySha(ctx context.Context, owner, repository, sha string) (CommitInfo, error) {
 	err := validateParametersNotBlank(map[string]string{
 		"owner":      owner,
 		"repository": repository,
@@ -507,24 +1448,90 @@ func (client BitbucketServerClient) GetCommitBySha(ctx context.Context, owner, r
 	return client.mapBitbucketServerCommitToCommitInfo(commit, owner, repository), nil
 }
 
+// ListCommits on Bitbucket server.
+// The commits aren't filtered by the author and by the commit time on Bitbucket server, so they are filtered after listing.
+// The most recent commits are listed first, so the listing stops at the first commit committed before Since.
+func (client *BitbucketServerClient) ListCommits(owner, repository string, options ListCommitsOptions) *Pager[CommitInfo] {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository})
+	if err != nil {
+		return newErrorPager[CommitInfo](err)
+	}
+	return newNumberedPager(0, func(ctx context.Context, start int) ([]CommitInfo, int, error) {
+		bitbucketClient, err := client.buildBitbucketClient(ctx)
+		if err != nil {
+			return nil, 0, err
+		}
+		params := createPaginationOptions(start)
+		if options.Ref != "" {
+			params["until"] = options.Ref
+		}
+		if options.Path != "" {
+			params["path"] = options.Path
+		}
+		if options.PageSize > 0 {
+			params["limit"] = options.PageSize
+		}
+		apiResponse, err := bitbucketClient.GetCommits(owner, repository, params)
+		if err != nil {
+			return nil, 0, err
+		}
+		commits, err := bitbucketv1.GetCommitsResponse(apiResponse)
+		if err != nil {
+			return nil, 0, err
+		}
+		results := make([]CommitInfo, 0, len(commits))
+		for _, commit := range commits {
+			committedAt := time.UnixMilli(commit.CommitterTimestamp)
+			if options.committedBeforeSince(committedAt) {
+				return results, 0, nil
+			}
+			if options.matchesCommit(commit.Author.Name, commit.Author.EmailAddress, committedAt) {
+				results = append(results, client.mapBitbucketServerCommitToCommitInfo(commit, owner, repository))
+			}
+		}
+		_, nextPageStart := bitbucketv1.HasNextPage(apiResponse)
+		return results, nextPageStart, nil
+	})
+}
+
 // CreateLabel on Bitbucket server
-func (client BitbucketServerClient) CreateLabel(ctx context.Context, owner, repository string, labelInfo LabelInfo) error {
-	return errLabelsNotSupported
+func (client *BitbucketServerClient) CreateLabel(ctx context.Context, owner, repository string, labelInfo LabelInfo) error {
+	return errBitbucketServerLabelsNotSupported
 }
 
 // GetLabel on Bitbucket server
 func (client *BitbucketServerClient) GetLabel(ctx context.Context, owner, repository, name string) (*LabelInfo, error) {
-	return nil, errLabelsNotSupported
+	return nil, errBitbucketServerLabelsNotSupported
+}
+
+// ListLabels on Bitbucket server
+func (client *BitbucketServerClient) ListLabels(_, _ string) *Pager[LabelInfo] {
+	return newErrorPager[LabelInfo](errBitbucketServerLabelsNotSupported)
+}
+
+// UpdateLabel on Bitbucket server
+func (client *BitbucketServerClient) UpdateLabel(ctx context.Context, owner, repository, name string, labelInfo LabelInfo) error {
+	return errBitbucketServerLabelsNotSupported
+}
+
+// DeleteLabel on Bitbucket server
+func (client *BitbucketServerClient) DeleteLabel(ctx context.Context, owner, repository, name string) error {
+	return errBitbucketServerLabelsNotSupported
+}
+
+// AddPullRequestLabels on Bitbucket server
+func (client *BitbucketServerClient) AddPullRequestLabels(ctx context.Context, owner, repository string, pullRequestID int, labels ...string) error {
+	return errBitbucketServerLabelsNotSupported
 }
 
 // ListPullRequestLabels on Bitbucket server
-func (client *BitbucketServerClient) ListPullRequestLabels(ctx context.Context, owner, repository string, pullRequestID int) ([]string, error) {
-	return nil, errLabelsNotSupported
+func (client *BitbucketServerClient) ListPullRequestLabels(_, _ string, _ int) *Pager[string] {
+	return newErrorPager[string](errBitbucketServerLabelsNotSupported)
 }
 
 // UnlabelPullRequest on Bitbucket server
 func (client *BitbucketServerClient) UnlabelPullRequest(ctx context.Context, owner, repository, name string, pullRequestID int) error {
-	return errLabelsNotSupported
+	return errBitbucketServerLabelsNotSupported
 }
 
 // GetRepositoryEnvironmentInfo on Bitbucket server
@@ -533,26 +1540,29 @@ func (client *BitbucketServerClient) GetRepositoryEnvironmentInfo(ctx context.Co
 }
 
 // Get all projects for which the authenticated user has the PROJECT_VIEW permission
-func (client *BitbucketServerClient) listProjects(bitbucketClient *bitbucketv1.DefaultApiService) ([]string, error) {
-	var apiResponse *bitbucketv1.APIResponse
-	var err error
-	var projects []string
-	for isLastProjectsPage, nextProjectsPageStart := true, 0; isLastProjectsPage; isLastProjectsPage, nextProjectsPageStart = bitbucketv1.HasNextPage(apiResponse) {
-		apiResponse, err = bitbucketClient.GetProjects(createPaginationOptions(nextProjectsPageStart))
+func (client *BitbucketServerClient) listProjects(ctx context.Context, bitbucketClient *bitbucketv1.DefaultApiService) ([]string, error) {
+	var username string
+	projects, err := newNumberedPager(0, func(ctx context.Context, start int) ([]string, int, error) {
+		apiResponse, err := bitbucketClient.GetProjects(createPaginationOptions(start))
 		if err != nil {
-			return nil, err
+			return nil, 0, err
 		}
 		projectsResponse := &projectsResponse{}
-		err = unmarshalAPIResponseValues(apiResponse, projectsResponse)
-		if err != nil {
-			return nil, err
+		if err = unmarshalAPIResponseValues(apiResponse, projectsResponse); err != nil {
+			return nil, 0, err
 		}
+		keys := make([]string, 0, len(projectsResponse.Values))
 		for _, project := range projectsResponse.Values {
-			projects = append(projects, project.Key)
+			keys = append(keys, project.Key)
 		}
+		username = apiResponse.Header.Get("X-Ausername")
+		_, nextPageStart := bitbucketv1.HasNextPage(apiResponse)
+		return keys, nextPageStart, nil
+	}).All(ctx)
+	if err != nil {
+		return nil, err
 	}
 	// Add user's private project
-	username := apiResponse.Header.Get("X-Ausername")
 	if username == "" {
 		return []string{}, errors.New("X-Ausername header is missing")
 	}
@@ -574,10 +1584,27 @@ func (client *BitbucketServerClient) DownloadFileFromRepo(ctx context.Context, o
 	return resp.Payload, resp.StatusCode, err
 }
 
+// escapeURLPath escapes every segment of a file path, so it can be used in a URL path
+func escapeURLPath(filePath string) string {
+	segments := strings.Split(filePath, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return strings.Join(segments, "/")
+}
+
 func createPaginationOptions(nextPageStart int) map[string]interface{} {
 	return map[string]interface{}{"start": nextPageStart}
 }
 
+// getBitbucketServerNextPageStart returns the start of the next page, or zero if the page is the last one
+func getBitbucketServerNextPageStart(isLastPage bool, nextPageStart int) int {
+	if isLastPage {
+		return 0
+	}
+	return nextPageStart
+}
+
 func unmarshalAPIResponseValues(response *bitbucketv1.APIResponse, target interface{}) error {
 	responseBytes, err := json.Marshal(response.Values)
 	if err != nil {
@@ -586,6 +1613,18 @@ func unmarshalAPIResponseValues(response *bitbucketv1.APIResponse, target interf
 	return json.Unmarshal(responseBytes, &target)
 }
 
+func getBitbucketServerCommentVersion(bitbucketClient *bitbucketv1.DefaultApiService, owner, repository string, pullRequestID int, commentID int64) (int, error) {
+	apiResponse, err := bitbucketClient.GetComment_6(owner, repository, int64(pullRequestID), commentID)
+	if err != nil {
+		return 0, err
+	}
+	var comment bitbucketv1.ActivityComment
+	if err = unmarshalAPIResponseValues(apiResponse, &comment); err != nil {
+		return 0, err
+	}
+	return comment.Version, nil
+}
+
 func getBitbucketServerWebhookID(r *bitbucketv1.APIResponse) (string, error) {
 	webhook := &bitbucketv1.Webhook{}
 	err := unmarshalAPIResponseValues(r, webhook)
@@ -616,13 +1655,78 @@ func getBitbucketServerWebhookEvents(webhookEvents ...vcsutils.WebhookEvent) []s
 			events = append(events, "pr:merged")
 		case vcsutils.PrRejected:
 			events = append(events, "pr:declined", "pr:deleted")
-		case vcsutils.Push:
-			events = append(events, "repo:refs_changed")
+		case vcsutils.Push, vcsutils.TagPushed, vcsutils.TagDeleted, vcsutils.BranchCreated, vcsutils.BranchDeleted:
+			events = appendMissing(events, "repo:refs_changed")
+		case vcsutils.PrCommentAdded:
+			events = append(events, "pr:comment:added")
+		case vcsutils.PrReviewSubmitted:
+			events = append(events, "pr:reviewer:needs_work")
+		case vcsutils.PrApproved:
+			events = append(events, "pr:reviewer:approved")
+		}
+		// Bitbucket server has no releases, so ReleasePublished isn't registered
+	}
+	return events
+}
+
+func mapBitbucketServerWebhookToWebhookInfo(webhook bitbucketServerWebhook) WebhookInfo {
+	return WebhookInfo{
+		ID:                 strconv.Itoa(webhook.ID),
+		PayloadURL:         webhook.URL,
+		Events:             mapBitbucketServerWebhookEvents(webhook.Events),
+		Active:             webhook.Active,
+		LastDeliveryStatus: getBitbucketServerWebhookDeliveryStatus(webhook.Statistics),
+	}
+}
+
+// Get a slice of Bitbucket server webhook events and return the matching webhook events
+func mapBitbucketServerWebhookEvents(bitbucketEvents []string) []vcsutils.WebhookEvent {
+	events := make([]vcsutils.WebhookEvent, 0, len(bitbucketEvents))
+	for _, event := range bitbucketEvents {
+		switch event {
+		case "pr:opened":
+			events = append(events, vcsutils.PrOpened)
+		case "pr:from_ref_updated":
+			events = append(events, vcsutils.PrEdited)
+		case "pr:merged":
+			events = append(events, vcsutils.PrMerged)
+		// A rejected pull request webhook is created with both pr:declined and pr:deleted, so only one of them is mapped
+		case "pr:declined":
+			events = append(events, vcsutils.PrRejected)
+		case "repo:refs_changed":
+			events = append(events, vcsutils.Push, vcsutils.TagPushed, vcsutils.TagDeleted, vcsutils.BranchCreated, vcsutils.BranchDeleted)
+		case "pr:comment:added":
+			events = append(events, vcsutils.PrCommentAdded)
+		case "pr:reviewer:needs_work":
+			events = append(events, vcsutils.PrReviewSubmitted)
+		case "pr:reviewer:approved":
+			events = append(events, vcsutils.PrApproved)
 		}
 	}
 	return events
 }
 
+// The statistics contain the last successful, failed and erroneous invocations. The latest of them is the last delivery.
+func getBitbucketServerWebhookDeliveryStatus(statistics *bitbucketServerWebhookStatistics) WebhookDeliveryStatus {
+	if statistics == nil {
+		return DeliveryUnknown
+	}
+	status, lastStart := DeliveryUnknown, int64(0)
+	for _, invocation := range []struct {
+		status     WebhookDeliveryStatus
+		invocation *bitbucketServerWebhookInvocation
+	}{
+		{DeliverySucceeded, statistics.LastSuccess},
+		{DeliveryFailed, statistics.LastFailure},
+		{DeliveryFailed, statistics.LastError},
+	} {
+		if invocation.invocation != nil && invocation.invocation.Start > lastStart {
+			status, lastStart = invocation.status, invocation.invocation.Start
+		}
+	}
+	return status
+}
+
 func (client *BitbucketServerClient) mapBitbucketServerCommitToCommitInfo(commit bitbucketv1.Commit,
 	owner, repo string) CommitInfo {
 	parents := make([]string, len(commit.Parents))
@@ -630,15 +1734,23 @@ func (client *BitbucketServerClient) mapBitbucketServerCommitToCommitInfo(commit
 		parents[i] = p.ID
 	}
 	url := fmt.Sprintf("%s/api/1.0/projects/%s/repos/%s/commits/%s",
-		client.vcsInfo.APIEndpoint, owner, repo, commit.ID)
+		client.restEndpoint(), owner, repo, commit.ID)
+	webURL := fmt.Sprintf("%s/projects/%s/repos/%s/commits/%s",
+		strings.TrimSuffix(client.restEndpoint(), "/rest"), owner, repo, commit.ID)
 	return CommitInfo{
-		Hash:          commit.ID,
-		AuthorName:    commit.Author.Name,
-		CommitterName: commit.Committer.Name,
-		Url:           url,
-		Timestamp:     commit.CommitterTimestamp,
-		Message:       commit.Message,
-		ParentHashes:  parents,
+		Hash:           commit.ID,
+		AuthorName:     commit.Author.Name,
+		AuthorEmail:    commit.Author.EmailAddress,
+		CommitterName:  commit.Committer.Name,
+		CommitterEmail: commit.Committer.EmailAddress,
+		Url:            url,
+		WebURL:         webURL,
+		Timestamp:      commit.CommitterTimestamp,
+		// The timestamps are in milliseconds
+		AuthorDate:   time.UnixMilli(commit.AuthorTimestamp).UTC(),
+		CommitDate:   time.UnixMilli(commit.CommitterTimestamp).UTC(),
+		Message:      commit.Message,
+		ParentHashes: parents,
 	}
 }
 
@@ -658,6 +1770,15 @@ type diffPayload struct {
 }
 
 func (client *BitbucketServerClient) GetModifiedFiles(ctx context.Context, owner, repository, refBefore, refAfter string) ([]string, error) {
+	fileChanges, err := client.GetFileChanges(ctx, owner, repository, refBefore, refAfter)
+	if err != nil {
+		return nil, err
+	}
+	return getFileChangesPaths(fileChanges), nil
+}
+
+// GetFileChanges on Bitbucket server
+func (client *BitbucketServerClient) GetFileChanges(ctx context.Context, owner, repository, refBefore, refAfter string) ([]FileChange, error) {
 	err := validateParametersNotBlank(map[string]string{
 		"owner":      owner,
 		"repository": repository,
@@ -684,15 +1805,82 @@ func (client *BitbucketServerClient) GetModifiedFiles(ctx context.Context, owner
 		return nil, err
 	}
 
-	fileNamesSet := datastructures.MakeSet[string]()
+	fileChanges := make([]FileChange, 0, len(dst.Diffs))
 	for _, diff := range dst.Diffs {
-		fileNamesSet.Add(diff.Source.ToString)
-		fileNamesSet.Add(diff.Destination.ToString)
+		// The source is missing for added files, and the destination is missing for removed files
+		source, destination := diff.Source.ToString, diff.Destination.ToString
+		fileChange := FileChange{Path: destination, Status: vcsutils.FileModified}
+		switch {
+		case source == "":
+			fileChange.Status = vcsutils.FileAdded
+		case destination == "":
+			fileChange.Status = vcsutils.FileRemoved
+			fileChange.Path = source
+		case source != destination:
+			fileChange.Status = vcsutils.FileRenamed
+			fileChange.PreviousPath = source
+		}
+		fileChanges = append(fileChanges, fileChange)
+	}
+	sortFileChanges(fileChanges)
+	return fileChanges, nil
+}
+
+// Bitbucket server anchors a comment to a single line, so a comment on a range of lines is anchored to its last line.
+// The line type can't be inferred from the comment, so the lines on the right side are assumed to be added
+// and the lines on the left side are assumed to be removed.
+func createBitbucketServerCommentAnchor(comment ReviewComment) *bitbucketv1.Anchor {
+	anchor := &bitbucketv1.Anchor{
+		DiffType: bitbucketv1.DiffTypeEffective,
+		Path:     comment.Path,
+		Line:     comment.Line,
+		LineType: bitbucketv1.LineTypeAdded,
+		FileType: bitbucketv1.FileTypeTo,
+	}
+	if comment.Side == LeftSide {
+		anchor.LineType = bitbucketv1.LineTypeRemoved
+		anchor.FileType = bitbucketv1.FileTypeFrom
+	}
+	return anchor
+}
+
+func mapBitbucketServerActivityToReviewCommentInfo(activity bitbucketv1.Activity) ReviewCommentInfo {
+	side := RightSide
+	if activity.CommentAnchor.FileType == bitbucketv1.FileTypeFrom {
+		side = LeftSide
+	}
+	return ReviewCommentInfo{
+		ID:      int64(activity.Comment.ID),
+		Content: activity.Comment.Text,
+		Created: time.UnixMilli(activity.Comment.CreatedDate),
+		Path:    activity.CommentAnchor.Path,
+		Line:    activity.CommentAnchor.Line,
+		Side:    side,
+	}
+}
+
+func mapBitbucketServerRepositoryToRepositoryInfo(repo bitbucketServerRepository) RepositoryInfo {
+	repositoryInfo := RepositoryInfo{
+		RepositoryVisibility: getBitbucketServerRepositoryVisibility(repo.Public),
+		Description:          repo.Description,
+		Archived:             repo.Archived,
+		Fork:                 repo.Origin != nil,
+	}
+	for _, cloneLink := range repo.Links.Clone {
+		switch cloneLink.Name {
+		case "http":
+			repositoryInfo.CloneInfo.HTTP = cloneLink.HRef
+		case "ssh":
+			repositoryInfo.CloneInfo.SSH = cloneLink.HRef
+		}
+	}
+	if len(repo.Links.Self) > 0 {
+		repositoryInfo.WebURL = repo.Links.Self[0].HRef
+	}
+	if repo.Origin != nil {
+		repositoryInfo.Parent = &RepositoryReference{Owner: repo.Origin.Project.Key, Name: repo.Origin.Slug}
 	}
-	_ = fileNamesSet.Remove("") // Make sure there are no blank filepath.
-	fileNamesList := fileNamesSet.ToSlice()
-	sort.Strings(fileNamesList)
-	return fileNamesList, nil
+	return repositoryInfo
 }
 
 func getBitbucketServerRepositoryVisibility(public bool) RepositoryVisibility {
@@ -8,6 +8,7 @@ import (
 	"io"
 	"math/rand"
 	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"reflect"
@@ -72,6 +73,45 @@ func TestBitbucketServer_ListRepositories(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestBitbucketServer_StreamRepositories(t *testing.T) {
+	ctx := context.Background()
+	client, _, cleanUp := createRoutingServerAndClient(t, vcsutils.BitbucketServer, false, map[string]interface{}{
+		"/rest/api/1.0/repos?archived=ARCHIVED&limit=100&visibility=public&start=0": []byte(`{"isLastPage":false,"nextPageStart":2,"values":[
+			{"slug":"repo-1","project":{"key":"PRJ"},"public":true,"archived":true},
+			{"slug":"other","project":{"key":"PRJ"},"public":true,"archived":true}]}`),
+		"/rest/api/1.0/repos?archived=ARCHIVED&limit=100&visibility=public&start=2": []byte(`{"isLastPage":true,"values":[
+			{"slug":"repo-2","project":{"key":"~FROGGER"},"public":true,"archived":true}]}`),
+		"/rest/api/1.0/projects/PRJ/repos/repo-1/branches/default":      bitbucketv1.Branch{DisplayID: "main"},
+		"/rest/api/1.0/projects/~FROGGER/repos/repo-2/branches/default": bitbucketv1.Branch{DisplayID: "master"},
+	})
+	defer cleanUp()
+
+	options := ListRepositoriesOptions{Visibility: vcsutils.PointerOf(Public), Archived: vcsutils.PointerOf(true), Search: "repo"}
+	repositories, err := collectRepositories(client.StreamRepositories(ctx, options))
+	assert.NoError(t, err)
+	assert.Equal(t, []RepositoryListItem{
+		{Owner: "PRJ", Name: repo1, RepositoryInfo: RepositoryInfo{RepositoryVisibility: Public, Archived: true, DefaultBranch: "main"}},
+		{Owner: "~FROGGER", Name: repo2, RepositoryInfo: RepositoryInfo{RepositoryVisibility: Public, Archived: true, DefaultBranch: "master"}},
+	}, repositories)
+
+	// The repositories of an owner are listed from its project
+	client, _, cleanUp = createRoutingServerAndClient(t, vcsutils.BitbucketServer, false, map[string]interface{}{
+		"/rest/api/1.0/projects/jfrog/repos?limit=100&start=0":       []byte(`{"isLastPage":true,"values":[{"slug":"repo-1","project":{"key":"jfrog"}}]}`),
+		"/rest/api/1.0/projects/jfrog/repos/repo-1/branches/default": bitbucketv1.Branch{DisplayID: "main"},
+	})
+	defer cleanUp()
+	repositories, err = collectRepositories(client.StreamRepositories(ctx, ListRepositoriesOptions{Owner: owner}))
+	assert.NoError(t, err)
+	assert.Equal(t, []RepositoryListItem{{Owner: owner, Name: repo1, RepositoryInfo: RepositoryInfo{RepositoryVisibility: Private, DefaultBranch: "main"}}}, repositories)
+
+	_, err = collectRepositories(client.StreamRepositories(ctx, ListRepositoriesOptions{UpdatedSince: time.Now()}))
+	var unsupportedErr *UnsupportedFeatureError
+	assert.True(t, errors.As(err, &unsupportedErr))
+
+	_, err = collectRepositories(createBadBitbucketServerClient(t).StreamRepositories(ctx, ListRepositoriesOptions{}))
+	assert.Error(t, err)
+}
+
 func TestBitbucketServer_ListBranches(t *testing.T) {
 	ctx := context.Background()
 	mockResponse := map[string][]bitbucketv1.Branch{
@@ -80,14 +120,189 @@ func TestBitbucketServer_ListBranches(t *testing.T) {
 	client, cleanUp := createServerAndClient(t, vcsutils.BitbucketServer, false, mockResponse, "/rest/api/1.0/projects/jfrog/repos/repo-1/branches?start=0", createBitbucketServerHandler)
 	defer cleanUp()
 
-	actualRepositories, err := client.ListBranches(ctx, owner, repo1)
+	actualRepositories, err := client.ListBranches(owner, repo1).All(ctx)
 	assert.NoError(t, err)
 	assert.ElementsMatch(t, actualRepositories, []string{branch1, branch2})
 
-	_, err = createBadBitbucketServerClient(t).ListBranches(ctx, owner, repo1)
+	_, err = createBadBitbucketServerClient(t).ListBranches(owner, repo1).All(ctx)
+	assert.Error(t, err)
+}
+
+func TestBitbucketServer_CreateBranch(t *testing.T) {
+	ctx := context.Background()
+	expectedBody := []byte(`{"name":"` + branch1 + `","startPoint":"` + branch2 + `"}` + "\n")
+	client, cleanUp := createBodyHandlingServerAndClient(t, vcsutils.BitbucketServer, false, bitbucketv1.Branch{},
+		"/rest/branch-utils/1.0/projects/jfrog/repos/repo-1/branches", http.StatusOK, expectedBody, http.MethodPost,
+		createBitbucketServerWithBodyHandler)
+	defer cleanUp()
+
+	err := client.CreateBranch(ctx, owner, repo1, branch1, branch2)
+	assert.NoError(t, err)
+
+	err = createBadBitbucketServerClient(t).CreateBranch(ctx, owner, repo1, branch1, branch2)
+	assert.Error(t, err)
+}
+
+func TestBitbucketServer_DeleteBranch(t *testing.T) {
+	ctx := context.Background()
+	expectedBody := []byte(`{"name":"refs/heads/` + branch1 + `"}` + "\n")
+	client, cleanUp := createBodyHandlingServerAndClient(t, vcsutils.BitbucketServer, false, nil,
+		"/rest/branch-utils/1.0/projects/jfrog/repos/repo-1/branches", http.StatusOK, expectedBody, http.MethodDelete,
+		createBitbucketServerWithBodyHandler)
+	defer cleanUp()
+
+	err := client.DeleteBranch(ctx, owner, repo1, branch1)
+	assert.NoError(t, err)
+
+	err = createBadBitbucketServerClient(t).DeleteBranch(ctx, owner, repo1, branch1)
+	assert.Error(t, err)
+}
+
+func TestBitbucketServer_GetBranch(t *testing.T) {
+	ctx := context.Background()
+	client, _, cleanUp := createRoutingServerAndClient(t, vcsutils.BitbucketServer, false, map[string]interface{}{
+		"/rest/api/1.0/projects/jfrog/repos/repo-1/branches?filterText=" + branch1 + "&start=0": map[string]interface{}{
+			"values": []bitbucketv1.Branch{
+				{ID: "refs/heads/" + branch1 + "-fix", DisplayID: branch1 + "-fix"},
+				{ID: "refs/heads/" + branch1, DisplayID: branch1, LatestCommit: commitHash, IsDefault: true},
+			},
+			"isLastPage": true,
+		},
+		"/rest/api/1.0/projects/jfrog/repos/repo-1/branches?filterText=" + branch2 + "&start=0": map[string]interface{}{
+			"values":     []bitbucketv1.Branch{},
+			"isLastPage": true,
+		},
+		"/rest/branch-permissions/2.0/projects/jfrog/repos/repo-1/restrictions?matcherType=BRANCH&matcherId=refs%2Fheads%2F" + branch1: map[string]interface{}{
+			"values": []map[string]interface{}{{"id": 1, "type": "no-deletes"}},
+		},
+	})
+	defer cleanUp()
+
+	branchDetails, err := client.GetBranch(ctx, owner, repo1, branch1)
+	assert.NoError(t, err)
+	assert.Equal(t, BranchDetails{Name: branch1, CommitSHA: commitHash, Protected: true, Default: true}, branchDetails)
+
+	_, err = client.GetBranch(ctx, owner, repo1, branch2)
+	assert.Error(t, err)
+}
+
+func TestBitbucketServer_CommitFiles(t *testing.T) {
+	ctx := context.Background()
+	client, requestBodies, cleanUp := createRoutingServerAndClient(t, vcsutils.BitbucketServer, false, map[string]interface{}{
+		"/rest/api/1.0/projects/jfrog/repos/repo-1/branches?filterText=" + branch1 + "&start=0": map[string]interface{}{
+			"values":     []bitbucketv1.Branch{{ID: "refs/heads/" + branch1, DisplayID: branch1, LatestCommit: commitHash}},
+			"isLastPage": true,
+		},
+		"/rest/api/1.0/projects/jfrog/repos/repo-1/browse/docs/new%20file.md": bitbucketv1.Commit{ID: "first-commit"},
+		"/rest/api/1.0/projects/jfrog/repos/repo-1/browse/go.mod":             bitbucketv1.Commit{ID: "second-commit"},
+	})
+	defer cleanUp()
+
+	err := client.CommitFiles(ctx, owner, repo1, branch1, "Update files", []CommitFileChange{
+		{Action: CreateFile, Path: "docs/new file.md", Content: []byte("# New file")},
+		{Action: UpdateFile, Path: "go.mod", Content: []byte("module example")},
+	}, CommitAuthor{})
+	assert.NoError(t, err)
+
+	createBody := string(requestBodies["PUT /rest/api/1.0/projects/jfrog/repos/repo-1/browse/docs/new%20file.md"])
+	assert.Contains(t, createBody, "Update files")
+	assert.Contains(t, createBody, "# New file")
+	assert.NotContains(t, createBody, "sourceCommitId")
+	// The second file is committed on top of the first commit
+	updateBody := string(requestBodies["PUT /rest/api/1.0/projects/jfrog/repos/repo-1/browse/go.mod"])
+	assert.Contains(t, updateBody, "module example")
+	assert.Contains(t, updateBody, "first-commit")
+
+	err = client.CommitFiles(ctx, owner, repo1, branch1, "Update files", []CommitFileChange{
+		{Action: UpdateFile, Path: "go.mod", Content: []byte("module example")},
+		{Action: DeleteFile, Path: "old.txt"},
+	}, CommitAuthor{})
+	var unsupportedErr *UnsupportedFeatureError
+	assert.True(t, errors.As(err, &unsupportedErr))
+}
+
+func TestBitbucketServer_CommitFilesPartialFailure(t *testing.T) {
+	ctx := context.Background()
+	client, _, cleanUp := createRoutingServerAndClient(t, vcsutils.BitbucketServer, false, map[string]interface{}{
+		"/rest/api/1.0/projects/jfrog/repos/repo-1/branches?filterText=" + branch1 + "&start=0": map[string]interface{}{
+			"values":     []bitbucketv1.Branch{{ID: "refs/heads/" + branch1, DisplayID: branch1, LatestCommit: commitHash}},
+			"isLastPage": true,
+		},
+		"/rest/api/1.0/projects/jfrog/repos/repo-1/browse/README.md": bitbucketv1.Commit{ID: "first-commit"},
+		"/rest/api/1.0/projects/jfrog/repos/repo-1/browse/go.mod":    []byte("not a commit"),
+	})
+	defer cleanUp()
+
+	err := client.CommitFiles(ctx, owner, repo1, branch1, "Update files", []CommitFileChange{
+		{Action: UpdateFile, Path: "README.md", Content: []byte("# Readme")},
+		{Action: UpdateFile, Path: "go.mod", Content: []byte("module example")},
+		{Action: UpdateFile, Path: "go.sum", Content: []byte("")},
+	}, CommitAuthor{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to commit go.mod, after the changes of README.md were already committed")
+}
+
+func TestBitbucketServer_ListTags(t *testing.T) {
+	ctx := context.Background()
+	client, _, cleanUp := createRoutingServerAndClient(t, vcsutils.BitbucketServer, false, map[string]interface{}{
+		"/rest/api/1.0/projects/jfrog/repos/repo-1/tags?start=0": map[string]interface{}{
+			"values":        []bitbucketv1.Tag{{ID: "refs/tags/v1.0.0", DisplayID: "v1.0.0", LatestCommit: commitHash}},
+			"isLastPage":    false,
+			"nextPageStart": 1,
+		},
+		"/rest/api/1.0/projects/jfrog/repos/repo-1/tags?start=1": map[string]interface{}{
+			"values":     []bitbucketv1.Tag{{ID: "refs/tags/v1.1.0", DisplayID: "v1.1.0", LatestCommit: "1"}},
+			"isLastPage": true,
+		},
+	})
+	defer cleanUp()
+
+	tags, err := client.ListTags(owner, repo1).All(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []TagInfo{{Name: "v1.0.0", CommitSHA: commitHash}, {Name: "v1.1.0", CommitSHA: "1"}}, tags)
+
+	_, err = createBadBitbucketServerClient(t).ListTags(owner, repo1).All(ctx)
+	assert.Error(t, err)
+}
+
+func TestBitbucketServer_CreateTag(t *testing.T) {
+	ctx := context.Background()
+	expectedBody := []byte(`{"name":"v1.0.0","startPoint":"` + commitHash + `","message":"Release 1.0.0"}` + "\n")
+	client, cleanUp := createBodyHandlingServerAndClient(t, vcsutils.BitbucketServer, false, bitbucketv1.Tag{},
+		"/rest/api/1.0/projects/jfrog/repos/repo-1/tags", http.StatusOK, expectedBody, http.MethodPost,
+		createBitbucketServerWithBodyHandler)
+	defer cleanUp()
+
+	err := client.CreateTag(ctx, owner, repo1, "v1.0.0", commitHash, "Release 1.0.0")
+	assert.NoError(t, err)
+
+	err = createBadBitbucketServerClient(t).CreateTag(ctx, owner, repo1, "v1.0.0", commitHash, "")
 	assert.Error(t, err)
 }
 
+func TestBitbucketServer_DeleteTag(t *testing.T) {
+	ctx := context.Background()
+	client, cleanUp := createServerAndClient(t, vcsutils.BitbucketServer, false, nil,
+		"/rest/git/1.0/projects/jfrog/repos/repo-1/tags/v1.0.0", createBitbucketServerHandler)
+	defer cleanUp()
+
+	err := client.DeleteTag(ctx, owner, repo1, "v1.0.0")
+	assert.NoError(t, err)
+
+	err = createBadBitbucketServerClient(t).DeleteTag(ctx, owner, repo1, "v1.0.0")
+	assert.Error(t, err)
+}
+
+func TestBitbucketServer_ReleasesNotSupported(t *testing.T) {
+	ctx := context.Background()
+	client := createBadBitbucketServerClient(t)
+	var unsupportedErr *UnsupportedFeatureError
+	_, err := client.CreateRelease(ctx, owner, repo1, CreateReleaseOptions{TagName: "v1.0.0"})
+	assert.True(t, errors.As(err, &unsupportedErr))
+	_, err = client.ListReleases(owner, repo1).All(ctx)
+	assert.True(t, errors.As(err, &unsupportedErr))
+}
+
 func TestBitbucketServer_CreateWebhook(t *testing.T) {
 	ctx := context.Background()
 	id := rand.Int31()
@@ -136,18 +351,202 @@ func TestBitbucketServer_DeleteWebhook(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestBitbucketServer_ListWebhooks(t *testing.T) {
+	ctx := context.Background()
+	webhook := []byte(`{"id":1,"url":"https://jfrog.com/hook","active":true,
+		"events":["repo:refs_changed","pr:declined","pr:deleted"],
+		"statistics":{"lastSuccess":{"start":1672567200000},"lastFailure":{"start":1672567260000}}}`)
+	client, _, cleanUp := createRoutingServerAndClient(t, vcsutils.BitbucketServer, false, map[string]interface{}{
+		"/rest/api/1.0/projects/jfrog/repos/repo-1/webhooks?statistics=true&start=0":                             []byte(`{"isLastPage":true,"values":[` + string(webhook) + `]}`),
+		"/rest/api/1.0/projects/jfrog/repos/repo-1/webhooks/1?statistics=true":                                   webhook,
+		"/rest/api/1.0/projects/jfrog/repos/repo-1/webhooks/test?webhookId=1&url=https%3A%2F%2Fjfrog.com%2Fhook": []byte(`{"response":{"statusCode":200}}`),
+	})
+	defer cleanUp()
+
+	expected := WebhookInfo{
+		ID:                 "1",
+		PayloadURL:         "https://jfrog.com/hook",
+		Events:             []vcsutils.WebhookEvent{vcsutils.Push, vcsutils.TagPushed, vcsutils.TagDeleted, vcsutils.BranchCreated, vcsutils.BranchDeleted, vcsutils.PrRejected},
+		Active:             true,
+		LastDeliveryStatus: DeliveryFailed,
+	}
+	webhooks, err := client.ListWebhooks(owner, repo1).All(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []WebhookInfo{expected}, webhooks)
+
+	webhookInfo, err := client.GetWebhook(ctx, owner, repo1, "1")
+	require.NoError(t, err)
+	assert.Equal(t, expected, webhookInfo)
+
+	assert.NoError(t, client.PingWebhook(ctx, owner, repo1, "1"))
+
+	_, err = createBadBitbucketServerClient(t).GetWebhook(ctx, owner, repo1, "1")
+	assert.Error(t, err)
+}
+
+func TestBitbucketServer_OrganizationWebhooks(t *testing.T) {
+	ctx := context.Background()
+	webhook := []byte(`{"id":1,"url":"https://jfrog.com/hook","active":true,"events":["repo:refs_changed"]}`)
+	client, bodies, cleanUp := createRoutingServerAndClient(t, vcsutils.BitbucketServer, false, map[string]interface{}{
+		"/rest/api/1.0/projects/jfrog/webhooks":                         webhook,
+		"/rest/api/1.0/projects/jfrog/webhooks/1":                       webhook,
+		"/rest/api/1.0/projects/jfrog/webhooks?statistics=true&start=0": []byte(`{"isLastPage":true,"values":[` + string(webhook) + `]}`),
+	})
+	defer cleanUp()
+
+	id, token, err := client.CreateOrganizationWebhook(ctx, owner, "https://jfrog.com/hook", vcsutils.Push)
+	require.NoError(t, err)
+	assert.Equal(t, "1", id)
+	assert.JSONEq(t, `{"url":"https://jfrog.com/hook","configuration":{"secret":"`+token+`"},"events":["repo:refs_changed"]}`,
+		string(bodies["POST /rest/api/1.0/projects/jfrog/webhooks"]))
+
+	err = client.UpdateOrganizationWebhook(ctx, owner, "https://jfrog.com/hook", token, id, vcsutils.PrMerged)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"url":"https://jfrog.com/hook","configuration":{"secret":"`+token+`"},"events":["pr:merged"]}`,
+		string(bodies["PUT /rest/api/1.0/projects/jfrog/webhooks/1"]))
+
+	webhooks, err := client.ListOrganizationWebhooks(owner).All(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []WebhookInfo{{ID: "1", PayloadURL: "https://jfrog.com/hook", Events: []vcsutils.WebhookEvent{vcsutils.Push, vcsutils.TagPushed, vcsutils.TagDeleted, vcsutils.BranchCreated, vcsutils.BranchDeleted}, Active: true}}, webhooks)
+
+	assert.NoError(t, client.DeleteOrganizationWebhook(ctx, owner, id))
+	assert.Error(t, client.DeleteOrganizationWebhook(ctx, owner, "not-a-number"))
+}
+
+func TestBitbucketServer_PingWebhookUnreachable(t *testing.T) {
+	ctx := context.Background()
+	client, _, cleanUp := createRoutingServerAndClient(t, vcsutils.BitbucketServer, false, map[string]interface{}{
+		"/rest/api/1.0/projects/jfrog/repos/repo-1/webhooks/1?statistics=true":                                   []byte(`{"id":1,"url":"https://jfrog.com/hook"}`),
+		"/rest/api/1.0/projects/jfrog/repos/repo-1/webhooks/test?webhookId=1&url=https%3A%2F%2Fjfrog.com%2Fhook": []byte(`{"exception":{"message":"Connection refused"}}`),
+	})
+	defer cleanUp()
+
+	assert.Error(t, client.PingWebhook(ctx, owner, repo1, "1"))
+}
+
+func TestGetBitbucketServerWebhookDeliveryStatus(t *testing.T) {
+	assert.Equal(t, DeliveryUnknown, getBitbucketServerWebhookDeliveryStatus(nil))
+	assert.Equal(t, DeliveryUnknown, getBitbucketServerWebhookDeliveryStatus(&bitbucketServerWebhookStatistics{}))
+	assert.Equal(t, DeliverySucceeded, getBitbucketServerWebhookDeliveryStatus(&bitbucketServerWebhookStatistics{
+		LastSuccess: &bitbucketServerWebhookInvocation{Start: 2},
+		LastError:   &bitbucketServerWebhookInvocation{Start: 1},
+	}))
+	assert.Equal(t, DeliveryFailed, getBitbucketServerWebhookDeliveryStatus(&bitbucketServerWebhookStatistics{
+		LastSuccess: &bitbucketServerWebhookInvocation{Start: 1},
+		LastError:   &bitbucketServerWebhookInvocation{Start: 2},
+	}))
+}
+
 func TestBitbucketServer_SetCommitStatus(t *testing.T) {
 	ctx := context.Background()
 	ref := "9caf1c431fb783b669f0f909bd018b40f2ea3808"
-	client, cleanUp := createServerAndClient(t, vcsutils.BitbucketServer, false, nil, fmt.Sprintf("/rest/build-status/1.0/commits/%s", ref), createBitbucketServerHandler)
+	status := CommitStatusOptions{
+		State:       Skipped,
+		Key:         "frogbot",
+		Name:        "Commit status title",
+		Description: "Commit status description",
+		DetailsURL:  "https://httpbin.org/anything",
+		BuildNumber: "12",
+	}
+	// Bitbucket server 7.4 and above sets the build status in the repository
+	buildsURI := fmt.Sprintf("/rest/api/1.0/projects/jfrog/repos/repo-1/commits/%s/builds", ref)
+	responses := map[string]interface{}{
+		"/rest/api/1.0/application-properties": map[string]string{"version": "7.21.0"},
+		buildsURI:                              []byte{},
+	}
+	client, bodies, cleanUp := createRoutingServerAndClient(t, vcsutils.BitbucketServer, false, responses)
 	defer cleanUp()
+	err := client.SetCommitStatus(ctx, owner, repo1, ref, status)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"state":"SUCCESSFUL","key":"frogbot","name":"Commit status title","url":"https://httpbin.org/anything",
+		"description":"Commit status description","buildNumber":"12"}`, string(bodies[http.MethodPost+" "+buildsURI]))
+
+	// The version is fetched once per client
+	delete(responses, "/rest/api/1.0/application-properties")
+	err = client.SetCommitStatus(ctx, owner, repo1, ref, status)
+	require.NoError(t, err)
+
+	// Older versions set the build status of the commit
+	buildStatusURI := fmt.Sprintf("/rest/build-status/1.0/commits/%s", ref)
+	client, bodies, cleanUp = createRoutingServerAndClient(t, vcsutils.BitbucketServer, false, map[string]interface{}{
+		"/rest/api/1.0/application-properties": map[string]string{"version": "7.3.2"},
+		buildStatusURI:                         []byte{},
+	})
+	defer cleanUp()
+	err = client.SetCommitStatus(ctx, owner, repo1, ref, status)
+	require.NoError(t, err)
+	assert.Contains(t, string(bodies[http.MethodPost+" "+buildStatusURI]), `"key":"frogbot"`)
+
+	err = createBadBitbucketServerClient(t).SetCommitStatus(ctx, owner, repo1, ref, status)
+	assert.Error(t, err)
+}
 
-	err := client.SetCommitStatus(ctx, Fail, owner, repo1, ref, "Commit status title", "Commit status description",
-		"https://httpbin.org/anything")
+func TestBitbucketServer_ListCommitStatuses(t *testing.T) {
+	ctx := context.Background()
+	commitsResponse, err := os.ReadFile(filepath.Join("testdata", "bitbucketserver", "commit_list_response.json"))
 	assert.NoError(t, err)
+	// The master branch is resolved to its latest commit, which is the first commit in the list response
+	client, _, cleanUp := createRoutingServerAndClient(t, vcsutils.BitbucketServer, false, map[string]interface{}{
+		fmt.Sprintf("/rest/api/1.0/projects/%s/repos/%s/commits?limit=1&limit=1&until=master", owner, repo1): commitsResponse,
+		"/rest/build-status/1.0/commits/def0123abcdef4567abcdef8987abcdef6543abc?start=0": []byte(`{"isLastPage":true,"values":[
+			{"state":"SUCCESSFUL","key":"build","name":"Build","url":"https://ci.example.com/build/1","description":"Build passed","dateAdded":1672567200000},
+			{"state":"FAILED","key":"scan","name":"Scan","url":"https://ci.example.com/scan/1","description":"Scan failed","dateAdded":1672567260000}]}`),
+	})
+	defer cleanUp()
+
+	statuses, err := client.ListCommitStatuses(owner, repo1, "master").All(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []CommitStatusInfo{
+		{
+			State: Pass, Context: "build", Description: "Build passed", DetailsURL: "https://ci.example.com/build/1",
+			CreatedAt: time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC), LastUpdatedAt: time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			State: Fail, Context: "scan", Description: "Scan failed", DetailsURL: "https://ci.example.com/scan/1",
+			CreatedAt: time.Date(2023, 1, 1, 10, 1, 0, 0, time.UTC), LastUpdatedAt: time.Date(2023, 1, 1, 10, 1, 0, 0, time.UTC),
+		},
+	}, statuses)
+
+	combined, err := client.GetCombinedCommitStatus(ctx, owner, repo1, "master")
+	require.NoError(t, err)
+	assert.Equal(t, Fail, combined.State)
+
+	_, err = createBadBitbucketServerClient(t).GetCombinedCommitStatus(ctx, owner, repo1, "master")
+	assert.Error(t, err)
+}
+
+func TestBitbucketServer_CreateCheckRun(t *testing.T) {
+	ctx := context.Background()
+	ref := "9caf1c431fb783b669f0f909bd018b40f2ea3808"
+	reportURI := fmt.Sprintf("/rest/insights/1.0/projects/jfrog/repos/repo-1/commits/%s/reports/frogbot", ref)
+	client, bodies, cleanUp := createRoutingServerAndClient(t, vcsutils.BitbucketServer, false, map[string]interface{}{
+		reportURI:                  []byte("{}"),
+		reportURI + "/annotations": []byte{},
+	})
+	defer cleanUp()
+
+	checkRun := CheckRunOptions{
+		Name:        "frogbot",
+		CommitSHA:   ref,
+		State:       Fail,
+		Title:       "Frogbot scan",
+		Summary:     "2 vulnerabilities",
+		DetailsURL:  "https://ci.example.com/scan/1",
+		Annotations: []CheckRunAnnotation{{Path: "main.go", StartLine: 3, EndLine: 4, Level: FailureAnnotation, Title: "CVE-2023-1", Message: "Vulnerable dependency"}},
+	}
+	checkRunID, err := client.CreateCheckRun(ctx, owner, repo1, checkRun)
+	require.NoError(t, err)
+	assert.Equal(t, "frogbot", checkRunID)
+	assert.JSONEq(t, `{"title":"Frogbot scan","details":"2 vulnerabilities","result":"FAIL","link":"https://ci.example.com/scan/1"}`, string(bodies[http.MethodPut+" "+reportURI]))
+	assert.JSONEq(t, fmt.Sprintf(`{"annotations":[{"externalId":"%s","path":"main.go","line":3,"message":"CVE-2023-1: Vulnerable dependency","severity":"HIGH"}]}`,
+		getBitbucketAnnotationExternalID(checkRun.Annotations[0])), string(bodies[http.MethodPost+" "+reportURI+"/annotations"]))
+
+	// A report in progress has no result
+	err = client.UpdateCheckRun(ctx, owner, repo1, checkRunID, CheckRunOptions{CommitSHA: ref, State: InProgress})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"title":"frogbot"}`, string(bodies[http.MethodPut+" "+reportURI]))
 
-	err = createBadBitbucketServerClient(t).SetCommitStatus(ctx, Fail, owner, repo1, ref, "Commit status title", "Commit status description",
-		"https://httpbin.org/anything")
+	_, err = createBadBitbucketServerClient(t).CreateCheckRun(ctx, owner, repo1, checkRun)
 	assert.Error(t, err)
 }
 
@@ -206,7 +605,7 @@ func TestBitbucketServer_ListOpenPullRequests(t *testing.T) {
 		fmt.Sprintf("/rest/api/1.0/projects/%s/repos/%s/pull-requests?start=0", owner, repo1), createBitbucketServerHandler)
 	defer cleanUp()
 
-	result, err := client.ListOpenPullRequests(ctx, owner, repo1)
+	result, err := client.ListOpenPullRequests(owner, repo1).All(ctx)
 
 	require.NoError(t, err)
 	assert.Len(t, result, 1)
@@ -225,7 +624,7 @@ func TestBitbucketServer_ListPullRequestComments(t *testing.T) {
 		fmt.Sprintf("/rest/api/1.0/projects/%s/repos/%s/pull-requests/1/activities?start=0", owner, repo1), createBitbucketServerHandler)
 	defer cleanUp()
 
-	result, err := client.ListPullRequestComments(ctx, owner, repo1, 1)
+	result, err := client.ListPullRequestComments(owner, repo1, 1).All(ctx)
 
 	require.NoError(t, err)
 	assert.Len(t, result, 1)
@@ -236,6 +635,142 @@ func TestBitbucketServer_ListPullRequestComments(t *testing.T) {
 	}, result[0])
 }
 
+func TestBitbucketServer_EditPullRequestComment(t *testing.T) {
+	ctx := context.Background()
+	commentURI := "/rest/api/1.0/projects/jfrog/repos/repo-1/pull-requests/1/comments/2"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, commentURI, r.RequestURI)
+		assert.Equal(t, "Bearer "+token, r.Header.Get("Authorization"))
+		if r.Method == http.MethodGet {
+			_, err := w.Write([]byte(`{"id":2,"version":3,"text":"Old content"}`))
+			require.NoError(t, err)
+			return
+		}
+		assert.Equal(t, http.MethodPut, r.Method)
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.Equal(t, `{"text":"Comment content","version":3}`+"\n", string(body))
+		_, err = w.Write([]byte(`{"id":2,"version":4,"text":"Comment content"}`))
+		require.NoError(t, err)
+	}))
+	defer server.Close()
+	client := buildClient(t, vcsutils.BitbucketServer, true, server)
+
+	err := client.EditPullRequestComment(ctx, owner, repo1, 1, 2, "Comment content")
+	assert.NoError(t, err)
+
+	err = createBadBitbucketServerClient(t).EditPullRequestComment(ctx, owner, repo1, 1, 2, "Comment content")
+	assert.Error(t, err)
+}
+
+func TestBitbucketServer_DeletePullRequestComment(t *testing.T) {
+	ctx := context.Background()
+	commentURI := "/rest/api/1.0/projects/jfrog/repos/repo-1/pull-requests/1/comments/2"
+	deleted := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer "+token, r.Header.Get("Authorization"))
+		if r.Method == http.MethodGet {
+			assert.Equal(t, commentURI, r.RequestURI)
+			_, err := w.Write([]byte(`{"id":2,"version":3,"text":"Comment content"}`))
+			require.NoError(t, err)
+			return
+		}
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, commentURI+"?version=3", r.RequestURI)
+		deleted = true
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+	client := buildClient(t, vcsutils.BitbucketServer, true, server)
+
+	err := client.DeletePullRequestComment(ctx, owner, repo1, 1, 2)
+	assert.NoError(t, err)
+	assert.True(t, deleted)
+
+	err = createBadBitbucketServerClient(t).DeletePullRequestComment(ctx, owner, repo1, 1, 2)
+	assert.Error(t, err)
+}
+
+func TestBitbucketServer_AddPullRequestReviewComments(t *testing.T) {
+	ctx := context.Background()
+	expectedBody := []byte(`{"text":"Comment content","anchor":{"diffType":"EFFECTIVE","line":5,"lineType":"REMOVED","fileType":"FROM","path":"file1.txt"}}`)
+	client, cleanUp := createBodyHandlingServerAndClient(t, vcsutils.BitbucketServer, false, nil,
+		"/rest/api/1.0/projects/jfrog/repos/repo-1/pull-requests/1/comments", http.StatusOK, expectedBody, http.MethodPost,
+		createBitbucketServerWithBodyHandler)
+	defer cleanUp()
+
+	comments := []ReviewComment{{Path: "file1.txt", Line: 5, Side: LeftSide, Content: "Comment content"}}
+	err := client.AddPullRequestReviewComments(ctx, owner, repo1, 1, comments)
+	assert.NoError(t, err)
+
+	err = createBadBitbucketServerClient(t).AddPullRequestReviewComments(ctx, owner, repo1, 1, comments)
+	assert.Error(t, err)
+}
+
+func TestBitbucketServer_ListPullRequestReviewComments(t *testing.T) {
+	ctx := context.Background()
+	response, err := os.ReadFile(filepath.Join("testdata", "bitbucketserver", "pull_request_comments_list_response.json"))
+	assert.NoError(t, err)
+	client, cleanUp := createServerAndClient(t, vcsutils.BitbucketServer, true, response,
+		fmt.Sprintf("/rest/api/1.0/projects/%s/repos/%s/pull-requests/1/activities?start=0", owner, repo1), createBitbucketServerHandler)
+	defer cleanUp()
+
+	result, err := client.ListPullRequestReviewComments(owner, repo1, 1).All(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []ReviewCommentInfo{{
+		ID:      1,
+		Content: "A measured reply.",
+		Created: time.UnixMilli(1548720847370),
+		Path:    "path/to/file",
+		Line:    1,
+		Side:    LeftSide,
+	}}, result)
+
+	_, err = createBadBitbucketServerClient(t).ListPullRequestReviewComments(owner, repo1, 1).All(ctx)
+	assert.Error(t, err)
+}
+
+func TestBitbucketServer_GetPullRequestDiff(t *testing.T) {
+	ctx := context.Background()
+	response, err := os.ReadFile(filepath.Join("testdata", "bitbucketserver", "pull_request_diff_response.diff"))
+	assert.NoError(t, err)
+	client, cleanUp := createServerAndClient(t, vcsutils.BitbucketServer, true, response,
+		fmt.Sprintf("/rest/api/1.0/projects/%s/repos/%s/pull-requests/1.diff", owner, repo1), createBitbucketServerHandler)
+	defer cleanUp()
+
+	result, err := client.GetPullRequestDiff(ctx, owner, repo1, 1)
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+	assert.Equal(t, "src/main.go", result[0].Path)
+	assert.Equal(t, vcsutils.FileModified, result[0].Status)
+	assert.Equal(t, []vcsutils.DiffLine{
+		{Type: vcsutils.ContextLine, Content: "func main() {", OldLine: 3, NewLine: 3},
+		{Type: vcsutils.RemovedLine, Content: "}", OldLine: 4},
+		{Type: vcsutils.AddedLine, Content: "} // main", NewLine: 4},
+	}, result[0].Hunks[0].Lines)
+	assert.Equal(t, "docs/readme.md", result[1].Path)
+	assert.Equal(t, vcsutils.FileAdded, result[1].Status)
+
+	_, err = createBadBitbucketServerClient(t).GetPullRequestDiff(ctx, owner, repo1, 1)
+	assert.Error(t, err)
+}
+
+func TestBitbucketServer_ListPullRequestFiles(t *testing.T) {
+	ctx := context.Background()
+	response, err := os.ReadFile(filepath.Join("testdata", "bitbucketserver", "pull_request_diff_response.diff"))
+	assert.NoError(t, err)
+	client, cleanUp := createServerAndClient(t, vcsutils.BitbucketServer, true, response,
+		fmt.Sprintf("/rest/api/1.0/projects/%s/repos/%s/pull-requests/1.diff", owner, repo1), createBitbucketServerHandler)
+	defer cleanUp()
+
+	result, err := client.ListPullRequestFiles(owner, repo1, 1).All(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []vcsutils.FileDiff{
+		{Path: "src/main.go", Status: vcsutils.FileModified, Additions: 1, Deletions: 1},
+		{Path: "docs/readme.md", Status: vcsutils.FileAdded, Additions: 1},
+	}, result)
+}
+
 func TestBitbucketServer_GetLatestCommit(t *testing.T) {
 	ctx := context.Background()
 	response, err := os.ReadFile(filepath.Join("testdata", "bitbucketserver", "commit_list_response.json"))
@@ -254,19 +789,73 @@ func TestBitbucketServer_GetLatestCommit(t *testing.T) {
 	expectedUrl := fmt.Sprintf("%s/rest/api/1.0/projects/jfrog/repos/repo-1"+
 		"/commits/def0123abcdef4567abcdef8987abcdef6543abc", serverUrl)
 	assert.Equal(t, CommitInfo{
-		Hash:          "def0123abcdef4567abcdef8987abcdef6543abc",
-		AuthorName:    "charlie",
-		CommitterName: "mark",
-		Url:           expectedUrl,
-		Timestamp:     1548720847610,
-		Message:       "More work on feature 1",
-		ParentHashes:  []string{"abcdef0123abcdef4567abcdef8987abcdef6543", "qwerty0123abcdef4567abcdef8987abcdef6543"},
+		Hash:           "def0123abcdef4567abcdef8987abcdef6543abc",
+		AuthorName:     "charlie",
+		AuthorEmail:    "charlie@example.com",
+		CommitterName:  "mark",
+		CommitterEmail: "mark@example.com",
+		Url:            expectedUrl,
+		WebURL:         fmt.Sprintf("%s/projects/jfrog/repos/repo-1/commits/def0123abcdef4567abcdef8987abcdef6543abc", serverUrl),
+		Timestamp:      1548720847610,
+		AuthorDate:     time.UnixMilli(1548720847609).UTC(),
+		CommitDate:     time.UnixMilli(1548720847610).UTC(),
+		Message:        "More work on feature 1",
+		ParentHashes:   []string{"abcdef0123abcdef4567abcdef8987abcdef6543", "qwerty0123abcdef4567abcdef8987abcdef6543"},
 	}, result)
 
 	_, err = createBadBitbucketServerClient(t).GetLatestCommit(ctx, owner, repo1, "master")
 	assert.Error(t, err)
 }
 
+func TestBitbucketServer_ListCommits(t *testing.T) {
+	ctx := context.Background()
+	response, err := os.ReadFile(filepath.Join("testdata", "bitbucketserver", "commit_list_response.json"))
+	assert.NoError(t, err)
+
+	// The limit is added twice by the go-bitbucket-v1 client
+	client, cleanUp := createServerAndClient(t, vcsutils.BitbucketServer, false, response,
+		fmt.Sprintf("/rest/api/1.0/projects/%s/repos/%s/commits?limit=10&limit=10&path=README.md&start=0&until=master", owner, repo1),
+		createBitbucketServerHandler)
+	defer cleanUp()
+
+	// The commits are filtered by the author and by the commit time after listing
+	options := ListCommitsOptions{Ref: "master", Path: "README.md", Author: "charlie@example.com", PageSize: 10}
+	result, err := client.ListCommits(owner, repo1, options).All(ctx)
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	assert.Equal(t, "def0123abcdef4567abcdef8987abcdef6543abc", result[0].Hash)
+
+	options.Since = time.UnixMilli(1548720847611)
+	result, err = client.ListCommits(owner, repo1, options).All(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, result)
+
+	_, err = createBadBitbucketServerClient(t).ListCommits(owner, repo1, ListCommitsOptions{}).All(ctx)
+	assert.Error(t, err)
+}
+
+func TestBitbucketServer_ListCommitsSince(t *testing.T) {
+	ctx := context.Background()
+	// The next page isn't requested, since the listing stops at the first commit committed before Since
+	client, _, cleanUp := createRoutingServerAndClient(t, vcsutils.BitbucketServer, false, map[string]interface{}{
+		fmt.Sprintf("/rest/api/1.0/projects/%s/repos/%s/commits?limit=2&limit=2&start=0", owner, repo1): map[string]interface{}{
+			"values": []bitbucketv1.Commit{
+				{ID: "new", CommitterTimestamp: 1672617600000},
+				{ID: "old", CommitterTimestamp: 1672358400000},
+			},
+			"isLastPage":    false,
+			"nextPageStart": 2,
+		},
+	})
+	defer cleanUp()
+
+	options := ListCommitsOptions{Since: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), PageSize: 2}
+	result, err := client.ListCommits(owner, repo1, options).All(ctx)
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	assert.Equal(t, "new", result[0].Hash)
+}
+
 func TestBitbucketServer_GetLatestCommitNotFound(t *testing.T) {
 	ctx := context.Background()
 	response := []byte(`{
@@ -390,7 +979,7 @@ func TestBitbucketServer_GetRepositoryInfo(t *testing.T) {
 		response,
 		fmt.Sprintf("/rest/api/1.0/projects/%s/repos/%s", owner, repo1),
 		http.StatusOK,
-		createBitbucketServerHandler,
+		createBitbucketServerRepositoryInfoHandler(`{"id":"refs/heads/main","displayId":"main"}`, http.StatusOK),
 	)
 	defer cleanUp()
 
@@ -404,6 +993,8 @@ func TestBitbucketServer_GetRepositoryInfo(t *testing.T) {
 					HTTP: "https://bitbucket.org/jfrog/repo-1.git",
 					SSH:  "ssh://git@bitbucket.org:jfrog/repo-1.git",
 				},
+				DefaultBranch: "main",
+				WebURL:        "http://link/to/repository",
 			},
 			res,
 		)
@@ -413,13 +1004,94 @@ func TestBitbucketServer_GetRepositoryInfo(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestBitbucketServer_GetRepositoryInfoEmptyFork(t *testing.T) {
+	ctx := context.Background()
+	response := []byte(`{"slug":"repo-1","description":"A fork","archived":true,"origin":{"slug":"upstream","project":{"key":"FROGS"}}}`)
+	client, cleanUp := createServerAndClientReturningStatus(t, vcsutils.BitbucketServer, false, response,
+		fmt.Sprintf("/rest/api/1.0/projects/%s/repos/%s", owner, repo1), http.StatusOK,
+		createBitbucketServerRepositoryInfoHandler(`{"errors":[{"message":"The repository has no default branch"}]}`, http.StatusNotFound))
+	defer cleanUp()
+
+	res, err := client.GetRepositoryInfo(ctx, owner, repo1)
+	require.NoError(t, err)
+	assert.Empty(t, res.DefaultBranch)
+	assert.Equal(t, "A fork", res.Description)
+	assert.True(t, res.Archived)
+	assert.True(t, res.Fork)
+	assert.Equal(t, &RepositoryReference{Owner: "FROGS", Name: "upstream"}, res.Parent)
+}
+
+func TestBitbucketServer_CreateRepository(t *testing.T) {
+	ctx := context.Background()
+	client, requestBodies, cleanUp := createRoutingServerAndClient(t, vcsutils.BitbucketServer, false, map[string]interface{}{
+		"/rest/api/1.0/projects/jfrog/repos":                           bitbucketv1.Repository{Slug: "new-repo"},
+		"/rest/api/1.0/projects/jfrog/repos/new-repo/browse/README.md": bitbucketv1.Commit{ID: commitHash},
+	})
+	defer cleanUp()
+
+	err := client.CreateRepository(ctx, owner, CreateRepositoryOptions{Name: "new-repo", Description: "A new repository", Visibility: Public, InitReadme: true})
+	assert.NoError(t, err)
+	// The README file is committed to the default branch, so it is set even if not requested
+	assert.JSONEq(t, `{"name":"new-repo","scmId":"git","public":true,"description":"A new repository","defaultBranch":"main"}`,
+		string(requestBodies["POST /rest/api/1.0/projects/jfrog/repos"]))
+	readmeBody := string(requestBodies["PUT /rest/api/1.0/projects/jfrog/repos/new-repo/browse/README.md"])
+	assert.Contains(t, readmeBody, "# new-repo\n\nA new repository\n")
+	assert.Contains(t, readmeBody, "name=\"branch\"\r\n\r\nmain\r\n")
+
+	err = createBadBitbucketServerClient(t).CreateRepository(ctx, owner, CreateRepositoryOptions{Name: "new-repo"})
+	assert.Error(t, err)
+}
+
+func TestBitbucketServer_ForkRepository(t *testing.T) {
+	ctx := context.Background()
+	expectedBody := []byte(`{"project":{"key":"FROGS"}}` + "\n")
+	client, cleanUp := createBodyHandlingServerAndClient(t, vcsutils.BitbucketServer, false, bitbucketv1.Repository{},
+		"/rest/api/1.0/projects/jfrog/repos/repo-1", http.StatusCreated, expectedBody, http.MethodPost,
+		createBitbucketServerWithBodyHandler)
+	defer cleanUp()
+
+	err := client.ForkRepository(ctx, owner, repo1, "FROGS")
+	assert.NoError(t, err)
+
+	err = createBadBitbucketServerClient(t).ForkRepository(ctx, owner, repo1, "FROGS")
+	assert.Error(t, err)
+}
+
+func TestBitbucketServer_ArchiveRepository(t *testing.T) {
+	ctx := context.Background()
+	expectedBody := []byte(`{"archived":true}` + "\n")
+	client, cleanUp := createBodyHandlingServerAndClient(t, vcsutils.BitbucketServer, false, bitbucketv1.Repository{},
+		"/rest/api/1.0/projects/jfrog/repos/repo-1", http.StatusOK, expectedBody, http.MethodPut,
+		createBitbucketServerWithBodyHandler)
+	defer cleanUp()
+
+	err := client.ArchiveRepository(ctx, owner, repo1)
+	assert.NoError(t, err)
+
+	err = createBadBitbucketServerClient(t).ArchiveRepository(ctx, owner, repo1)
+	assert.Error(t, err)
+}
+
+func TestBitbucketServer_DeleteRepository(t *testing.T) {
+	ctx := context.Background()
+	client, cleanUp := createServerAndClientReturningStatus(t, vcsutils.BitbucketServer, false, nil,
+		"/rest/api/1.0/projects/jfrog/repos/repo-1", http.StatusAccepted, createBitbucketServerHandler)
+	defer cleanUp()
+
+	err := client.DeleteRepository(ctx, owner, repo1)
+	assert.NoError(t, err)
+
+	err = createBadBitbucketServerClient(t).DeleteRepository(ctx, owner, repo1)
+	assert.Error(t, err)
+}
+
 func TestBitbucketServer_CreateLabel(t *testing.T) {
 	ctx := context.Background()
 	client, err := NewClientBuilder(vcsutils.BitbucketServer).Build()
 	assert.NoError(t, err)
 
 	err = client.CreateLabel(ctx, owner, repo1, LabelInfo{})
-	assert.ErrorIs(t, err, errLabelsNotSupported)
+	assert.ErrorIs(t, err, errBitbucketServerLabelsNotSupported)
 }
 
 func TestBitbucketServer_GetLabel(t *testing.T) {
@@ -428,7 +1100,22 @@ func TestBitbucketServer_GetLabel(t *testing.T) {
 	assert.NoError(t, err)
 
 	_, err = client.GetLabel(ctx, owner, repo1, labelName)
-	assert.ErrorIs(t, err, errLabelsNotSupported)
+	assert.ErrorIs(t, err, errBitbucketServerLabelsNotSupported)
+}
+
+func TestBitbucketServer_LabelsManagement(t *testing.T) {
+	ctx := context.Background()
+	client, err := NewClientBuilder(vcsutils.BitbucketServer).Build()
+	assert.NoError(t, err)
+
+	_, err = client.ListLabels(owner, repo1).All(ctx)
+	assert.ErrorIs(t, err, errBitbucketServerLabelsNotSupported)
+	err = client.UpdateLabel(ctx, owner, repo1, labelName, LabelInfo{})
+	assert.ErrorIs(t, err, errBitbucketServerLabelsNotSupported)
+	err = client.DeleteLabel(ctx, owner, repo1, labelName)
+	assert.ErrorIs(t, err, errBitbucketServerLabelsNotSupported)
+	err = client.AddPullRequestLabels(ctx, owner, repo1, 1, labelName)
+	assert.ErrorIs(t, err, errBitbucketServerLabelsNotSupported)
 }
 
 func TestBitbucketServer_ListPullRequestLabels(t *testing.T) {
@@ -436,8 +1123,8 @@ func TestBitbucketServer_ListPullRequestLabels(t *testing.T) {
 	client, err := NewClientBuilder(vcsutils.BitbucketServer).Build()
 	assert.NoError(t, err)
 
-	_, err = client.ListPullRequestLabels(ctx, owner, repo1, 1)
-	assert.ErrorIs(t, err, errLabelsNotSupported)
+	_, err = client.ListPullRequestLabels(owner, repo1, 1).All(ctx)
+	assert.ErrorIs(t, err, errBitbucketServerLabelsNotSupported)
 }
 
 func TestBitbucketServer_UnlabelPullRequest(t *testing.T) {
@@ -446,7 +1133,7 @@ func TestBitbucketServer_UnlabelPullRequest(t *testing.T) {
 	assert.NoError(t, err)
 
 	err = client.UnlabelPullRequest(ctx, owner, repo1, labelName, 1)
-	assert.ErrorIs(t, err, errLabelsNotSupported)
+	assert.ErrorIs(t, err, errBitbucketServerLabelsNotSupported)
 }
 
 func TestBitbucketServer_GetRepositoryEnvironmentInfo(t *testing.T) {
@@ -475,13 +1162,18 @@ func TestBitbucketServer_GetCommitBySha(t *testing.T) {
 	expectedUrl := fmt.Sprintf("%s/rest/api/1.0/projects/jfrog/repos/repo-1"+
 		"/commits/abcdef0123abcdef4567abcdef8987abcdef6543", serverUrl)
 	assert.Equal(t, CommitInfo{
-		Hash:          sha,
-		AuthorName:    "charlie",
-		CommitterName: "mark",
-		Url:           expectedUrl,
-		Timestamp:     1636089306104,
-		Message:       "WIP on feature 1",
-		ParentHashes:  []string{"bbcdef0123abcdef4567abcdef8987abcdef6543"},
+		Hash:           sha,
+		AuthorName:     "charlie",
+		AuthorEmail:    "charlie@example.com",
+		CommitterName:  "mark",
+		CommitterEmail: "mark@example.com",
+		Url:            expectedUrl,
+		WebURL:         fmt.Sprintf("%s/projects/jfrog/repos/repo-1/commits/abcdef0123abcdef4567abcdef8987abcdef6543", serverUrl),
+		Timestamp:      1636089306104,
+		AuthorDate:     time.UnixMilli(1636089306104).UTC(),
+		CommitDate:     time.UnixMilli(1636089306104).UTC(),
+		Message:        "WIP on feature 1",
+		ParentHashes:   []string{"bbcdef0123abcdef4567abcdef8987abcdef6543"},
 	}, result)
 
 	_, err = createBadBitbucketServerClient(t).GetCommitBySha(ctx, owner, repo1, sha)
@@ -599,6 +1291,24 @@ func TestBitbucketServerClient_GetModifiedFiles(t *testing.T) {
 	})
 }
 
+func TestBitbucketServerClient_GetFileChanges(t *testing.T) {
+	ctx := context.Background()
+	response, err := os.ReadFile(filepath.Join("testdata", "bitbucketserver", "compare_commits.json"))
+	assert.NoError(t, err)
+	client, closeServer := createBodyHandlingServerAndClient(t, vcsutils.BitbucketServer, false, response,
+		"/rest/api/1.0/projects/jfrog/repos/repo-1/compare/diff?contextLines=0&from=sha-1&to=sha-2",
+		http.StatusOK, nil, http.MethodGet, createBitbucketServerWithBodyHandler)
+	defer closeServer()
+
+	fileChanges, err := client.GetFileChanges(ctx, owner, repo1, "sha-1", "sha-2")
+	require.NoError(t, err)
+	assert.Equal(t, []FileChange{
+		{Path: "path/to/file.txt", Status: vcsutils.FileModified},
+		{Path: "path/to/other_file.txt", Status: vcsutils.FileModified},
+		{Path: "path/to/other_file2.txt", PreviousPath: "path/to/other_file.txt", Status: vcsutils.FileRenamed},
+	}, fileChanges)
+}
+
 func createBitbucketServerHandler(t *testing.T, expectedURI string, response []byte, expectedStatusCode int) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(expectedStatusCode)
@@ -609,6 +1319,21 @@ func createBitbucketServerHandler(t *testing.T, expectedURI string, response []b
 	}
 }
 
+// createBitbucketServerRepositoryInfoHandler serves the repository at the expected URI, and the default branch response with the given status
+func createBitbucketServerRepositoryInfoHandler(defaultBranchResponse string, defaultBranchStatus int) func(*testing.T, string, []byte, int) http.HandlerFunc {
+	return func(t *testing.T, expectedURI string, response []byte, expectedStatusCode int) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			if r.RequestURI == expectedURI+"/branches/default" {
+				w.WriteHeader(defaultBranchStatus)
+				_, err := w.Write([]byte(defaultBranchResponse))
+				assert.NoError(t, err)
+				return
+			}
+			createBitbucketServerHandler(t, expectedURI, response, expectedStatusCode)(w, r)
+		}
+	}
+}
+
 func createBitbucketServerListRepositoriesHandler(t *testing.T, _ string, _ []byte, expectedStatusCode int) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		var responseObj interface{}
@@ -671,3 +1396,9 @@ func createBadBitbucketServerClient(t *testing.T) VcsClient {
 	require.NoError(t, err)
 	return client
 }
+
+func TestGetBitbucketServerWebhookEvents(t *testing.T) {
+	assert.Equal(t, []string{"repo:refs_changed", "pr:comment:added", "pr:reviewer:needs_work", "pr:reviewer:approved"},
+		getBitbucketServerWebhookEvents(vcsutils.Push, vcsutils.TagPushed, vcsutils.BranchCreated, vcsutils.PrCommentAdded,
+			vcsutils.PrReviewSubmitted, vcsutils.PrApproved, vcsutils.ReleasePublished))
+}
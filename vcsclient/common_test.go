@@ -2,6 +2,7 @@ package vcsclient
 
 import (
 	"encoding/json"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -26,6 +27,8 @@ var (
 	branch2   = "branch-2"
 	labelName = "🚀 label-name"
 	envName   = "frogbot"
+	// A full commit SHA, which is not resolved as a branch name
+	commitHash = "f62ea5359e7af59880b4a5e23e0ce6c1b32b5d3c"
 )
 
 type createHandlerFunc func(t *testing.T, expectedUri string, response []byte, expectedStatusCode int) http.HandlerFunc
@@ -77,6 +80,35 @@ func createBodyHandlingServerAndClient(t *testing.T, vcsProvider vcsutils.VcsPro
 	return client, server.Close
 }
 
+// createRoutingServerAndClient creates a server which responds to every request with the response of its URI.
+// Use it to test client methods which send several requests.
+// The bodies of the received requests are recorded by their method and URI, for example "POST /repos/jfrog/repo-1/git/trees".
+func createRoutingServerAndClient(t *testing.T, vcsProvider vcsutils.VcsProvider, basicAuth bool, responses map[string]interface{}) (VcsClient, map[string][]byte, func()) {
+	requestBodies := make(map[string][]byte)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		response, exists := responses[r.RequestURI]
+		if !exists {
+			// The GitLab client sends a request to the API root when it is built
+			assert.Equal(t, "/api/v4/", r.RequestURI, "unexpected request")
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		requestBody, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		if len(requestBody) > 0 {
+			requestBodies[r.Method+" "+r.RequestURI] = requestBody
+		}
+		byteResponse, ok := response.([]byte)
+		if !ok {
+			byteResponse, err = json.Marshal(response)
+			assert.NoError(t, err)
+		}
+		_, err = w.Write(byteResponse)
+		assert.NoError(t, err)
+	}))
+	return buildClient(t, vcsProvider, basicAuth, server), requestBodies, server.Close
+}
+
 func buildClient(t *testing.T, vcsProvider vcsutils.VcsProvider, basicAuth bool, server *httptest.Server) VcsClient {
 	clientBuilder := NewClientBuilder(vcsProvider).ApiEndpoint(server.URL).Token(token)
 	if basicAuth {
@@ -111,3 +143,15 @@ func getNonBitbucketProviders() []vcsutils.VcsProvider {
 		vcsutils.GitHub, vcsutils.GitLab,
 	}
 }
+
+// collectRepositories reads all the streamed repositories, and returns them with the error which stopped the listing
+func collectRepositories(results <-chan RepositoryResult) ([]RepositoryListItem, error) {
+	var repositories []RepositoryListItem
+	for result := range results {
+		if result.Err != nil {
+			return repositories, result.Err
+		}
+		repositories = append(repositories, result.Repository)
+	}
+	return repositories, nil
+}
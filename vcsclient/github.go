@@ -3,14 +3,16 @@ package vcsclient
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"github.com/jfrog/gofrog/datastructures"
 	"io"
 	"net/http"
 	"net/url"
-	"sort"
+	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/google/go-github/v45/github"
 	"github.com/grokify/mogo/encoding/base64"
@@ -19,6 +21,14 @@ import (
 	"golang.org/x/oauth2"
 )
 
+const (
+	// The Git file mode of a non-executable file
+	regularFileMode      = "100644"
+	defaultGitHubAPIHost = "api.github.com"
+	// The maximal number of annotations in a check run request
+	gitHubAnnotationsBatchSize = 50
+)
+
 // GitHubClient API version 3
 type GitHubClient struct {
 	vcsInfo VcsInfo
@@ -52,10 +62,24 @@ func (client *GitHubClient) buildGithubClient(ctx context.Context) (*github.Clie
 			return nil, err
 		}
 		ghClient.BaseURL = baseURL
+		ghClient.UploadURL = getGitHubUploadURL(baseURL, ghClient.UploadURL)
 	}
 	return ghClient, nil
 }
 
+// getGitHubUploadURL returns the endpoint of the release assets uploads, which is separate from the API endpoint
+func getGitHubUploadURL(baseURL, defaultUploadURL *url.URL) *url.URL {
+	if baseURL.Host == defaultGitHubAPIHost {
+		return defaultUploadURL
+	}
+	uploadURL := *baseURL
+	// GitHub Enterprise Server
+	if strings.HasSuffix(baseURL.Path, "/api/v3/") {
+		uploadURL.Path = strings.TrimSuffix(baseURL.Path, "v3/") + "uploads/"
+	}
+	return &uploadURL
+}
+
 // AddSshKeyToRepository on GitHub
 func (client *GitHubClient) AddSshKeyToRepository(ctx context.Context, owner, repository, keyName, publicKey string, permission Permission) error {
 	err := validateParametersNotBlank(map[string]string{
@@ -87,43 +111,431 @@ func (client *GitHubClient) AddSshKeyToRepository(ctx context.Context, owner, re
 
 // ListRepositories on GitHub
 func (client *GitHubClient) ListRepositories(ctx context.Context) (map[string][]string, error) {
-	ghClient, err := client.buildGithubClient(ctx)
+	repositories, err := client.listRepositories(time.Time{}, listAuthenticatedUserRepositories(&github.RepositoryListOptions{})).All(ctx)
 	if err != nil {
 		return nil, err
 	}
 	results := make(map[string][]string)
-	for nextPage := 1; ; nextPage++ {
-		options := &github.RepositoryListOptions{ListOptions: github.ListOptions{Page: nextPage}}
-		repos, response, err := ghClient.Repositories.List(ctx, "", options)
+	for _, repository := range repositories {
+		results[repository.Owner] = append(results[repository.Owner], repository.Name)
+	}
+	return results, nil
+}
+
+// StreamRepositories on GitHub
+func (client *GitHubClient) StreamRepositories(ctx context.Context, options ListRepositoriesOptions) <-chan RepositoryResult {
+	if options.Owner != "" {
+		return streamRepositories(ctx, options, client.listRepositories(options.UpdatedSince, listOwnerRepositories(options.Owner, options.Visibility)))
+	}
+	// The recently pushed repositories are listed first, so the listing stops at the first repository not pushed since UpdatedSince
+	listOptions := &github.RepositoryListOptions{
+		Visibility:  getGitHubListVisibility(options.Visibility),
+		Sort:        "pushed",
+		Direction:   "desc",
+		ListOptions: github.ListOptions{PerPage: repositoriesPageSize},
+	}
+	return streamRepositories(ctx, options, client.listRepositories(options.UpdatedSince, listAuthenticatedUserRepositories(listOptions)))
+}
+
+// gitHubRepositoriesLister lists a page of repositories
+type gitHubRepositoriesLister func(ctx context.Context, ghClient *github.Client, page int) ([]*github.Repository, *github.Response, error)
+
+// listAuthenticatedUserRepositories lists the repositories which the authenticated user has access to
+func listAuthenticatedUserRepositories(listOptions *github.RepositoryListOptions) gitHubRepositoriesLister {
+	return func(ctx context.Context, ghClient *github.Client, page int) ([]*github.Repository, *github.Response, error) {
+		listOptions.Page = page
+		return ghClient.Repositories.List(ctx, "", listOptions)
+	}
+}
+
+// listOwnerRepositories lists the repositories of an organization or a user, the recently pushed first.
+// GitHub lists only the public repositories of users other than the authenticated user.
+func listOwnerRepositories(owner string, visibility *RepositoryVisibility) gitHubRepositoriesLister {
+	var lister gitHubRepositoriesLister
+	return func(ctx context.Context, ghClient *github.Client, page int) ([]*github.Repository, *github.Response, error) {
+		if lister == nil {
+			var err error
+			if lister, err = getOwnerRepositoriesLister(ctx, ghClient, owner, visibility); err != nil {
+				return nil, nil, err
+			}
+		}
+		return lister(ctx, ghClient, page)
+	}
+}
+
+func getOwnerRepositoriesLister(ctx context.Context, ghClient *github.Client, owner string, visibility *RepositoryVisibility) (gitHubRepositoriesLister, error) {
+	ownerUser, _, err := ghClient.Users.Get(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+	listOptions := github.ListOptions{PerPage: repositoriesPageSize}
+	if ownerUser.GetType() == "Organization" {
+		orgListOptions := &github.RepositoryListByOrgOptions{Type: "all", Sort: "pushed", Direction: "desc", ListOptions: listOptions}
+		if visibility != nil {
+			orgListOptions.Type = getGitHubVisibilityName(*visibility)
+		}
+		return func(ctx context.Context, ghClient *github.Client, page int) ([]*github.Repository, *github.Response, error) {
+			orgListOptions.Page = page
+			return ghClient.Repositories.ListByOrg(ctx, owner, orgListOptions)
+		}, nil
+	}
+	authenticatedUser, _, err := ghClient.Users.Get(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	if strings.EqualFold(authenticatedUser.GetLogin(), owner) {
+		return listAuthenticatedUserRepositories(&github.RepositoryListOptions{
+			Visibility:  getGitHubListVisibility(visibility),
+			Affiliation: "owner",
+			Sort:        "pushed",
+			Direction:   "desc",
+			ListOptions: listOptions,
+		}), nil
+	}
+	userListOptions := &github.RepositoryListOptions{Type: "owner", Sort: "pushed", Direction: "desc", ListOptions: listOptions}
+	return func(ctx context.Context, ghClient *github.Client, page int) ([]*github.Repository, *github.Response, error) {
+		userListOptions.Page = page
+		return ghClient.Repositories.List(ctx, owner, userListOptions)
+	}, nil
+}
+
+// listRepositories lists the repositories by the lister. If pushedSince isn't zero, the repositories must be sorted by the push time,
+// and the listing stops at the first repository which wasn't pushed since then.
+func (client *GitHubClient) listRepositories(pushedSince time.Time, list gitHubRepositoriesLister) *Pager[RepositoryListItem] {
+	return newNumberedPager(1, func(ctx context.Context, page int) ([]RepositoryListItem, int, error) {
+		ghClient, err := client.buildGithubClient(ctx)
 		if err != nil {
-			return nil, err
+			return nil, 0, err
+		}
+		repos, response, err := list(ctx, ghClient, page)
+		if err != nil {
+			return nil, 0, err
 		}
+		results := make([]RepositoryListItem, 0, len(repos))
 		for _, repo := range repos {
-			results[*repo.Owner.Login] = append(results[*repo.Owner.Login], *repo.Name)
+			if !pushedSince.IsZero() && repo.GetPushedAt().Before(pushedSince) {
+				return results, 0, nil
+			}
+			results = append(results, RepositoryListItem{Owner: repo.GetOwner().GetLogin(), Name: repo.GetName(), RepositoryInfo: mapGitHubRepositoryToRepositoryInfo(repo)})
 		}
-		if nextPage+1 > response.LastPage {
-			break
+		return results, response.NextPage, nil
+	})
+}
+
+// ListBranches on GitHub
+func (client *GitHubClient) ListBranches(owner, repository string) *Pager[string] {
+	return newNumberedPager(1, func(ctx context.Context, page int) ([]string, int, error) {
+		ghClient, err := client.buildGithubClient(ctx)
+		if err != nil {
+			return nil, 0, err
+		}
+		options := &github.BranchListOptions{ListOptions: github.ListOptions{Page: page}}
+		branches, response, err := ghClient.Repositories.ListBranches(ctx, owner, repository, options)
+		if err != nil {
+			return nil, 0, err
 		}
+		results := make([]string, 0, len(branches))
+		for _, branch := range branches {
+			results = append(results, branch.GetName())
+		}
+		return results, response.NextPage, nil
+	})
+}
+
+// CreateBranch on GitHub
+func (client *GitHubClient) CreateBranch(ctx context.Context, owner, repository, branch, fromRef string) error {
+	err := validateParametersNotBlank(map[string]string{
+		"owner":      owner,
+		"repository": repository,
+		"branch":     branch,
+		"fromRef":    fromRef,
+	})
+	if err != nil {
+		return err
 	}
-	return results, nil
+	ghClient, err := client.buildGithubClient(ctx)
+	if err != nil {
+		return err
+	}
+	sha, err := getGitHubCommitSHA(ctx, ghClient, owner, repository, fromRef)
+	if err != nil {
+		return err
+	}
+	_, _, err = ghClient.Git.CreateRef(ctx, owner, repository, &github.Reference{
+		Ref:    vcsutils.PointerOf(branchRefPrefix + branch),
+		Object: &github.GitObject{SHA: &sha},
+	})
+	return err
 }
 
-// ListBranches on GitHub
-func (client *GitHubClient) ListBranches(ctx context.Context, owner, repository string) ([]string, error) {
+// getGitHubCommitSHA returns the commit SHA of a branch. A Git reference can be created only from a commit SHA.
+func getGitHubCommitSHA(ctx context.Context, ghClient *github.Client, owner, repository, ref string) (string, error) {
+	if isCommitSHA(ref) {
+		return ref, nil
+	}
+	sha, _, err := ghClient.Repositories.GetCommitSHA1(ctx, owner, repository, ref, "")
+	return sha, err
+}
+
+// DeleteBranch on GitHub
+func (client *GitHubClient) DeleteBranch(ctx context.Context, owner, repository, branch string) error {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository, "branch": branch})
+	if err != nil {
+		return err
+	}
 	ghClient, err := client.buildGithubClient(ctx)
 	if err != nil {
-		return nil, err
+		return err
+	}
+	_, err = ghClient.Git.DeleteRef(ctx, owner, repository, branchRefPrefix+branch)
+	return err
+}
+
+// GetBranch on GitHub
+func (client *GitHubClient) GetBranch(ctx context.Context, owner, repository, branch string) (BranchDetails, error) {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository, "branch": branch})
+	if err != nil {
+		return BranchDetails{}, err
+	}
+	ghClient, err := client.buildGithubClient(ctx)
+	if err != nil {
+		return BranchDetails{}, err
 	}
-	branches, _, err := ghClient.Repositories.ListBranches(ctx, owner, repository, nil)
+	ghBranch, _, err := ghClient.Repositories.GetBranch(ctx, owner, repository, branch, true)
 	if err != nil {
-		return []string{}, err
+		return BranchDetails{}, err
 	}
+	// The branch response doesn't tell whether it is the default branch
+	repo, _, err := ghClient.Repositories.Get(ctx, owner, repository)
+	if err != nil {
+		return BranchDetails{}, err
+	}
+	return BranchDetails{
+		Name:      ghBranch.GetName(),
+		CommitSHA: ghBranch.GetCommit().GetSHA(),
+		Protected: ghBranch.GetProtected(),
+		Default:   ghBranch.GetName() == repo.GetDefaultBranch(),
+	}, nil
+}
 
-	results := make([]string, 0, len(branches))
-	for _, repo := range branches {
-		results = append(results, *repo.Name)
+// CommitFiles on GitHub.
+// The commit is built with the Git database API, by creating the blobs, the tree and the commit, and then moving the branch to the new commit.
+func (client *GitHubClient) CommitFiles(ctx context.Context, owner, repository, branch, message string, changes []CommitFileChange, author CommitAuthor) error {
+	err := validateParametersNotBlank(map[string]string{
+		"owner":      owner,
+		"repository": repository,
+		"branch":     branch,
+		"message":    message,
+	})
+	if err != nil {
+		return err
 	}
-	return results, nil
+	if err = validateCommitFileChanges(changes); err != nil {
+		return err
+	}
+	ghClient, err := client.buildGithubClient(ctx)
+	if err != nil {
+		return err
+	}
+	ref, _, err := ghClient.Git.GetRef(ctx, owner, repository, branchRefPrefix+branch)
+	if err != nil {
+		return err
+	}
+	parent, _, err := ghClient.Git.GetCommit(ctx, owner, repository, ref.GetObject().GetSHA())
+	if err != nil {
+		return err
+	}
+	entries, err := createGitHubTreeEntries(ctx, ghClient, owner, repository, changes)
+	if err != nil {
+		return err
+	}
+	tree, _, err := ghClient.Git.CreateTree(ctx, owner, repository, parent.GetTree().GetSHA(), entries)
+	if err != nil {
+		return err
+	}
+	commit := &github.Commit{Message: &message, Tree: tree, Parents: []*github.Commit{parent}}
+	if author != (CommitAuthor{}) {
+		commit.Author = &github.CommitAuthor{Name: &author.Name, Email: &author.Email}
+	}
+	newCommit, _, err := ghClient.Git.CreateCommit(ctx, owner, repository, commit)
+	if err != nil {
+		return err
+	}
+	ref.Object.SHA = newCommit.SHA
+	_, _, err = ghClient.Git.UpdateRef(ctx, owner, repository, ref, false)
+	return err
+}
+
+func createGitHubTreeEntries(ctx context.Context, ghClient *github.Client, owner, repository string, changes []CommitFileChange) ([]*github.TreeEntry, error) {
+	var entries []*github.TreeEntry
+	for _, change := range changes {
+		if change.Action == DeleteFile || change.Action == MoveFile {
+			removedPath := change.Path
+			if change.Action == MoveFile {
+				removedPath = change.PreviousPath
+			}
+			// An entry without a SHA and a content removes the file from the tree
+			entries = append(entries, &github.TreeEntry{Path: &removedPath, Mode: vcsutils.PointerOf(regularFileMode), Type: vcsutils.PointerOf("blob")})
+			if change.Action == DeleteFile {
+				continue
+			}
+		}
+		// The tree entry content can't contain binary data, so the content is uploaded as a base64 encoded blob
+		blob, _, err := ghClient.Git.CreateBlob(ctx, owner, repository, &github.Blob{
+			Content:  vcsutils.PointerOf(encodeFileContent(change.Content)),
+			Encoding: vcsutils.PointerOf("base64"),
+		})
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, &github.TreeEntry{
+			Path: vcsutils.PointerOf(change.Path),
+			Mode: vcsutils.PointerOf(regularFileMode),
+			Type: vcsutils.PointerOf("blob"),
+			SHA:  blob.SHA,
+		})
+	}
+	return entries, nil
+}
+
+// ListTags on GitHub
+func (client *GitHubClient) ListTags(owner, repository string) *Pager[TagInfo] {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository})
+	if err != nil {
+		return newErrorPager[TagInfo](err)
+	}
+	return newNumberedPager(1, func(ctx context.Context, page int) ([]TagInfo, int, error) {
+		ghClient, err := client.buildGithubClient(ctx)
+		if err != nil {
+			return nil, 0, err
+		}
+		tags, response, err := ghClient.Repositories.ListTags(ctx, owner, repository, &github.ListOptions{Page: page})
+		if err != nil {
+			return nil, 0, err
+		}
+		results := make([]TagInfo, 0, len(tags))
+		for _, tag := range tags {
+			results = append(results, TagInfo{Name: tag.GetName(), CommitSHA: tag.GetCommit().GetSHA()})
+		}
+		return results, response.NextPage, nil
+	})
+}
+
+// CreateTag on GitHub
+func (client *GitHubClient) CreateTag(ctx context.Context, owner, repository, tag, fromRef, message string) error {
+	err := validateParametersNotBlank(map[string]string{
+		"owner":      owner,
+		"repository": repository,
+		"tag":        tag,
+		"fromRef":    fromRef,
+	})
+	if err != nil {
+		return err
+	}
+	ghClient, err := client.buildGithubClient(ctx)
+	if err != nil {
+		return err
+	}
+	sha, err := getGitHubCommitSHA(ctx, ghClient, owner, repository, fromRef)
+	if err != nil {
+		return err
+	}
+	// The reference of an annotated tag points to a tag object, which contains the message
+	if message != "" {
+		tagObject, _, err := ghClient.Git.CreateTag(ctx, owner, repository, &github.Tag{
+			Tag:     &tag,
+			Message: &message,
+			Object:  &github.GitObject{Type: vcsutils.PointerOf("commit"), SHA: &sha},
+		})
+		if err != nil {
+			return err
+		}
+		sha = tagObject.GetSHA()
+	}
+	_, _, err = ghClient.Git.CreateRef(ctx, owner, repository, &github.Reference{
+		Ref:    vcsutils.PointerOf(tagRefPrefix + tag),
+		Object: &github.GitObject{SHA: &sha},
+	})
+	return err
+}
+
+// DeleteTag on GitHub
+func (client *GitHubClient) DeleteTag(ctx context.Context, owner, repository, tag string) error {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository, "tag": tag})
+	if err != nil {
+		return err
+	}
+	ghClient, err := client.buildGithubClient(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = ghClient.Git.DeleteRef(ctx, owner, repository, tagRefPrefix+tag)
+	return err
+}
+
+// CreateRelease on GitHub
+func (client *GitHubClient) CreateRelease(ctx context.Context, owner, repository string, release CreateReleaseOptions) (ReleaseInfo, error) {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository, "tag name": release.TagName})
+	if err != nil {
+		return ReleaseInfo{}, err
+	}
+	ghClient, err := client.buildGithubClient(ctx)
+	if err != nil {
+		return ReleaseInfo{}, err
+	}
+	ghRelease := &github.RepositoryRelease{TagName: &release.TagName, Name: &release.Name, Body: &release.Notes}
+	if release.TargetRef != "" {
+		ghRelease.TargetCommitish = &release.TargetRef
+	}
+	ghRelease, _, err = ghClient.Repositories.CreateRelease(ctx, owner, repository, ghRelease)
+	if err != nil {
+		return ReleaseInfo{}, err
+	}
+	for _, assetPath := range release.AssetPaths {
+		asset, err := uploadGitHubReleaseAsset(ctx, ghClient, owner, repository, ghRelease.GetID(), assetPath)
+		if err != nil {
+			// The release is deleted, so that it can be created again with all its assets
+			if _, deleteErr := ghClient.Repositories.DeleteRelease(ctx, owner, repository, ghRelease.GetID()); deleteErr != nil {
+				return ReleaseInfo{}, fmt.Errorf("%w, and the created release couldn't be deleted: %s", err, deleteErr.Error())
+			}
+			return ReleaseInfo{}, err
+		}
+		ghRelease.Assets = append(ghRelease.Assets, asset)
+	}
+	return mapGitHubReleaseToReleaseInfo(ghRelease), nil
+}
+
+func uploadGitHubReleaseAsset(ctx context.Context, ghClient *github.Client, owner, repository string, releaseID int64, assetPath string) (*github.ReleaseAsset, error) {
+	file, err := os.Open(assetPath)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = file.Close() }()
+	asset, _, err := ghClient.Repositories.UploadReleaseAsset(ctx, owner, repository, releaseID, &github.UploadOptions{Name: filepath.Base(assetPath)}, file)
+	return asset, err
+}
+
+// ListReleases on GitHub
+func (client *GitHubClient) ListReleases(owner, repository string) *Pager[ReleaseInfo] {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository})
+	if err != nil {
+		return newErrorPager[ReleaseInfo](err)
+	}
+	return newNumberedPager(1, func(ctx context.Context, page int) ([]ReleaseInfo, int, error) {
+		ghClient, err := client.buildGithubClient(ctx)
+		if err != nil {
+			return nil, 0, err
+		}
+		releases, response, err := ghClient.Repositories.ListReleases(ctx, owner, repository, &github.ListOptions{Page: page})
+		if err != nil {
+			return nil, 0, err
+		}
+		results := make([]ReleaseInfo, 0, len(releases))
+		for _, release := range releases {
+			results = append(results, mapGitHubReleaseToReleaseInfo(release))
+		}
+		return results, response.NextPage, nil
+	})
 }
 
 // CreateWebhook on GitHub
@@ -172,24 +584,315 @@ func (client *GitHubClient) DeleteWebhook(ctx context.Context, owner, repository
 	return err
 }
 
-// SetCommitStatus on GitHub
-func (client *GitHubClient) SetCommitStatus(ctx context.Context, commitStatus CommitStatus, owner, repository, ref,
-	title, description, detailsURL string) error {
+// ListWebhooks on GitHub
+func (client *GitHubClient) ListWebhooks(owner, repository string) *Pager[WebhookInfo] {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository})
+	if err != nil {
+		return newErrorPager[WebhookInfo](err)
+	}
+	return newNumberedPager(1, func(ctx context.Context, page int) ([]WebhookInfo, int, error) {
+		ghClient, err := client.buildGithubClient(ctx)
+		if err != nil {
+			return nil, 0, err
+		}
+		hooks, response, err := ghClient.Repositories.ListHooks(ctx, owner, repository, &github.ListOptions{Page: page})
+		if err != nil {
+			return nil, 0, err
+		}
+		results := make([]WebhookInfo, 0, len(hooks))
+		for _, hook := range hooks {
+			results = append(results, mapGitHubHookToWebhookInfo(hook))
+		}
+		return results, response.NextPage, nil
+	})
+}
+
+// GetWebhook on GitHub
+func (client *GitHubClient) GetWebhook(ctx context.Context, owner, repository, webhookID string) (WebhookInfo, error) {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository, "webhookID": webhookID})
+	if err != nil {
+		return WebhookInfo{}, err
+	}
 	ghClient, err := client.buildGithubClient(ctx)
+	if err != nil {
+		return WebhookInfo{}, err
+	}
+	webhookIDInt64, err := strconv.ParseInt(webhookID, 10, 64)
+	if err != nil {
+		return WebhookInfo{}, err
+	}
+	hook, _, err := ghClient.Repositories.GetHook(ctx, owner, repository, webhookIDInt64)
+	if err != nil {
+		return WebhookInfo{}, err
+	}
+	return mapGitHubHookToWebhookInfo(hook), nil
+}
+
+// PingWebhook on GitHub. GitHub sends a ping event to the payload URL.
+func (client *GitHubClient) PingWebhook(ctx context.Context, owner, repository, webhookID string) error {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository, "webhookID": webhookID})
 	if err != nil {
 		return err
 	}
-	state := getGitHubCommitState(commitStatus)
-	status := &github.RepoStatus{
-		Context:     &title,
-		TargetURL:   &detailsURL,
-		State:       &state,
-		Description: &description,
+	ghClient, err := client.buildGithubClient(ctx)
+	if err != nil {
+		return err
 	}
-	_, _, err = ghClient.Repositories.CreateStatus(ctx, owner, repository, ref, status)
+	webhookIDInt64, err := strconv.ParseInt(webhookID, 10, 64)
+	if err != nil {
+		return err
+	}
+	_, err = ghClient.Repositories.PingHook(ctx, owner, repository, webhookIDInt64)
 	return err
 }
 
+// CreateOrganizationWebhook on GitHub
+func (client *GitHubClient) CreateOrganizationWebhook(ctx context.Context, owner, payloadURL string,
+	webhookEvents ...vcsutils.WebhookEvent) (string, string, error) {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "payloadURL": payloadURL})
+	if err != nil {
+		return "", "", err
+	}
+	ghClient, err := client.buildGithubClient(ctx)
+	if err != nil {
+		return "", "", err
+	}
+	token := vcsutils.CreateToken()
+	hook := createGitHubHook(token, payloadURL, webhookEvents...)
+	responseHook, _, err := ghClient.Organizations.CreateHook(ctx, owner, hook)
+	if err != nil {
+		return "", "", err
+	}
+	return strconv.FormatInt(responseHook.GetID(), 10), token, nil
+}
+
+// UpdateOrganizationWebhook on GitHub
+func (client *GitHubClient) UpdateOrganizationWebhook(ctx context.Context, owner, payloadURL, token, webhookID string,
+	webhookEvents ...vcsutils.WebhookEvent) error {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "payloadURL": payloadURL, "webhookID": webhookID})
+	if err != nil {
+		return err
+	}
+	ghClient, err := client.buildGithubClient(ctx)
+	if err != nil {
+		return err
+	}
+	webhookIDInt64, err := strconv.ParseInt(webhookID, 10, 64)
+	if err != nil {
+		return err
+	}
+	hook := createGitHubHook(token, payloadURL, webhookEvents...)
+	_, _, err = ghClient.Organizations.EditHook(ctx, owner, webhookIDInt64, hook)
+	return err
+}
+
+// DeleteOrganizationWebhook on GitHub
+func (client *GitHubClient) DeleteOrganizationWebhook(ctx context.Context, owner, webhookID string) error {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "webhookID": webhookID})
+	if err != nil {
+		return err
+	}
+	ghClient, err := client.buildGithubClient(ctx)
+	if err != nil {
+		return err
+	}
+	webhookIDInt64, err := strconv.ParseInt(webhookID, 10, 64)
+	if err != nil {
+		return err
+	}
+	_, err = ghClient.Organizations.DeleteHook(ctx, owner, webhookIDInt64)
+	return err
+}
+
+// ListOrganizationWebhooks on GitHub
+func (client *GitHubClient) ListOrganizationWebhooks(owner string) *Pager[WebhookInfo] {
+	err := validateParametersNotBlank(map[string]string{"owner": owner})
+	if err != nil {
+		return newErrorPager[WebhookInfo](err)
+	}
+	return newNumberedPager(1, func(ctx context.Context, page int) ([]WebhookInfo, int, error) {
+		ghClient, err := client.buildGithubClient(ctx)
+		if err != nil {
+			return nil, 0, err
+		}
+		hooks, response, err := ghClient.Organizations.ListHooks(ctx, owner, &github.ListOptions{Page: page})
+		if err != nil {
+			return nil, 0, err
+		}
+		results := make([]WebhookInfo, 0, len(hooks))
+		for _, hook := range hooks {
+			results = append(results, mapGitHubHookToWebhookInfo(hook))
+		}
+		return results, response.NextPage, nil
+	})
+}
+
+// SetCommitStatus on GitHub. The key is the status context, and the build number isn't sent.
+func (client *GitHubClient) SetCommitStatus(ctx context.Context, owner, repository, ref string, status CommitStatusOptions) error {
+	ghClient, err := client.buildGithubClient(ctx)
+	if err != nil {
+		return err
+	}
+	repoStatus := &github.RepoStatus{
+		Context:     &status.Key,
+		TargetURL:   &status.DetailsURL,
+		State:       vcsutils.PointerOf(getGitHubCommitState(status.State)),
+		Description: &status.Description,
+	}
+	_, _, err = ghClient.Repositories.CreateStatus(ctx, owner, repository, ref, repoStatus)
+	return err
+}
+
+// ListCommitStatuses on GitHub
+func (client *GitHubClient) ListCommitStatuses(owner, repository, ref string) *Pager[CommitStatusInfo] {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository, "ref": ref})
+	if err != nil {
+		return newErrorPager[CommitStatusInfo](err)
+	}
+	return newNumberedPager(1, func(ctx context.Context, page int) ([]CommitStatusInfo, int, error) {
+		ghClient, err := client.buildGithubClient(ctx)
+		if err != nil {
+			return nil, 0, err
+		}
+		combinedStatus, response, err := ghClient.Repositories.GetCombinedStatus(ctx, owner, repository, ref, &github.ListOptions{Page: page})
+		if err != nil {
+			return nil, 0, err
+		}
+		results := make([]CommitStatusInfo, 0, len(combinedStatus.Statuses))
+		for _, status := range combinedStatus.Statuses {
+			results = append(results, CommitStatusInfo{
+				State:         mapGitHubCommitState(status.GetState()),
+				Context:       status.GetContext(),
+				Description:   status.GetDescription(),
+				DetailsURL:    status.GetTargetURL(),
+				CreatedAt:     status.GetCreatedAt(),
+				LastUpdatedAt: status.GetUpdatedAt(),
+			})
+		}
+		return results, response.NextPage, nil
+	})
+}
+
+// GetCombinedCommitStatus on GitHub
+func (client *GitHubClient) GetCombinedCommitStatus(ctx context.Context, owner, repository, ref string) (CombinedCommitStatus, error) {
+	statuses, err := client.ListCommitStatuses(owner, repository, ref).All(ctx)
+	if err != nil {
+		return CombinedCommitStatus{}, err
+	}
+	checkRuns, err := client.listCheckRuns(owner, repository, ref).All(ctx)
+	if err != nil {
+		return CombinedCommitStatus{}, err
+	}
+	return newCombinedCommitStatus(append(statuses, checkRuns...)), nil
+}
+
+// CreateCheckRun on GitHub. GitHub accepts up to 50 annotations in a request, and therefore the rest of the
+// annotations are added by updating the check run.
+func (client *GitHubClient) CreateCheckRun(ctx context.Context, owner, repository string, checkRun CheckRunOptions) (string, error) {
+	err := validateParametersNotBlank(map[string]string{
+		"owner":              owner,
+		"repository":         repository,
+		"checkRun.Name":      checkRun.Name,
+		"checkRun.CommitSHA": checkRun.CommitSHA,
+	})
+	if err != nil {
+		return "", err
+	}
+	ghClient, err := client.buildGithubClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	batches := splitToBatches(checkRun.Annotations, gitHubAnnotationsBatchSize)
+	status, conclusion, completedAt := getGitHubCheckRunState(checkRun.State)
+	created, _, err := ghClient.Checks.CreateCheckRun(ctx, owner, repository, github.CreateCheckRunOptions{
+		Name:        checkRun.Name,
+		HeadSHA:     checkRun.CommitSHA,
+		DetailsURL:  vcsutils.PointerOf(checkRun.DetailsURL),
+		Status:      status,
+		Conclusion:  conclusion,
+		CompletedAt: completedAt,
+		Output:      createGitHubCheckRunOutput(checkRun, batches[0]),
+	})
+	if err != nil {
+		return "", err
+	}
+	checkRunID := created.GetID()
+	if err = client.addCheckRunAnnotations(ctx, ghClient, owner, repository, checkRunID, checkRun, batches[1:]); err != nil {
+		return "", err
+	}
+	return strconv.FormatInt(checkRunID, 10), nil
+}
+
+// UpdateCheckRun on GitHub
+func (client *GitHubClient) UpdateCheckRun(ctx context.Context, owner, repository, checkRunID string, checkRun CheckRunOptions) error {
+	err := validateParametersNotBlank(map[string]string{
+		"owner":         owner,
+		"repository":    repository,
+		"checkRunID":    checkRunID,
+		"checkRun.Name": checkRun.Name,
+	})
+	if err != nil {
+		return err
+	}
+	checkRunIDInt, err := strconv.ParseInt(checkRunID, 10, 64)
+	if err != nil {
+		return err
+	}
+	ghClient, err := client.buildGithubClient(ctx)
+	if err != nil {
+		return err
+	}
+	batches := splitToBatches(checkRun.Annotations, gitHubAnnotationsBatchSize)
+	status, conclusion, completedAt := getGitHubCheckRunState(checkRun.State)
+	_, _, err = ghClient.Checks.UpdateCheckRun(ctx, owner, repository, checkRunIDInt, github.UpdateCheckRunOptions{
+		Name:        checkRun.Name,
+		DetailsURL:  vcsutils.PointerOf(checkRun.DetailsURL),
+		Status:      status,
+		Conclusion:  conclusion,
+		CompletedAt: completedAt,
+		Output:      createGitHubCheckRunOutput(checkRun, batches[0]),
+	})
+	if err != nil {
+		return err
+	}
+	return client.addCheckRunAnnotations(ctx, ghClient, owner, repository, checkRunIDInt, checkRun, batches[1:])
+}
+
+// addCheckRunAnnotations adds each batch of annotations to the check run in a separate request
+func (client *GitHubClient) addCheckRunAnnotations(ctx context.Context, ghClient *github.Client, owner, repository string,
+	checkRunID int64, checkRun CheckRunOptions, batches [][]CheckRunAnnotation) error {
+	for _, batch := range batches {
+		_, _, err := ghClient.Checks.UpdateCheckRun(ctx, owner, repository, checkRunID, github.UpdateCheckRunOptions{
+			Name:   checkRun.Name,
+			Output: createGitHubCheckRunOutput(checkRun, batch),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// listCheckRuns lists the latest check runs of the ref as commit statuses
+func (client *GitHubClient) listCheckRuns(owner, repository, ref string) *Pager[CommitStatusInfo] {
+	return newNumberedPager(1, func(ctx context.Context, page int) ([]CommitStatusInfo, int, error) {
+		ghClient, err := client.buildGithubClient(ctx)
+		if err != nil {
+			return nil, 0, err
+		}
+		checkRuns, response, err := ghClient.Checks.ListCheckRunsForRef(ctx, owner, repository, ref,
+			&github.ListCheckRunsOptions{ListOptions: github.ListOptions{Page: page}})
+		if err != nil {
+			return nil, 0, err
+		}
+		results := make([]CommitStatusInfo, 0, len(checkRuns.CheckRuns))
+		for _, checkRun := range checkRuns.CheckRuns {
+			results = append(results, mapGitHubCheckRunToCommitStatusInfo(checkRun))
+		}
+		return results, response.NextPage, nil
+	})
+}
+
 // DownloadRepository on GitHub
 func (client *GitHubClient) DownloadRepository(ctx context.Context, owner, repository, branch, localPath string) error {
 	ghClient, err := client.buildGithubClient(ctx)
@@ -244,54 +947,175 @@ func (client *GitHubClient) CreatePullRequest(ctx context.Context, owner, reposi
 	return err
 }
 
-// ListOpenPullRequests on GitHub
-func (client *GitHubClient) ListOpenPullRequests(ctx context.Context, owner, repository string) ([]PullRequestInfo, error) {
-	ghClient, err := client.buildGithubClient(ctx)
+// ListOpenPullRequests on GitHub
+func (client *GitHubClient) ListOpenPullRequests(owner, repository string) *Pager[PullRequestInfo] {
+	return newNumberedPager(1, func(ctx context.Context, page int) ([]PullRequestInfo, int, error) {
+		ghClient, err := client.buildGithubClient(ctx)
+		if err != nil {
+			return nil, 0, err
+		}
+		client.logger.Debug("fetching open pull requests in", repository)
+		options := &github.PullRequestListOptions{State: "open", ListOptions: github.ListOptions{Page: page}}
+		pullRequests, response, err := ghClient.PullRequests.List(ctx, owner, repository, options)
+		if err != nil {
+			return nil, 0, err
+		}
+		results, err := mapGitHubPullRequestToPullRequestInfoList(pullRequests)
+		return results, response.NextPage, err
+	})
+}
+
+// AddPullRequestComment on GitHub
+func (client *GitHubClient) AddPullRequestComment(ctx context.Context, owner, repository, content string, pullRequestID int) error {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository, "content": content})
+	if err != nil {
+		return err
+	}
+	ghClient, err := client.buildGithubClient(ctx)
+	if err != nil {
+		return err
+	}
+	// We use the Issues API to add a regular comment. The PullRequests API adds a code review comment.
+	_, _, err = ghClient.Issues.CreateComment(ctx, owner, repository, pullRequestID, &github.IssueComment{
+		Body: &content,
+	})
+	return err
+}
+
+// ListPullRequestComments on GitHub
+func (client *GitHubClient) ListPullRequestComments(owner, repository string, pullRequestID int) *Pager[CommentInfo] {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository})
+	if err != nil {
+		return newErrorPager[CommentInfo](err)
+	}
+	return newNumberedPager(1, func(ctx context.Context, page int) ([]CommentInfo, int, error) {
+		ghClient, err := client.buildGithubClient(ctx)
+		if err != nil {
+			return nil, 0, err
+		}
+		options := &github.IssueListCommentsOptions{ListOptions: github.ListOptions{Page: page}}
+		commentsList, response, err := ghClient.Issues.ListComments(ctx, owner, repository, pullRequestID, options)
+		if err != nil {
+			return nil, 0, err
+		}
+		results, err := mapGitHubCommentToCommentInfoList(commentsList)
+		return results, response.NextPage, err
+	})
+}
+
+// EditPullRequestComment on GitHub
+func (client *GitHubClient) EditPullRequestComment(ctx context.Context, owner, repository string, _ int, commentID int64, content string) error {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository, "content": content})
+	if err != nil {
+		return err
+	}
+	ghClient, err := client.buildGithubClient(ctx)
+	if err != nil {
+		return err
+	}
+	_, _, err = ghClient.Issues.EditComment(ctx, owner, repository, commentID, &github.IssueComment{
+		Body: &content,
+	})
+	return err
+}
+
+// DeletePullRequestComment on GitHub
+func (client *GitHubClient) DeletePullRequestComment(ctx context.Context, owner, repository string, _ int, commentID int64) error {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository})
 	if err != nil {
-		return nil, err
+		return err
 	}
-	client.logger.Debug("fetching open pull requests in", repository)
-	pullRequests, _, err := ghClient.PullRequests.List(ctx, owner, repository, &github.PullRequestListOptions{
-		State: "open",
-	})
+	ghClient, err := client.buildGithubClient(ctx)
 	if err != nil {
-		return []PullRequestInfo{}, err
+		return err
 	}
-	return mapGitHubPullRequestToPullRequestInfoList(pullRequests)
+	_, err = ghClient.Issues.DeleteComment(ctx, owner, repository, commentID)
+	return err
 }
 
-// AddPullRequestComment on GitHub
-func (client *GitHubClient) AddPullRequestComment(ctx context.Context, owner, repository, content string, pullRequestID int) error {
-	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository, "content": content})
+// AddPullRequestReviewComments on GitHub
+func (client *GitHubClient) AddPullRequestReviewComments(ctx context.Context, owner, repository string, pullRequestID int, comments []ReviewComment) error {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository})
 	if err != nil {
 		return err
 	}
+	if err = validateReviewComments(comments); err != nil {
+		return err
+	}
 	ghClient, err := client.buildGithubClient(ctx)
 	if err != nil {
 		return err
 	}
-	// We use the Issues API to add a regular comment. The PullRequests API adds a code review comment.
-	_, _, err = ghClient.Issues.CreateComment(ctx, owner, repository, pullRequestID, &github.IssueComment{
-		Body: &content,
+	draftComments := make([]*github.DraftReviewComment, 0, len(comments))
+	for _, comment := range comments {
+		draftComments = append(draftComments, mapReviewCommentToGitHubDraftReviewComment(comment))
+	}
+	// All the comments are submitted at once, as a single review which neither approves nor requests changes.
+	_, _, err = ghClient.PullRequests.CreateReview(ctx, owner, repository, pullRequestID, &github.PullRequestReviewRequest{
+		Event:    vcsutils.PointerOf("COMMENT"),
+		Comments: draftComments,
 	})
 	return err
 }
 
-// ListPullRequestComments on GitHub
-func (client *GitHubClient) ListPullRequestComments(ctx context.Context, owner, repository string, pullRequestID int) ([]CommentInfo, error) {
+// ListPullRequestReviewComments on GitHub
+func (client *GitHubClient) ListPullRequestReviewComments(owner, repository string, pullRequestID int) *Pager[ReviewCommentInfo] {
 	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository})
 	if err != nil {
-		return []CommentInfo{}, err
-	}
-	ghClient, err := client.buildGithubClient(ctx)
-	if err != nil {
-		return []CommentInfo{}, err
+		return newErrorPager[ReviewCommentInfo](err)
 	}
-	commentsList, _, err := ghClient.Issues.ListComments(ctx, owner, repository, pullRequestID, &github.IssueListCommentsOptions{})
+	return newNumberedPager(1, func(ctx context.Context, page int) ([]ReviewCommentInfo, int, error) {
+		ghClient, err := client.buildGithubClient(ctx)
+		if err != nil {
+			return nil, 0, err
+		}
+		options := &github.PullRequestListCommentsOptions{ListOptions: github.ListOptions{Page: page}}
+		comments, response, err := ghClient.PullRequests.ListComments(ctx, owner, repository, pullRequestID, options)
+		if err != nil {
+			return nil, 0, err
+		}
+		results := make([]ReviewCommentInfo, 0, len(comments))
+		for _, comment := range comments {
+			results = append(results, mapGitHubReviewCommentToReviewCommentInfo(comment))
+		}
+		return results, response.NextPage, nil
+	})
+}
+
+// ListPullRequestFiles on GitHub
+func (client *GitHubClient) ListPullRequestFiles(owner, repository string, pullRequestID int) *Pager[vcsutils.FileDiff] {
+	return client.listPullRequestFiles(owner, repository, pullRequestID, false)
+}
+
+// GetPullRequestDiff on GitHub
+func (client *GitHubClient) GetPullRequestDiff(ctx context.Context, owner, repository string, pullRequestID int) ([]vcsutils.FileDiff, error) {
+	return client.listPullRequestFiles(owner, repository, pullRequestID, true).All(ctx)
+}
+
+func (client *GitHubClient) listPullRequestFiles(owner, repository string, pullRequestID int, withHunks bool) *Pager[vcsutils.FileDiff] {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository})
 	if err != nil {
-		return []CommentInfo{}, err
+		return newErrorPager[vcsutils.FileDiff](err)
 	}
-	return mapGitHubCommentToCommentInfoList(commentsList)
+	return newNumberedPager(1, func(ctx context.Context, page int) ([]vcsutils.FileDiff, int, error) {
+		ghClient, err := client.buildGithubClient(ctx)
+		if err != nil {
+			return nil, 0, err
+		}
+		files, response, err := ghClient.PullRequests.ListFiles(ctx, owner, repository, pullRequestID, &github.ListOptions{Page: page})
+		if err != nil {
+			return nil, 0, err
+		}
+		results := make([]vcsutils.FileDiff, 0, len(files))
+		for _, file := range files {
+			fileDiff, err := mapGitHubCommitFileToFileDiff(file, withHunks)
+			if err != nil {
+				return nil, 0, err
+			}
+			results = append(results, fileDiff)
+		}
+		return results, response.NextPage, nil
+	})
 }
 
 // GetLatestCommit on GitHub
@@ -343,7 +1167,90 @@ func (client *GitHubClient) GetRepositoryInfo(ctx context.Context, owner, reposi
 	if err != nil {
 		return RepositoryInfo{}, err
 	}
-	return RepositoryInfo{RepositoryVisibility: getGitHubRepositoryVisibility(repo), CloneInfo: CloneInfo{HTTP: repo.GetCloneURL(), SSH: repo.GetSSHURL()}}, nil
+	return mapGitHubRepositoryToRepositoryInfo(repo), nil
+}
+
+// CreateRepository on GitHub
+func (client *GitHubClient) CreateRepository(ctx context.Context, owner string, options CreateRepositoryOptions) error {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository name": options.Name})
+	if err != nil {
+		return err
+	}
+	ghClient, err := client.buildGithubClient(ctx)
+	if err != nil {
+		return err
+	}
+	// The repositories of the authenticated user are created without an organization
+	organization := owner
+	user, _, err := ghClient.Users.Get(ctx, "")
+	if err != nil {
+		return err
+	}
+	if strings.EqualFold(user.GetLogin(), owner) {
+		organization = ""
+	}
+	repo, _, err := ghClient.Repositories.Create(ctx, organization, &github.Repository{
+		Name:        &options.Name,
+		Description: &options.Description,
+		Private:     vcsutils.PointerOf(options.Visibility != Public),
+		Visibility:  vcsutils.PointerOf(getGitHubVisibilityName(options.Visibility)),
+		AutoInit:    &options.InitReadme,
+	})
+	if err != nil {
+		return err
+	}
+	// GitHub creates the initial branch with the default name of the owner, so it is renamed
+	if options.InitReadme && options.DefaultBranch != "" && repo.GetDefaultBranch() != options.DefaultBranch {
+		_, _, err = ghClient.Repositories.RenameBranch(ctx, owner, options.Name, repo.GetDefaultBranch(), options.DefaultBranch)
+	}
+	return err
+}
+
+// ForkRepository on GitHub
+func (client *GitHubClient) ForkRepository(ctx context.Context, owner, repository, targetOwner string) error {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository})
+	if err != nil {
+		return err
+	}
+	ghClient, err := client.buildGithubClient(ctx)
+	if err != nil {
+		return err
+	}
+	_, _, err = ghClient.Repositories.CreateFork(ctx, owner, repository, &github.RepositoryCreateForkOptions{Organization: targetOwner})
+	// The fork is created asynchronously
+	var acceptedErr *github.AcceptedError
+	if errors.As(err, &acceptedErr) {
+		return nil
+	}
+	return err
+}
+
+// ArchiveRepository on GitHub
+func (client *GitHubClient) ArchiveRepository(ctx context.Context, owner, repository string) error {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository})
+	if err != nil {
+		return err
+	}
+	ghClient, err := client.buildGithubClient(ctx)
+	if err != nil {
+		return err
+	}
+	_, _, err = ghClient.Repositories.Edit(ctx, owner, repository, &github.Repository{Archived: vcsutils.PointerOf(true)})
+	return err
+}
+
+// DeleteRepository on GitHub
+func (client *GitHubClient) DeleteRepository(ctx context.Context, owner, repository string) error {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository})
+	if err != nil {
+		return err
+	}
+	ghClient, err := client.buildGithubClient(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = ghClient.Repositories.Delete(ctx, owner, repository)
+	return err
 }
 
 // GetCommitBySha on GitHub
@@ -370,6 +1277,37 @@ func (client *GitHubClient) GetCommitBySha(ctx context.Context, owner, repositor
 	return mapGitHubCommitToCommitInfo(commit), nil
 }
 
+// ListCommits on GitHub
+func (client *GitHubClient) ListCommits(owner, repository string, options ListCommitsOptions) *Pager[CommitInfo] {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository})
+	if err != nil {
+		return newErrorPager[CommitInfo](err)
+	}
+	return newNumberedPager(1, func(ctx context.Context, page int) ([]CommitInfo, int, error) {
+		ghClient, err := client.buildGithubClient(ctx)
+		if err != nil {
+			return nil, 0, err
+		}
+		listOptions := &github.CommitsListOptions{
+			SHA:         options.Ref,
+			Path:        options.Path,
+			Author:      options.Author,
+			Since:       options.Since,
+			Until:       options.Until,
+			ListOptions: github.ListOptions{Page: page, PerPage: options.PageSize},
+		}
+		commits, response, err := ghClient.Repositories.ListCommits(ctx, owner, repository, listOptions)
+		if err != nil {
+			return nil, 0, err
+		}
+		results := make([]CommitInfo, 0, len(commits))
+		for _, commit := range commits {
+			results = append(results, mapGitHubCommitToCommitInfo(commit))
+		}
+		return results, response.NextPage, nil
+	})
+}
+
 // CreateLabel on GitHub
 func (client *GitHubClient) CreateLabel(ctx context.Context, owner, repository string, labelInfo LabelInfo) error {
 	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository, "LabelInfo.name": labelInfo.Name})
@@ -411,39 +1349,107 @@ func (client *GitHubClient) GetLabel(ctx context.Context, owner, repository, nam
 		return nil, err
 	}
 
-	return &LabelInfo{
-		Name:        *label.Name,
-		Description: *label.Description,
-		Color:       *label.Color,
-	}, err
+	labelInfo := mapGitHubLabelToLabelInfo(label)
+	return &labelInfo, err
 }
 
-// ListPullRequestLabels on GitHub
-func (client *GitHubClient) ListPullRequestLabels(ctx context.Context, owner, repository string, pullRequestID int) ([]string, error) {
+// ListLabels on GitHub
+func (client *GitHubClient) ListLabels(owner, repository string) *Pager[LabelInfo] {
 	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository})
 	if err != nil {
-		return nil, err
+		return newErrorPager[LabelInfo](err)
+	}
+	return newNumberedPager(1, func(ctx context.Context, page int) ([]LabelInfo, int, error) {
+		ghClient, err := client.buildGithubClient(ctx)
+		if err != nil {
+			return nil, 0, err
+		}
+		labels, response, err := ghClient.Issues.ListLabels(ctx, owner, repository, &github.ListOptions{Page: page})
+		if err != nil {
+			return nil, 0, err
+		}
+		results := make([]LabelInfo, 0, len(labels))
+		for _, label := range labels {
+			results = append(results, mapGitHubLabelToLabelInfo(label))
+		}
+		return results, response.NextPage, nil
+	})
+}
+
+// UpdateLabel on GitHub
+func (client *GitHubClient) UpdateLabel(ctx context.Context, owner, repository, name string, labelInfo LabelInfo) error {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository, "name": name, "LabelInfo.name": labelInfo.Name})
+	if err != nil {
+		return err
+	}
+	ghClient, err := client.buildGithubClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, _, err = ghClient.Issues.EditLabel(ctx, owner, repository, name, &github.Label{
+		Name:        &labelInfo.Name,
+		Description: &labelInfo.Description,
+		Color:       &labelInfo.Color,
+	})
+	return err
+}
+
+// DeleteLabel on GitHub
+func (client *GitHubClient) DeleteLabel(ctx context.Context, owner, repository, name string) error {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository, "name": name})
+	if err != nil {
+		return err
+	}
+	ghClient, err := client.buildGithubClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = ghClient.Issues.DeleteLabel(ctx, owner, repository, name)
+	return err
+}
+
+// AddPullRequestLabels on GitHub
+func (client *GitHubClient) AddPullRequestLabels(ctx context.Context, owner, repository string, pullRequestID int, labels ...string) error {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository})
+	if err != nil {
+		return err
+	}
+	if len(labels) == 0 {
+		return nil
 	}
 	ghClient, err := client.buildGithubClient(ctx)
 	if err != nil {
-		return []string{}, err
+		return err
 	}
 
-	results := []string{}
-	for nextPage := 0; ; nextPage++ {
-		options := &github.ListOptions{Page: nextPage}
-		labels, response, err := ghClient.Issues.ListLabelsByIssue(ctx, owner, repository, pullRequestID, options)
+	// Pull requests are issues on GitHub, so the labels are added using the Issues API
+	_, _, err = ghClient.Issues.AddLabelsToIssue(ctx, owner, repository, pullRequestID, labels)
+	return err
+}
+
+// ListPullRequestLabels on GitHub
+func (client *GitHubClient) ListPullRequestLabels(owner, repository string, pullRequestID int) *Pager[string] {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository})
+	if err != nil {
+		return newErrorPager[string](err)
+	}
+	return newNumberedPager(1, func(ctx context.Context, page int) ([]string, int, error) {
+		ghClient, err := client.buildGithubClient(ctx)
 		if err != nil {
-			return []string{}, err
+			return nil, 0, err
 		}
-		for _, label := range labels {
-			results = append(results, *label.Name)
+		labels, response, err := ghClient.Issues.ListLabelsByIssue(ctx, owner, repository, pullRequestID, &github.ListOptions{Page: page})
+		if err != nil {
+			return nil, 0, err
 		}
-		if nextPage+1 >= response.LastPage {
-			break
+		results := make([]string, 0, len(labels))
+		for _, label := range labels {
+			results = append(results, label.GetName())
 		}
-	}
-	return results, nil
+		return results, response.NextPage, nil
+	})
 }
 
 // UnlabelPullRequest on GitHub
@@ -567,6 +1573,15 @@ func (client *GitHubClient) GetRepositoryEnvironmentInfo(ctx context.Context, ow
 }
 
 func (client *GitHubClient) GetModifiedFiles(ctx context.Context, owner, repository, refBefore, refAfter string) ([]string, error) {
+	fileChanges, err := client.GetFileChanges(ctx, owner, repository, refBefore, refAfter)
+	if err != nil {
+		return nil, err
+	}
+	return getFileChangesPaths(fileChanges), nil
+}
+
+// GetFileChanges on GitHub
+func (client *GitHubClient) GetFileChanges(ctx context.Context, owner, repository, refBefore, refAfter string) ([]FileChange, error) {
 	if err := validateParametersNotBlank(map[string]string{
 		"owner":      owner,
 		"repository": repository,
@@ -594,15 +1609,18 @@ func (client *GitHubClient) GetModifiedFiles(ctx context.Context, owner, reposit
 		return nil, err
 	}
 
-	fileNamesSet := datastructures.MakeSet[string]()
+	fileChanges := make([]FileChange, 0, len(comparison.Files))
 	for _, file := range comparison.Files {
-		fileNamesSet.Add(vcsutils.DefaultIfNotNil(file.Filename))
-		fileNamesSet.Add(vcsutils.DefaultIfNotNil(file.PreviousFilename))
+		fileChange := FileChange{Path: file.GetFilename(), Status: getGitHubFileStatus(file.GetStatus())}
+		// The previous filename is returned only for files which were moved
+		if previousPath := file.GetPreviousFilename(); previousPath != "" && previousPath != fileChange.Path {
+			fileChange.Status = vcsutils.FileRenamed
+			fileChange.PreviousPath = previousPath
+		}
+		fileChanges = append(fileChanges, fileChange)
 	}
-	_ = fileNamesSet.Remove("") // Make sure there are no blank filepath.
-	fileNamesList := fileNamesSet.ToSlice()
-	sort.Strings(fileNamesList)
-	return fileNamesList, nil
+	sortFileChanges(fileChanges)
+	return fileChanges, nil
 }
 
 // Extract code reviewers from environment
@@ -641,32 +1659,108 @@ func getGitHubWebhookEvents(webhookEvents ...vcsutils.WebhookEvent) []string {
 	for _, event := range webhookEvents {
 		switch event {
 		case vcsutils.PrOpened, vcsutils.PrEdited, vcsutils.PrMerged, vcsutils.PrRejected:
-			events = append(events, "pull_request")
-		case vcsutils.Push:
-			events = append(events, "push")
+			events = appendMissing(events, "pull_request")
+		case vcsutils.Push, vcsutils.TagPushed, vcsutils.TagDeleted, vcsutils.BranchCreated, vcsutils.BranchDeleted:
+			events = appendMissing(events, "push")
+		case vcsutils.PrCommentAdded:
+			// Comments on the conversation of a pull request are issue comments
+			events = appendMissing(events, "issue_comment", "pull_request_review_comment")
+		case vcsutils.PrReviewSubmitted, vcsutils.PrApproved:
+			events = appendMissing(events, "pull_request_review")
+		case vcsutils.ReleasePublished:
+			events = appendMissing(events, "release")
+		}
+	}
+	return events
+}
+
+func mapGitHubHookToWebhookInfo(hook *github.Hook) WebhookInfo {
+	payloadURL, _ := hook.Config["url"].(string)
+	return WebhookInfo{
+		ID:                 strconv.FormatInt(hook.GetID(), 10),
+		PayloadURL:         payloadURL,
+		Events:             mapGitHubWebhookEvents(hook.Events),
+		Active:             hook.GetActive(),
+		LastDeliveryStatus: getGitHubWebhookDeliveryStatus(hook.LastResponse),
+	}
+}
+
+// Get a slice of GitHub webhook events and return the matching webhook events
+func mapGitHubWebhookEvents(gitHubEvents []string) []vcsutils.WebhookEvent {
+	events := make([]vcsutils.WebhookEvent, 0, len(gitHubEvents))
+	for _, event := range gitHubEvents {
+		switch event {
+		case "pull_request":
+			events = appendMissing(events, vcsutils.PrOpened, vcsutils.PrEdited, vcsutils.PrMerged, vcsutils.PrRejected)
+		case "push":
+			events = appendMissing(events, vcsutils.Push, vcsutils.TagPushed, vcsutils.TagDeleted, vcsutils.BranchCreated, vcsutils.BranchDeleted)
+		case "issue_comment", "pull_request_review_comment":
+			events = appendMissing(events, vcsutils.PrCommentAdded)
+		case "pull_request_review":
+			events = appendMissing(events, vcsutils.PrReviewSubmitted, vcsutils.PrApproved)
+		case "release":
+			events = appendMissing(events, vcsutils.ReleasePublished)
 		}
 	}
 	return events
 }
 
+// The last response of a webhook has no code before the first delivery
+func getGitHubWebhookDeliveryStatus(lastResponse map[string]interface{}) WebhookDeliveryStatus {
+	code, ok := lastResponse["code"].(float64)
+	if !ok {
+		return DeliveryUnknown
+	}
+	if code >= 200 && code < 300 {
+		return DeliverySucceeded
+	}
+	return DeliveryFailed
+}
+
 func getGitHubRepositoryVisibility(repo *github.Repository) RepositoryVisibility {
-	switch *repo.Visibility {
+	switch repo.GetVisibility() {
 	case "public":
 		return Public
 	case "internal":
 		return Internal
-	default:
+	case "private":
+		return Private
+	}
+	// Older GitHub Enterprise versions return only the private flag
+	if repo.GetPrivate() {
 		return Private
 	}
+	return Public
+}
+
+// getGitHubListVisibility returns the visibility parameter of the repositories list request.
+// The list request has no internal visibility, so the internal repositories are filtered after listing all the repositories.
+func getGitHubListVisibility(visibility *RepositoryVisibility) string {
+	if visibility == nil || *visibility == Internal {
+		return "all"
+	}
+	return getGitHubVisibilityName(*visibility)
+}
+
+func getGitHubVisibilityName(visibility RepositoryVisibility) string {
+	switch visibility {
+	case Public:
+		return "public"
+	case Internal:
+		return "internal"
+	default:
+		return "private"
+	}
 }
 
+// getGitHubCommitState returns the state of a GitHub status, which doesn't have skipped and cancelled states
 func getGitHubCommitState(commitState CommitStatus) string {
 	switch commitState {
-	case Pass:
+	case Pass, Skipped:
 		return "success"
 	case Fail:
 		return "failure"
-	case Error:
+	case Error, Cancelled:
 		return "error"
 	case InProgress:
 		return "pending"
@@ -674,21 +1768,133 @@ func getGitHubCommitState(commitState CommitStatus) string {
 	return ""
 }
 
+func mapGitHubCommitState(state string) CommitStatus {
+	switch state {
+	case "success":
+		return Pass
+	case "failure":
+		return Fail
+	case "error":
+		return Error
+	}
+	return InProgress
+}
+
+// getGitHubCheckRunState returns the status of the check run, and the conclusion and the completion time of a completed check run
+func getGitHubCheckRunState(state CommitStatus) (status, conclusion *string, completedAt *github.Timestamp) {
+	switch state {
+	case InProgress:
+		return vcsutils.PointerOf("in_progress"), nil, nil
+	case Pass:
+		conclusion = vcsutils.PointerOf("success")
+	case Skipped:
+		conclusion = vcsutils.PointerOf("skipped")
+	case Cancelled:
+		conclusion = vcsutils.PointerOf("cancelled")
+	default:
+		conclusion = vcsutils.PointerOf("failure")
+	}
+	return vcsutils.PointerOf("completed"), conclusion, &github.Timestamp{Time: time.Now()}
+}
+
+func createGitHubCheckRunOutput(checkRun CheckRunOptions, annotations []CheckRunAnnotation) *github.CheckRunOutput {
+	output := &github.CheckRunOutput{
+		Title:       vcsutils.PointerOf(checkRun.Title),
+		Summary:     vcsutils.PointerOf(checkRun.Summary),
+		Annotations: make([]*github.CheckRunAnnotation, 0, len(annotations)),
+	}
+	for _, annotation := range annotations {
+		endLine := annotation.EndLine
+		if endLine == 0 {
+			endLine = annotation.StartLine
+		}
+		output.Annotations = append(output.Annotations, &github.CheckRunAnnotation{
+			Path:            vcsutils.PointerOf(annotation.Path),
+			StartLine:       vcsutils.PointerOf(annotation.StartLine),
+			EndLine:         vcsutils.PointerOf(endLine),
+			AnnotationLevel: vcsutils.PointerOf(getGitHubAnnotationLevel(annotation.Level)),
+			Title:           vcsutils.PointerOf(annotation.Title),
+			Message:         vcsutils.PointerOf(annotation.Message),
+		})
+	}
+	return output
+}
+
+func getGitHubAnnotationLevel(level AnnotationLevel) string {
+	switch level {
+	case WarningAnnotation:
+		return "warning"
+	case FailureAnnotation:
+		return "failure"
+	}
+	return "notice"
+}
+
+func mapGitHubCheckRunToCommitStatusInfo(checkRun *github.CheckRun) CommitStatusInfo {
+	status := CommitStatusInfo{
+		State:         mapGitHubCheckRunState(checkRun.GetStatus(), checkRun.GetConclusion()),
+		Context:       checkRun.GetName(),
+		Description:   checkRun.GetOutput().GetTitle(),
+		DetailsURL:    checkRun.GetDetailsURL(),
+		CreatedAt:     checkRun.GetStartedAt().Time,
+		LastUpdatedAt: checkRun.GetStartedAt().Time,
+	}
+	if status.DetailsURL == "" {
+		status.DetailsURL = checkRun.GetHTMLURL()
+	}
+	if checkRun.CompletedAt != nil {
+		status.LastUpdatedAt = checkRun.GetCompletedAt().Time
+	}
+	return status
+}
+
+// mapGitHubCheckRunState maps the status and the conclusion of a check run.
+// Neutral check runs don't block the commit, and therefore they pass.
+func mapGitHubCheckRunState(status, conclusion string) CommitStatus {
+	if status != "completed" {
+		return InProgress
+	}
+	switch conclusion {
+	case "success", "neutral":
+		return Pass
+	case "skipped":
+		return Skipped
+	case "cancelled":
+		return Cancelled
+	case "stale":
+		return Error
+	}
+	return Fail
+}
+
 func mapGitHubCommitToCommitInfo(commit *github.RepositoryCommit) CommitInfo {
 	parents := make([]string, len(commit.Parents))
 	for i, c := range commit.Parents {
 		parents[i] = c.GetSHA()
 	}
 	details := commit.GetCommit()
-	return CommitInfo{
-		Hash:          commit.GetSHA(),
-		AuthorName:    details.GetAuthor().GetName(),
-		CommitterName: details.GetCommitter().GetName(),
-		Url:           commit.GetURL(),
-		Timestamp:     details.GetCommitter().GetDate().UTC().Unix(),
-		Message:       details.GetMessage(),
-		ParentHashes:  parents,
-	}
+	commitInfo := CommitInfo{
+		Hash:           commit.GetSHA(),
+		AuthorName:     details.GetAuthor().GetName(),
+		AuthorEmail:    details.GetAuthor().GetEmail(),
+		CommitterName:  details.GetCommitter().GetName(),
+		CommitterEmail: details.GetCommitter().GetEmail(),
+		Url:            commit.GetURL(),
+		WebURL:         commit.GetHTMLURL(),
+		Timestamp:      details.GetCommitter().GetDate().UTC().Unix(),
+		AuthorDate:     details.GetAuthor().GetDate().UTC(),
+		CommitDate:     details.GetCommitter().GetDate().UTC(),
+		Message:        details.GetMessage(),
+		ParentHashes:   parents,
+	}
+	if verification := details.GetVerification(); verification != nil {
+		commitInfo.Verification = &CommitVerification{Verified: verification.GetVerified(), Reason: verification.GetReason()}
+	}
+	// The stats are returned only for a single commit
+	if commit.Stats != nil {
+		commitInfo.Stats = &CommitStats{Additions: commit.Stats.GetAdditions(), Deletions: commit.Stats.GetDeletions(), FilesChanged: len(commit.Files)}
+	}
+	return commitInfo
 }
 
 func mapGitHubCommentToCommentInfoList(commentsList []*github.IssueComment) (res []CommentInfo, err error) {
@@ -702,6 +1908,122 @@ func mapGitHubCommentToCommentInfoList(commentsList []*github.IssueComment) (res
 	return
 }
 
+func mapReviewCommentToGitHubDraftReviewComment(comment ReviewComment) *github.DraftReviewComment {
+	side := getGitHubReviewCommentSide(comment.Side)
+	draftComment := &github.DraftReviewComment{
+		Path: vcsutils.PointerOf(comment.Path),
+		Body: vcsutils.PointerOf(comment.Content),
+		Side: &side,
+		Line: vcsutils.PointerOf(comment.Line),
+	}
+	if comment.StartLine > 0 && comment.StartLine < comment.Line {
+		draftComment.StartLine = vcsutils.PointerOf(comment.StartLine)
+		draftComment.StartSide = &side
+	}
+	return draftComment
+}
+
+func mapGitHubReviewCommentToReviewCommentInfo(comment *github.PullRequestComment) ReviewCommentInfo {
+	side := RightSide
+	if comment.GetSide() == "LEFT" {
+		side = LeftSide
+	}
+	return ReviewCommentInfo{
+		ID:        comment.GetID(),
+		Content:   comment.GetBody(),
+		Created:   comment.GetCreatedAt(),
+		Path:      comment.GetPath(),
+		Line:      comment.GetLine(),
+		StartLine: comment.GetStartLine(),
+		Side:      side,
+	}
+}
+
+func getGitHubReviewCommentSide(side ReviewCommentSide) string {
+	if side == LeftSide {
+		return "LEFT"
+	}
+	return "RIGHT"
+}
+
+func mapGitHubReleaseToReleaseInfo(release *github.RepositoryRelease) ReleaseInfo {
+	releaseInfo := ReleaseInfo{
+		TagName:   release.GetTagName(),
+		Name:      release.GetName(),
+		Notes:     release.GetBody(),
+		CreatedAt: release.GetCreatedAt().Time,
+	}
+	for _, asset := range release.Assets {
+		releaseInfo.Assets = append(releaseInfo.Assets, ReleaseAsset{Name: asset.GetName(), URL: asset.GetBrowserDownloadURL()})
+	}
+	return releaseInfo
+}
+
+func mapGitHubRepositoryToRepositoryInfo(repo *github.Repository) RepositoryInfo {
+	repositoryInfo := RepositoryInfo{
+		RepositoryVisibility: getGitHubRepositoryVisibility(repo),
+		CloneInfo:            CloneInfo{HTTP: repo.GetCloneURL(), SSH: repo.GetSSHURL()},
+		DefaultBranch:        repo.GetDefaultBranch(),
+		Description:          repo.GetDescription(),
+		WebURL:               repo.GetHTMLURL(),
+		Topics:               repo.Topics,
+		Archived:             repo.GetArchived(),
+		Fork:                 repo.GetFork(),
+		// GitHub returns the repository size in kilobytes
+		Size:         int64(repo.GetSize()) * 1024,
+		CreatedAt:    repo.GetCreatedAt().Time,
+		LastPushedAt: repo.GetPushedAt().Time,
+		Language:     repo.GetLanguage(),
+	}
+	if parent := repo.GetParent(); parent != nil {
+		repositoryInfo.Parent = &RepositoryReference{Owner: parent.GetOwner().GetLogin(), Name: parent.GetName()}
+	}
+	return repositoryInfo
+}
+
+func mapGitHubLabelToLabelInfo(label *github.Label) LabelInfo {
+	return LabelInfo{
+		Name:        label.GetName(),
+		Description: label.GetDescription(),
+		Color:       label.GetColor(),
+	}
+}
+
+func mapGitHubCommitFileToFileDiff(file *github.CommitFile, withHunks bool) (vcsutils.FileDiff, error) {
+	fileDiff := vcsutils.FileDiff{
+		Path:      file.GetFilename(),
+		Status:    getGitHubFileStatus(file.GetStatus()),
+		Additions: file.GetAdditions(),
+		Deletions: file.GetDeletions(),
+	}
+	if fileDiff.Status == vcsutils.FileRenamed {
+		fileDiff.PreviousPath = file.GetPreviousFilename()
+	}
+	if !withHunks {
+		return fileDiff, nil
+	}
+	// The patch is omitted for binary files, and for files with a large diff
+	if file.Patch == nil {
+		return fileDiff, nil
+	}
+	var err error
+	fileDiff.Hunks, err = vcsutils.ParseDiffHunks(file.GetPatch())
+	return fileDiff, err
+}
+
+func getGitHubFileStatus(status string) vcsutils.FileStatus {
+	switch status {
+	case "added", "copied":
+		return vcsutils.FileAdded
+	case "removed":
+		return vcsutils.FileRemoved
+	case "renamed":
+		return vcsutils.FileRenamed
+	default:
+		return vcsutils.FileModified
+	}
+}
+
 func mapGitHubPullRequestToPullRequestInfoList(pullRequestList []*github.PullRequest) (res []PullRequestInfo, err error) {
 	for _, pullRequest := range pullRequestList {
 		res = append(res, PullRequestInfo{
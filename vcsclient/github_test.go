@@ -9,6 +9,7 @@ import (
 	"math"
 	"math/rand"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"os"
 	"path/filepath"
@@ -108,16 +109,328 @@ func TestGitHubClient_ListRepositoriesWithPagination(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestGitHubClient_StreamRepositories(t *testing.T) {
+	ctx := context.Background()
+	recentRepository := &github.Repository{Name: &repo1, Owner: &github.User{Login: &username}, Visibility: vcsutils.PointerOf("internal"),
+		DefaultBranch: vcsutils.PointerOf("main"), PushedAt: &github.Timestamp{Time: time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)}}
+	oldRepository := &github.Repository{Name: &repo2, Owner: &github.User{Login: &username}, Visibility: vcsutils.PointerOf("internal"),
+		PushedAt: &github.Timestamp{Time: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)}}
+	client, _, cleanUp := createRoutingServerAndClient(t, vcsutils.GitHub, false, map[string]interface{}{
+		"/user/repos?direction=desc&page=1&per_page=100&sort=pushed&visibility=all": []*github.Repository{recentRepository, oldRepository},
+	})
+	defer cleanUp()
+
+	// The listing stops at the first repository which wasn't pushed since UpdatedSince
+	options := ListRepositoriesOptions{Visibility: vcsutils.PointerOf(Internal), UpdatedSince: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)}
+	repositories, err := collectRepositories(client.StreamRepositories(ctx, options))
+	assert.NoError(t, err)
+	assert.Equal(t, []RepositoryListItem{{Owner: username, Name: repo1, RepositoryInfo: mapGitHubRepositoryToRepositoryInfo(recentRepository)}}, repositories)
+	assert.Equal(t, "main", repositories[0].DefaultBranch)
+
+	_, err = collectRepositories(createBadGitHubClient(t).StreamRepositories(ctx, ListRepositoriesOptions{}))
+	assert.Error(t, err)
+}
+
+func TestGitHubClient_StreamRepositoriesOfOwner(t *testing.T) {
+	ctx := context.Background()
+	repository := &github.Repository{Name: &repo1, Owner: &github.User{Login: vcsutils.PointerOf(owner)}, Visibility: vcsutils.PointerOf("private")}
+	userRepository := &github.Repository{Name: &repo2, Owner: &github.User{Login: vcsutils.PointerOf("froggy")}, Visibility: vcsutils.PointerOf("public")}
+	selfRepository := &github.Repository{Name: &repo1, Owner: &github.User{Login: &username}, Visibility: vcsutils.PointerOf("private")}
+	client, _, cleanUp := createRoutingServerAndClient(t, vcsutils.GitHub, false, map[string]interface{}{
+		"/user":              &github.User{Login: &username, Type: vcsutils.PointerOf("User")},
+		"/users/jfrog":       &github.User{Login: vcsutils.PointerOf(owner), Type: vcsutils.PointerOf("Organization")},
+		"/users/froggy":      &github.User{Login: vcsutils.PointerOf("froggy"), Type: vcsutils.PointerOf("User")},
+		"/users/" + username: &github.User{Login: &username, Type: vcsutils.PointerOf("User")},
+		"/orgs/jfrog/repos?direction=desc&page=1&per_page=100&sort=pushed&type=private":               []*github.Repository{repository},
+		"/users/froggy/repos?direction=desc&page=1&per_page=100&sort=pushed&type=owner":               []*github.Repository{userRepository},
+		"/user/repos?affiliation=owner&direction=desc&page=1&per_page=100&sort=pushed&visibility=all": []*github.Repository{selfRepository},
+	})
+	defer cleanUp()
+
+	// The repositories of an organization
+	repositories, err := collectRepositories(client.StreamRepositories(ctx, ListRepositoriesOptions{Owner: owner, Visibility: vcsutils.PointerOf(Private)}))
+	assert.NoError(t, err)
+	assert.Equal(t, []RepositoryListItem{{Owner: owner, Name: repo1, RepositoryInfo: mapGitHubRepositoryToRepositoryInfo(repository)}}, repositories)
+
+	// The repositories of another user
+	repositories, err = collectRepositories(client.StreamRepositories(ctx, ListRepositoriesOptions{Owner: "froggy"}))
+	assert.NoError(t, err)
+	assert.Equal(t, []RepositoryListItem{{Owner: "froggy", Name: repo2, RepositoryInfo: mapGitHubRepositoryToRepositoryInfo(userRepository)}}, repositories)
+
+	// The repositories of the authenticated user, including the private ones
+	repositories, err = collectRepositories(client.StreamRepositories(ctx, ListRepositoriesOptions{Owner: username}))
+	assert.NoError(t, err)
+	assert.Equal(t, []RepositoryListItem{{Owner: username, Name: repo1, RepositoryInfo: mapGitHubRepositoryToRepositoryInfo(selfRepository)}}, repositories)
+
+	_, err = collectRepositories(createBadGitHubClient(t).StreamRepositories(ctx, ListRepositoriesOptions{Owner: owner}))
+	assert.Error(t, err)
+}
+
 func TestGitHubClient_ListBranches(t *testing.T) {
 	ctx := context.Background()
-	client, cleanUp := createServerAndClient(t, vcsutils.GitHub, false, []github.Branch{{Name: &branch1}, {Name: &branch2}}, fmt.Sprintf("/repos/jfrog/%s/branches", repo1), createGitHubHandler)
+	client, cleanUp := createServerAndClient(t, vcsutils.GitHub, false, []github.Branch{{Name: &branch1}, {Name: &branch2}}, fmt.Sprintf("/repos/jfrog/%s/branches?page=1", repo1), createGitHubHandler)
 	defer cleanUp()
 
-	actualBranches, err := client.ListBranches(ctx, owner, repo1)
+	actualBranches, err := client.ListBranches(owner, repo1).All(ctx)
 	assert.NoError(t, err)
 	assert.ElementsMatch(t, actualBranches, []string{branch1, branch2})
 
-	_, err = createBadGitHubClient(t).ListBranches(ctx, owner, repo1)
+	_, err = createBadGitHubClient(t).ListBranches(owner, repo1).All(ctx)
+	assert.Error(t, err)
+}
+
+func TestGitHubClient_CreateBranch(t *testing.T) {
+	ctx := context.Background()
+	expectedBody := []byte(`{"ref":"refs/heads/` + branch1 + `","sha":"` + commitHash + `"}` + "\n")
+	client, cleanUp := createBodyHandlingServerAndClient(t, vcsutils.GitHub, false, github.Reference{},
+		"/repos/jfrog/repo-1/git/refs", http.StatusCreated, expectedBody, http.MethodPost, createGitHubWithBodyHandler)
+	defer cleanUp()
+
+	err := client.CreateBranch(ctx, owner, repo1, branch1, commitHash)
+	assert.NoError(t, err)
+
+	err = createBadGitHubClient(t).CreateBranch(ctx, owner, repo1, branch1, commitHash)
+	assert.Error(t, err)
+}
+
+func TestGitHubClient_CreateBranchFromBranch(t *testing.T) {
+	ctx := context.Background()
+	client, _, cleanUp := createRoutingServerAndClient(t, vcsutils.GitHub, false, map[string]interface{}{
+		"/repos/jfrog/repo-1/commits/" + branch2: []byte(commitHash),
+		"/repos/jfrog/repo-1/git/refs":           github.Reference{},
+	})
+	defer cleanUp()
+
+	err := client.CreateBranch(ctx, owner, repo1, branch1, branch2)
+	assert.NoError(t, err)
+}
+
+func TestGitHubClient_DeleteBranch(t *testing.T) {
+	ctx := context.Background()
+	client, cleanUp := createServerAndClient(t, vcsutils.GitHub, false, nil,
+		"/repos/jfrog/repo-1/git/refs/heads/"+branch1, createGitHubHandler)
+	defer cleanUp()
+
+	err := client.DeleteBranch(ctx, owner, repo1, branch1)
+	assert.NoError(t, err)
+
+	err = createBadGitHubClient(t).DeleteBranch(ctx, owner, repo1, branch1)
+	assert.Error(t, err)
+}
+
+func TestGitHubClient_GetBranch(t *testing.T) {
+	ctx := context.Background()
+	client, _, cleanUp := createRoutingServerAndClient(t, vcsutils.GitHub, false, map[string]interface{}{
+		"/repos/jfrog/repo-1/branches/" + branch1: github.Branch{
+			Name:      &branch1,
+			Commit:    &github.RepositoryCommit{SHA: vcsutils.PointerOf(commitHash)},
+			Protected: vcsutils.PointerOf(true),
+		},
+		"/repos/jfrog/repo-1": github.Repository{DefaultBranch: &branch1},
+	})
+	defer cleanUp()
+
+	branchDetails, err := client.GetBranch(ctx, owner, repo1, branch1)
+	assert.NoError(t, err)
+	assert.Equal(t, BranchDetails{Name: branch1, CommitSHA: commitHash, Protected: true, Default: true}, branchDetails)
+
+	_, err = createBadGitHubClient(t).GetBranch(ctx, owner, repo1, branch1)
+	assert.Error(t, err)
+}
+
+func TestGitHubClient_CommitFiles(t *testing.T) {
+	ctx := context.Background()
+	parentSHA, treeSHA, blobSHA := "parent-sha", "tree-sha", "blob-sha"
+	client, requestBodies, cleanUp := createRoutingServerAndClient(t, vcsutils.GitHub, false, map[string]interface{}{
+		"/repos/jfrog/repo-1/git/ref/heads/" + branch1: github.Reference{
+			Ref:    vcsutils.PointerOf("refs/heads/" + branch1),
+			Object: &github.GitObject{SHA: &parentSHA},
+		},
+		"/repos/jfrog/repo-1/git/refs/heads/" + branch1: github.Reference{},
+		"/repos/jfrog/repo-1/git/commits/" + parentSHA:  github.Commit{SHA: &parentSHA, Tree: &github.Tree{SHA: &treeSHA}},
+		"/repos/jfrog/repo-1/git/blobs":                 github.Blob{SHA: &blobSHA},
+		"/repos/jfrog/repo-1/git/trees":                 github.Tree{SHA: vcsutils.PointerOf("new-tree-sha")},
+		"/repos/jfrog/repo-1/git/commits":               github.Commit{SHA: &commitHash},
+	})
+	defer cleanUp()
+
+	err := client.CommitFiles(ctx, owner, repo1, branch1, "Update files", []CommitFileChange{
+		{Action: UpdateFile, Path: "go.mod", Content: []byte("module example")},
+		{Action: DeleteFile, Path: "old.txt"},
+		{Action: MoveFile, Path: "new.txt", PreviousPath: "moved.txt", Content: []byte("moved")},
+	}, CommitAuthor{Name: "frogger", Email: "frogger@jfrog.com"})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"content":"bW92ZWQ=","encoding":"base64"}`+"\n", string(requestBodies["POST /repos/jfrog/repo-1/git/blobs"]))
+	assert.JSONEq(t, `{"base_tree":"tree-sha","tree":[
+		{"sha":"blob-sha","path":"go.mod","mode":"100644","type":"blob"},
+		{"sha":null,"path":"old.txt","mode":"100644","type":"blob"},
+		{"sha":null,"path":"moved.txt","mode":"100644","type":"blob"},
+		{"sha":"blob-sha","path":"new.txt","mode":"100644","type":"blob"}]}`,
+		string(requestBodies["POST /repos/jfrog/repo-1/git/trees"]))
+	assert.JSONEq(t, `{"author":{"name":"frogger","email":"frogger@jfrog.com"},"message":"Update files","tree":"new-tree-sha","parents":["parent-sha"]}`,
+		string(requestBodies["POST /repos/jfrog/repo-1/git/commits"]))
+	assert.JSONEq(t, `{"sha":"`+commitHash+`","force":false}`, string(requestBodies["PATCH /repos/jfrog/repo-1/git/refs/heads/"+branch1]))
+
+	err = createBadGitHubClient(t).CommitFiles(ctx, owner, repo1, branch1, "Update files",
+		[]CommitFileChange{{Action: CreateFile, Path: "go.mod"}}, CommitAuthor{})
+	assert.Error(t, err)
+}
+
+func TestGitHubClient_ListTags(t *testing.T) {
+	ctx := context.Background()
+	client, _, cleanUp := createRoutingServerAndClient(t, vcsutils.GitHub, false, map[string]interface{}{
+		"/repos/jfrog/repo-1/tags?page=1": []github.RepositoryTag{
+			{Name: vcsutils.PointerOf("v1.0.0"), Commit: &github.Commit{SHA: &commitHash}},
+		},
+	})
+	defer cleanUp()
+
+	tags, err := client.ListTags(owner, repo1).All(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []TagInfo{{Name: "v1.0.0", CommitSHA: commitHash}}, tags)
+
+	_, err = createBadGitHubClient(t).ListTags(owner, repo1).All(ctx)
+	assert.Error(t, err)
+}
+
+func TestGitHubClient_CreateTag(t *testing.T) {
+	ctx := context.Background()
+	client, requestBodies, cleanUp := createRoutingServerAndClient(t, vcsutils.GitHub, false, map[string]interface{}{
+		"/repos/jfrog/repo-1/git/tags": github.Tag{SHA: vcsutils.PointerOf("tag-sha")},
+		"/repos/jfrog/repo-1/git/refs": github.Reference{},
+	})
+	defer cleanUp()
+
+	err := client.CreateTag(ctx, owner, repo1, "v1.0.0", commitHash, "")
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"ref":"refs/tags/v1.0.0","sha":"`+commitHash+`"}`, string(requestBodies["POST /repos/jfrog/repo-1/git/refs"]))
+
+	err = client.CreateTag(ctx, owner, repo1, "v1.0.0", commitHash, "Release 1.0.0")
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"tag":"v1.0.0","message":"Release 1.0.0","object":"`+commitHash+`","type":"commit"}`,
+		string(requestBodies["POST /repos/jfrog/repo-1/git/tags"]))
+	// The reference of an annotated tag points to the tag object
+	assert.JSONEq(t, `{"ref":"refs/tags/v1.0.0","sha":"tag-sha"}`, string(requestBodies["POST /repos/jfrog/repo-1/git/refs"]))
+
+	err = createBadGitHubClient(t).CreateTag(ctx, owner, repo1, "v1.0.0", commitHash, "")
+	assert.Error(t, err)
+}
+
+func TestGitHubClient_DeleteTag(t *testing.T) {
+	ctx := context.Background()
+	client, cleanUp := createServerAndClient(t, vcsutils.GitHub, false, nil,
+		"/repos/jfrog/repo-1/git/refs/tags/v1.0.0", createGitHubHandler)
+	defer cleanUp()
+
+	err := client.DeleteTag(ctx, owner, repo1, "v1.0.0")
+	assert.NoError(t, err)
+
+	err = createBadGitHubClient(t).DeleteTag(ctx, owner, repo1, "v1.0.0")
+	assert.Error(t, err)
+}
+
+func TestGitHubClient_CreateRelease(t *testing.T) {
+	ctx := context.Background()
+	assetPath := filepath.Join(t.TempDir(), "asset.txt")
+	assert.NoError(t, os.WriteFile(assetPath, []byte("asset content"), 0600))
+	client, requestBodies, cleanUp := createRoutingServerAndClient(t, vcsutils.GitHub, false, map[string]interface{}{
+		"/repos/jfrog/repo-1/releases": github.RepositoryRelease{
+			ID:      vcsutils.PointerOf(int64(1)),
+			TagName: vcsutils.PointerOf("v1.0.0"),
+			Name:    vcsutils.PointerOf("Release 1.0.0"),
+			Body:    vcsutils.PointerOf("Notes"),
+		},
+		"/repos/jfrog/repo-1/releases/1/assets?name=asset.txt": github.ReleaseAsset{
+			Name:               vcsutils.PointerOf("asset.txt"),
+			BrowserDownloadURL: vcsutils.PointerOf("https://github.com/jfrog/repo-1/releases/download/v1.0.0/asset.txt"),
+		},
+	})
+	defer cleanUp()
+
+	release, err := client.CreateRelease(ctx, owner, repo1, CreateReleaseOptions{
+		TagName:    "v1.0.0",
+		TargetRef:  branch1,
+		Name:       "Release 1.0.0",
+		Notes:      "Notes",
+		AssetPaths: []string{assetPath},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, ReleaseInfo{
+		TagName: "v1.0.0",
+		Name:    "Release 1.0.0",
+		Notes:   "Notes",
+		Assets:  []ReleaseAsset{{Name: "asset.txt", URL: "https://github.com/jfrog/repo-1/releases/download/v1.0.0/asset.txt"}},
+	}, release)
+	assert.JSONEq(t, `{"tag_name":"v1.0.0","target_commitish":"`+branch1+`","name":"Release 1.0.0","body":"Notes"}`,
+		string(requestBodies["POST /repos/jfrog/repo-1/releases"]))
+	assert.Equal(t, "asset content", string(requestBodies["POST /repos/jfrog/repo-1/releases/1/assets?name=asset.txt"]))
+
+	_, err = createBadGitHubClient(t).CreateRelease(ctx, owner, repo1, CreateReleaseOptions{TagName: "v1.0.0"})
+	assert.Error(t, err)
+}
+
+func TestGitHubClient_CreateReleaseUploadFailure(t *testing.T) {
+	ctx := context.Background()
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.RequestURI)
+		switch r.Method {
+		case http.MethodPost:
+			_, err := w.Write([]byte(`{"id": 1, "tag_name": "v1.0.0"}`))
+			assert.NoError(t, err)
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+	client := buildClient(t, vcsutils.GitHub, false, server)
+
+	// The release is deleted when an asset can't be uploaded
+	_, err := client.CreateRelease(ctx, owner, repo1, CreateReleaseOptions{
+		TagName:    "v1.0.0",
+		AssetPaths: []string{filepath.Join(t.TempDir(), "missing.txt")},
+	})
+	assert.ErrorIs(t, err, os.ErrNotExist)
+	assert.Equal(t, []string{"POST /repos/jfrog/repo-1/releases", "DELETE /repos/jfrog/repo-1/releases/1"}, requests)
+}
+
+func TestGetGitHubUploadURL(t *testing.T) {
+	defaultUploadURL, err := url.Parse("https://uploads.github.com/")
+	assert.NoError(t, err)
+	tests := []struct {
+		baseURL           string
+		expectedUploadURL string
+	}{
+		{baseURL: "https://api.github.com/", expectedUploadURL: "https://uploads.github.com/"},
+		{baseURL: "https://github.example.com/api/v3/", expectedUploadURL: "https://github.example.com/api/uploads/"},
+		{baseURL: "http://127.0.0.1:8080/", expectedUploadURL: "http://127.0.0.1:8080/"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.baseURL, func(t *testing.T) {
+			baseURL, err := url.Parse(tt.baseURL)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedUploadURL, getGitHubUploadURL(baseURL, defaultUploadURL).String())
+		})
+	}
+}
+
+func TestGitHubClient_ListReleases(t *testing.T) {
+	ctx := context.Background()
+	createdAt := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+	client, _, cleanUp := createRoutingServerAndClient(t, vcsutils.GitHub, false, map[string]interface{}{
+		"/repos/jfrog/repo-1/releases?page=1": []github.RepositoryRelease{{
+			TagName:   vcsutils.PointerOf("v1.0.0"),
+			Name:      vcsutils.PointerOf("Release 1.0.0"),
+			CreatedAt: &github.Timestamp{Time: createdAt},
+		}},
+	})
+	defer cleanUp()
+
+	releases, err := client.ListReleases(owner, repo1).All(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []ReleaseInfo{{TagName: "v1.0.0", Name: "Release 1.0.0", CreatedAt: createdAt}}, releases)
+
+	_, err = createBadGitHubClient(t).ListReleases(owner, repo1).All(ctx)
 	assert.Error(t, err)
 }
 
@@ -164,21 +477,219 @@ func TestGitHubClient_DeleteWebhook(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestGitHubClient_ListWebhooks(t *testing.T) {
+	ctx := context.Background()
+	hookID := int64(1)
+	hook := &github.Hook{
+		ID:           &hookID,
+		Events:       []string{"push", "pull_request"},
+		Active:       github.Bool(true),
+		Config:       map[string]interface{}{"url": "https://jfrog.com/hook", "content_type": "json"},
+		LastResponse: map[string]interface{}{"code": 500, "status": "active", "message": "Internal Server Error"},
+	}
+	client, _, cleanUp := createRoutingServerAndClient(t, vcsutils.GitHub, false, map[string]interface{}{
+		fmt.Sprintf("/repos/jfrog/%s/hooks?page=1", repo1):  []*github.Hook{hook},
+		fmt.Sprintf("/repos/jfrog/%s/hooks/1", repo1):       hook,
+		fmt.Sprintf("/repos/jfrog/%s/hooks/1/pings", repo1): nil,
+	})
+	defer cleanUp()
+
+	expected := WebhookInfo{
+		ID:                 "1",
+		PayloadURL:         "https://jfrog.com/hook",
+		Events:             []vcsutils.WebhookEvent{vcsutils.Push, vcsutils.TagPushed, vcsutils.TagDeleted, vcsutils.BranchCreated, vcsutils.BranchDeleted, vcsutils.PrOpened, vcsutils.PrEdited, vcsutils.PrMerged, vcsutils.PrRejected},
+		Active:             true,
+		LastDeliveryStatus: DeliveryFailed,
+	}
+	webhooks, err := client.ListWebhooks(owner, repo1).All(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []WebhookInfo{expected}, webhooks)
+
+	webhook, err := client.GetWebhook(ctx, owner, repo1, "1")
+	require.NoError(t, err)
+	assert.Equal(t, expected, webhook)
+
+	assert.NoError(t, client.PingWebhook(ctx, owner, repo1, "1"))
+
+	_, err = createBadGitHubClient(t).GetWebhook(ctx, owner, repo1, "1")
+	assert.Error(t, err)
+}
+
+func TestGitHubClient_OrganizationWebhooks(t *testing.T) {
+	ctx := context.Background()
+	hookID := int64(1)
+	hook := &github.Hook{
+		ID:     &hookID,
+		Events: []string{"push"},
+		Active: github.Bool(true),
+		Config: map[string]interface{}{"url": "https://jfrog.com/hook"},
+	}
+	client, bodies, cleanUp := createRoutingServerAndClient(t, vcsutils.GitHub, false, map[string]interface{}{
+		"/orgs/jfrog/hooks":        hook,
+		"/orgs/jfrog/hooks/1":      hook,
+		"/orgs/jfrog/hooks?page=1": []*github.Hook{hook},
+	})
+	defer cleanUp()
+
+	id, token, err := client.CreateOrganizationWebhook(ctx, owner, "https://jfrog.com/hook", vcsutils.Push)
+	require.NoError(t, err)
+	assert.Equal(t, "1", id)
+	assert.NotEmpty(t, token)
+	var created github.Hook
+	require.NoError(t, json.Unmarshal(bodies["POST /orgs/jfrog/hooks"], &created))
+	assert.Equal(t, []string{"push"}, created.Events)
+	assert.Equal(t, token, created.Config["secret"])
+
+	err = client.UpdateOrganizationWebhook(ctx, owner, "https://jfrog.com/hook", token, id, vcsutils.Push, vcsutils.PrOpened)
+	require.NoError(t, err)
+	assert.Contains(t, string(bodies["PATCH /orgs/jfrog/hooks/1"]), "pull_request")
+
+	webhooks, err := client.ListOrganizationWebhooks(owner).All(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []WebhookInfo{{ID: "1", PayloadURL: "https://jfrog.com/hook", Events: []vcsutils.WebhookEvent{vcsutils.Push, vcsutils.TagPushed, vcsutils.TagDeleted, vcsutils.BranchCreated, vcsutils.BranchDeleted}, Active: true}}, webhooks)
+
+	assert.NoError(t, client.DeleteOrganizationWebhook(ctx, owner, id))
+
+	_, _, err = createBadGitHubClient(t).CreateOrganizationWebhook(ctx, owner, "https://jfrog.com/hook", vcsutils.Push)
+	assert.Error(t, err)
+}
+
+func TestGetGitHubWebhookDeliveryStatus(t *testing.T) {
+	assert.Equal(t, DeliveryUnknown, getGitHubWebhookDeliveryStatus(nil))
+	assert.Equal(t, DeliveryUnknown, getGitHubWebhookDeliveryStatus(map[string]interface{}{"code": nil, "status": "unused"}))
+	assert.Equal(t, DeliverySucceeded, getGitHubWebhookDeliveryStatus(map[string]interface{}{"code": float64(200)}))
+	assert.Equal(t, DeliveryFailed, getGitHubWebhookDeliveryStatus(map[string]interface{}{"code": float64(404)}))
+}
+
 func TestGitHubClient_CreateCommitStatus(t *testing.T) {
 	ctx := context.Background()
 	ref := "39e5418"
 	client, cleanUp := createServerAndClient(t, vcsutils.GitHub, false, github.RepoStatus{}, fmt.Sprintf("/repos/jfrog/%s/statuses/%s", repo1, ref), createGitHubHandler)
 	defer cleanUp()
 
-	err := client.SetCommitStatus(ctx, Error, owner, repo1, ref, "Commit status title", "Commit status description",
-		"https://httpbin.org/anything")
+	status := CommitStatusOptions{
+		State:       Error,
+		Key:         "Commit status title",
+		Description: "Commit status description",
+		DetailsURL:  "https://httpbin.org/anything",
+	}
+	err := client.SetCommitStatus(ctx, owner, repo1, ref, status)
 	assert.NoError(t, err)
 
-	err = createBadGitHubClient(t).SetCommitStatus(ctx, Error, owner, repo1, ref, "Commit status title", "Commit status description",
-		"https://httpbin.org/anything")
+	err = createBadGitHubClient(t).SetCommitStatus(ctx, owner, repo1, ref, status)
+	assert.Error(t, err)
+}
+
+func TestGitHubClient_ListCommitStatuses(t *testing.T) {
+	ctx := context.Background()
+	ref := "39e5418"
+	createdAt := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+	updatedAt := createdAt.Add(time.Minute)
+	client, _, cleanUp := createRoutingServerAndClient(t, vcsutils.GitHub, false, map[string]interface{}{
+		fmt.Sprintf("/repos/jfrog/%s/commits/%s/status?page=1", repo1, ref): github.CombinedStatus{
+			State: github.String("pending"),
+			Statuses: []*github.RepoStatus{{
+				State:       github.String("success"),
+				Context:     github.String("ci/build"),
+				Description: github.String("Build passed"),
+				TargetURL:   github.String("https://ci.example.com/build/1"),
+				CreatedAt:   &createdAt,
+				UpdatedAt:   &updatedAt,
+			}},
+		},
+		fmt.Sprintf("/repos/jfrog/%s/commits/%s/check-runs?page=1", repo1, ref): github.ListCheckRunsResults{
+			CheckRuns: []*github.CheckRun{{
+				Name:       github.String("Frogbot"),
+				Status:     github.String("completed"),
+				Conclusion: github.String("failure"),
+				HTMLURL:    github.String("https://github.com/jfrog/repo-1/runs/1"),
+				Output:     &github.CheckRunOutput{Title: github.String("2 vulnerabilities")},
+				StartedAt:  &github.Timestamp{Time: createdAt},
+			}},
+		},
+	})
+	defer cleanUp()
+
+	buildStatus := CommitStatusInfo{
+		State:         Pass,
+		Context:       "ci/build",
+		Description:   "Build passed",
+		DetailsURL:    "https://ci.example.com/build/1",
+		CreatedAt:     createdAt,
+		LastUpdatedAt: updatedAt,
+	}
+	statuses, err := client.ListCommitStatuses(owner, repo1, ref).All(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []CommitStatusInfo{buildStatus}, statuses)
+
+	// The check runs are combined with the statuses
+	combined, err := client.GetCombinedCommitStatus(ctx, owner, repo1, ref)
+	require.NoError(t, err)
+	assert.Equal(t, CombinedCommitStatus{State: Fail, Statuses: []CommitStatusInfo{buildStatus, {
+		State:         Fail,
+		Context:       "Frogbot",
+		Description:   "2 vulnerabilities",
+		DetailsURL:    "https://github.com/jfrog/repo-1/runs/1",
+		CreatedAt:     createdAt,
+		LastUpdatedAt: createdAt,
+	}}}, combined)
+
+	_, err = createBadGitHubClient(t).GetCombinedCommitStatus(ctx, owner, repo1, ref)
 	assert.Error(t, err)
 }
 
+func TestGitHubClient_CreateCheckRun(t *testing.T) {
+	ctx := context.Background()
+	var requests []string
+	var annotationsCounts []int
+	var lastRequest map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.RequestURI)
+		var request struct {
+			Output github.CheckRunOutput `json:"output"`
+		}
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(body, &request))
+		require.NoError(t, json.Unmarshal(body, &lastRequest))
+		annotationsCounts = append(annotationsCounts, len(request.Output.Annotations))
+		_, err = w.Write([]byte(`{"id": 4}`))
+		require.NoError(t, err)
+	}))
+	defer server.Close()
+	client := buildClient(t, vcsutils.GitHub, false, server)
+
+	checkRun := CheckRunOptions{Name: "Frogbot", CommitSHA: "39e5418", State: InProgress, Title: "Scanning", Summary: "**Scanning**"}
+	for i := 0; i < 120; i++ {
+		checkRun.Annotations = append(checkRun.Annotations, CheckRunAnnotation{Path: "main.go", StartLine: i + 1, Level: WarningAnnotation, Message: "Vulnerable"})
+	}
+	// The annotations are sent in batches of 50
+	checkRunID, err := client.CreateCheckRun(ctx, owner, repo1, checkRun)
+	require.NoError(t, err)
+	assert.Equal(t, "4", checkRunID)
+	assert.Equal(t, []string{"POST /repos/jfrog/repo-1/check-runs", "PATCH /repos/jfrog/repo-1/check-runs/4", "PATCH /repos/jfrog/repo-1/check-runs/4"}, requests)
+	assert.Equal(t, []int{50, 50, 20}, annotationsCounts)
+
+	// The check run is completed
+	requests, annotationsCounts = nil, nil
+	err = client.UpdateCheckRun(ctx, owner, repo1, checkRunID, CheckRunOptions{Name: "Frogbot", State: Fail, Title: "2 vulnerabilities"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"PATCH /repos/jfrog/repo-1/check-runs/4"}, requests)
+	assert.Equal(t, "completed", lastRequest["status"])
+	assert.Equal(t, "failure", lastRequest["conclusion"])
+
+	_, err = createBadGitHubClient(t).CreateCheckRun(ctx, owner, repo1, checkRun)
+	assert.Error(t, err)
+}
+
+func TestMapGitHubCheckRunState(t *testing.T) {
+	assert.Equal(t, InProgress, mapGitHubCheckRunState("queued", ""))
+	assert.Equal(t, Pass, mapGitHubCheckRunState("completed", "neutral"))
+	assert.Equal(t, Skipped, mapGitHubCheckRunState("completed", "skipped"))
+	assert.Equal(t, Cancelled, mapGitHubCheckRunState("completed", "cancelled"))
+	assert.Equal(t, Fail, mapGitHubCheckRunState("completed", "timed_out"))
+}
+
 func TestGitHubClient_getRepositoryVisibility(t *testing.T) {
 	visibility := "public"
 	assert.Equal(t, Public, getGitHubRepositoryVisibility(&github.Repository{Visibility: &visibility}))
@@ -186,6 +697,9 @@ func TestGitHubClient_getRepositoryVisibility(t *testing.T) {
 	assert.Equal(t, Internal, getGitHubRepositoryVisibility(&github.Repository{Visibility: &visibility}))
 	visibility = "private"
 	assert.Equal(t, Private, getGitHubRepositoryVisibility(&github.Repository{Visibility: &visibility}))
+	// Without the visibility, the private flag is used
+	assert.Equal(t, Private, getGitHubRepositoryVisibility(&github.Repository{Private: vcsutils.PointerOf(true)}))
+	assert.Equal(t, Public, getGitHubRepositoryVisibility(&github.Repository{}))
 }
 
 func TestGitHubClient_getGitHubCommitState(t *testing.T) {
@@ -193,7 +707,9 @@ func TestGitHubClient_getGitHubCommitState(t *testing.T) {
 	assert.Equal(t, "failure", getGitHubCommitState(Fail))
 	assert.Equal(t, "error", getGitHubCommitState(Error))
 	assert.Equal(t, "pending", getGitHubCommitState(InProgress))
-	assert.Equal(t, "", getGitHubCommitState(5))
+	assert.Equal(t, "success", getGitHubCommitState(Skipped))
+	assert.Equal(t, "error", getGitHubCommitState(Cancelled))
+	assert.Equal(t, "", getGitHubCommitState(7))
 }
 
 func TestGitHubClient_DownloadRepository(t *testing.T) {
@@ -265,6 +781,133 @@ func TestGitHubClient_AddPullRequestComment(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestGitHubClient_EditPullRequestComment(t *testing.T) {
+	ctx := context.Background()
+	expectedBody := []byte(`{"body":"Comment content"}` + "\n")
+	client, cleanUp := createBodyHandlingServerAndClient(t, vcsutils.GitHub, false, github.IssueComment{},
+		"/repos/jfrog/repo-1/issues/comments/2", http.StatusOK, expectedBody, http.MethodPatch, createGitHubWithBodyHandler)
+	defer cleanUp()
+
+	err := client.EditPullRequestComment(ctx, owner, repo1, 1, 2, "Comment content")
+	assert.NoError(t, err)
+
+	err = createBadGitHubClient(t).EditPullRequestComment(ctx, owner, repo1, 1, 2, "Comment content")
+	assert.Error(t, err)
+}
+
+func TestGitHubClient_DeletePullRequestComment(t *testing.T) {
+	ctx := context.Background()
+	client, cleanUp := createServerAndClient(t, vcsutils.GitHub, false, nil,
+		"/repos/jfrog/repo-1/issues/comments/2", createGitHubHandler)
+	defer cleanUp()
+
+	err := client.DeletePullRequestComment(ctx, owner, repo1, 1, 2)
+	assert.NoError(t, err)
+
+	err = createBadGitHubClient(t).DeletePullRequestComment(ctx, owner, repo1, 1, 2)
+	assert.Error(t, err)
+}
+
+func TestGitHubClient_AddPullRequestReviewComments(t *testing.T) {
+	ctx := context.Background()
+	expectedBody := []byte(`{"event":"COMMENT","comments":[{"path":"file1.txt","body":"Comment content","side":"RIGHT","line":5},` +
+		`{"path":"file2.txt","body":"Comment content","start_side":"LEFT","side":"LEFT","start_line":2,"line":4}]}` + "\n")
+	client, cleanUp := createBodyHandlingServerAndClient(t, vcsutils.GitHub, false, github.PullRequestReview{},
+		"/repos/jfrog/repo-1/pulls/1/reviews", http.StatusOK, expectedBody, http.MethodPost, createGitHubWithBodyHandler)
+	defer cleanUp()
+
+	comments := []ReviewComment{
+		{Path: "file1.txt", Line: 5, Content: "Comment content"},
+		{Path: "file2.txt", StartLine: 2, Line: 4, Side: LeftSide, Content: "Comment content"},
+	}
+	err := client.AddPullRequestReviewComments(ctx, owner, repo1, 1, comments)
+	assert.NoError(t, err)
+
+	err = client.AddPullRequestReviewComments(ctx, owner, repo1, 1, []ReviewComment{{Path: "file1.txt", Content: "Comment content"}})
+	assert.EqualError(t, err, "validation failed: the line of the review comment on 'file1.txt' must be positive")
+
+	err = createBadGitHubClient(t).AddPullRequestReviewComments(ctx, owner, repo1, 1, comments)
+	assert.Error(t, err)
+}
+
+func TestGitHubClient_ListPullRequestReviewComments(t *testing.T) {
+	ctx := context.Background()
+	response, err := os.ReadFile(filepath.Join("testdata", "github", "pull_request_review_comments_list_response.json"))
+	assert.NoError(t, err)
+	client, cleanUp := createServerAndClient(t, vcsutils.GitHub, false, response,
+		fmt.Sprintf("/repos/%s/%s/pulls/1/comments?page=1", owner, repo1), createGitHubHandler)
+	defer cleanUp()
+
+	result, err := client.ListPullRequestReviewComments(owner, repo1, 1).All(ctx)
+	require.NoError(t, err)
+	expectedCreated, err := time.Parse(time.RFC3339, "2011-04-14T16:00:49Z")
+	assert.NoError(t, err)
+	assert.Equal(t, []ReviewCommentInfo{
+		{ID: 10, Content: "Great stuff!", Created: expectedCreated, Path: "file1.txt", Line: 2, StartLine: 1, Side: RightSide},
+		{ID: 11, Content: "Removed line", Created: expectedCreated, Path: "file2.txt", Line: 7, Side: LeftSide},
+	}, result)
+
+	_, err = createBadGitHubClient(t).ListPullRequestReviewComments(owner, repo1, 1).All(ctx)
+	assert.Error(t, err)
+}
+
+func TestGitHubClient_ListPullRequestFiles(t *testing.T) {
+	ctx := context.Background()
+	client, cleanUp := createServerAndClient(t, vcsutils.GitHub, false, pullRequestFilesResponse(),
+		"/repos/jfrog/repo-1/pulls/1/files?page=1", createGitHubHandler)
+	defer cleanUp()
+
+	result, err := client.ListPullRequestFiles(owner, repo1, 1).All(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []vcsutils.FileDiff{
+		{Path: "src/main.go", Status: vcsutils.FileModified, Additions: 1, Deletions: 1},
+		{Path: "new.txt", PreviousPath: "old.txt", Status: vcsutils.FileRenamed},
+	}, result)
+
+	_, err = createBadGitHubClient(t).ListPullRequestFiles(owner, repo1, 1).All(ctx)
+	assert.Error(t, err)
+}
+
+func TestGitHubClient_GetPullRequestDiff(t *testing.T) {
+	ctx := context.Background()
+	client, cleanUp := createServerAndClient(t, vcsutils.GitHub, false, pullRequestFilesResponse(),
+		"/repos/jfrog/repo-1/pulls/1/files?page=1", createGitHubHandler)
+	defer cleanUp()
+
+	result, err := client.GetPullRequestDiff(ctx, owner, repo1, 1)
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+	assert.Equal(t, []vcsutils.DiffHunk{{
+		OldStart: 3, OldLines: 2, NewStart: 3, NewLines: 2,
+		Lines: []vcsutils.DiffLine{
+			{Type: vcsutils.ContextLine, Content: "func main() {", OldLine: 3, NewLine: 3},
+			{Type: vcsutils.RemovedLine, Content: "}", OldLine: 4},
+			{Type: vcsutils.AddedLine, Content: "} // main", NewLine: 4},
+		},
+	}}, result[0].Hunks)
+	assert.Empty(t, result[1].Hunks)
+
+	_, err = createBadGitHubClient(t).GetPullRequestDiff(ctx, owner, repo1, 1)
+	assert.Error(t, err)
+}
+
+func pullRequestFilesResponse() []*github.CommitFile {
+	return []*github.CommitFile{
+		{
+			Filename:  vcsutils.PointerOf("src/main.go"),
+			Status:    vcsutils.PointerOf("modified"),
+			Additions: vcsutils.PointerOf(1),
+			Deletions: vcsutils.PointerOf(1),
+			Patch:     vcsutils.PointerOf("@@ -3,2 +3,2 @@\n func main() {\n-}\n+} // main"),
+		},
+		{
+			Filename:         vcsutils.PointerOf("new.txt"),
+			PreviousFilename: vcsutils.PointerOf("old.txt"),
+			Status:           vcsutils.PointerOf("renamed"),
+		},
+	}
+}
+
 func TestGitHubClient_GetLatestCommit(t *testing.T) {
 	ctx := context.Background()
 	response, err := os.ReadFile(filepath.Join("testdata", "github", "commit_list_response.json"))
@@ -278,19 +921,57 @@ func TestGitHubClient_GetLatestCommit(t *testing.T) {
 
 	require.NoError(t, err)
 	assert.Equal(t, CommitInfo{
-		Hash:          "6dcb09b5b57875f334f61aebed695e2e4193db5e",
-		AuthorName:    "Monalisa Octocat",
-		CommitterName: "Joconde Octocat",
-		Url:           "https://api.github.com/repos/octocat/Hello-World/commits/6dcb09b5b57875f334f61aebed695e2e4193db5e",
-		Timestamp:     1302796850,
-		Message:       "Fix all the bugs",
-		ParentHashes:  []string{"6dcb09b5b57875f334f61aebed695e2e4193db5e"},
+		Hash:           "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+		AuthorName:     "Monalisa Octocat",
+		AuthorEmail:    "support@github.com",
+		CommitterName:  "Joconde Octocat",
+		CommitterEmail: "support2@github.com",
+		Url:            "https://api.github.com/repos/octocat/Hello-World/commits/6dcb09b5b57875f334f61aebed695e2e4193db5e",
+		WebURL:         "https://github.com/octocat/Hello-World/commit/6dcb09b5b57875f334f61aebed695e2e4193db5e",
+		Timestamp:      1302796850,
+		AuthorDate:     time.Date(2011, 4, 14, 16, 0, 49, 0, time.UTC),
+		CommitDate:     time.Date(2011, 4, 14, 16, 0, 50, 0, time.UTC),
+		Message:        "Fix all the bugs",
+		ParentHashes:   []string{"6dcb09b5b57875f334f61aebed695e2e4193db5e"},
+		Verification:   &CommitVerification{Reason: "unsigned"},
 	}, result)
 
 	_, err = createBadGitHubClient(t).GetLatestCommit(ctx, owner, repo1, "master")
 	assert.Error(t, err)
 }
 
+func TestGitHubClient_ListCommits(t *testing.T) {
+	ctx := context.Background()
+	response, err := os.ReadFile(filepath.Join("testdata", "github", "commit_list_response.json"))
+	assert.NoError(t, err)
+
+	client, cleanUp := createServerAndClient(t, vcsutils.GitHub, false, response,
+		fmt.Sprintf("/repos/%s/%s/commits?author=octocat&page=1&path=README.md&per_page=10&sha=master&since=2011-01-01T00%%3A00%%3A00Z", owner, repo1), createGitHubHandler)
+	defer cleanUp()
+
+	options := ListCommitsOptions{Ref: "master", Path: "README.md", Author: "octocat", Since: time.Date(2011, 1, 1, 0, 0, 0, 0, time.UTC), PageSize: 10}
+	result, err := client.ListCommits(owner, repo1, options).All(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []CommitInfo{{
+		Hash:           "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+		AuthorName:     "Monalisa Octocat",
+		AuthorEmail:    "support@github.com",
+		CommitterName:  "Joconde Octocat",
+		CommitterEmail: "support2@github.com",
+		Url:            "https://api.github.com/repos/octocat/Hello-World/commits/6dcb09b5b57875f334f61aebed695e2e4193db5e",
+		WebURL:         "https://github.com/octocat/Hello-World/commit/6dcb09b5b57875f334f61aebed695e2e4193db5e",
+		Timestamp:      1302796850,
+		AuthorDate:     time.Date(2011, 4, 14, 16, 0, 49, 0, time.UTC),
+		CommitDate:     time.Date(2011, 4, 14, 16, 0, 50, 0, time.UTC),
+		Message:        "Fix all the bugs",
+		ParentHashes:   []string{"6dcb09b5b57875f334f61aebed695e2e4193db5e"},
+		Verification:   &CommitVerification{Reason: "unsigned"},
+	}}, result)
+
+	_, err = createBadGitHubClient(t).ListCommits(owner, repo1, ListCommitsOptions{}).All(ctx)
+	assert.Error(t, err)
+}
+
 func TestGitHubClient_GetLatestCommitNotFound(t *testing.T) {
 	ctx := context.Background()
 	response := []byte(`{
@@ -393,13 +1074,20 @@ func TestGitHubClient_GetCommitBySha(t *testing.T) {
 
 	require.NoError(t, err)
 	assert.Equal(t, CommitInfo{
-		Hash:          sha,
-		AuthorName:    "Monalisa Octocat",
-		CommitterName: "Joconde Octocat",
-		Url:           "https://api.github.com/repos/octocat/Hello-World/commits/6dcb09b5b57875f334f61aebed695e2e4193db5e",
-		Timestamp:     1302796850,
-		Message:       "Fix all the bugs",
-		ParentHashes:  []string{"5dcb09b5b57875f334f61aebed695e2e4193db5e"},
+		Hash:           sha,
+		AuthorName:     "Monalisa Octocat",
+		AuthorEmail:    "support@github.com",
+		CommitterName:  "Joconde Octocat",
+		CommitterEmail: "support2@github.com",
+		Url:            "https://api.github.com/repos/octocat/Hello-World/commits/6dcb09b5b57875f334f61aebed695e2e4193db5e",
+		WebURL:         "https://github.com/octocat/Hello-World/commit/6dcb09b5b57875f334f61aebed695e2e4193db5e",
+		Timestamp:      1302796850,
+		AuthorDate:     time.Date(2011, 4, 14, 16, 0, 49, 0, time.UTC),
+		CommitDate:     time.Date(2011, 4, 14, 16, 0, 50, 0, time.UTC),
+		Message:        "Fix all the bugs",
+		ParentHashes:   []string{"5dcb09b5b57875f334f61aebed695e2e4193db5e"},
+		Verification:   &CommitVerification{Reason: "unsigned"},
+		Stats:          &CommitStats{Additions: 104, Deletions: 4, FilesChanged: 1},
 	}, result)
 
 	_, err = createBadGitHubClient(t).GetCommitBySha(ctx, owner, repo1, sha)
@@ -438,6 +1126,14 @@ func TestGitHubClient_GetRepositoryInfo(t *testing.T) {
 		RepositoryInfo{
 			RepositoryVisibility: Public,
 			CloneInfo:            CloneInfo{HTTP: "https://github.com/octocat/Hello-World.git", SSH: "git@github.com:octocat/Hello-World.git"},
+			DefaultBranch:        "master",
+			Description:          "This your first repo!",
+			WebURL:               "https://github.com/octocat/Hello-World",
+			Topics:               []string{"octocat", "atom", "electron", "api"},
+			Parent:               &RepositoryReference{Owner: "octocat", Name: "Hello-World"},
+			Size:                 108 * 1024,
+			CreatedAt:            time.Date(2011, 1, 26, 19, 1, 12, 0, time.UTC),
+			LastPushedAt:         time.Date(2011, 1, 26, 19, 6, 43, 0, time.UTC),
 		},
 		info,
 	)
@@ -446,6 +1142,73 @@ func TestGitHubClient_GetRepositoryInfo(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestGitHubClient_CreateRepository(t *testing.T) {
+	ctx := context.Background()
+	client, requestBodies, cleanUp := createRoutingServerAndClient(t, vcsutils.GitHub, false, map[string]interface{}{
+		"/user":             github.User{Login: vcsutils.PointerOf("frogger")},
+		"/user/repos":       github.Repository{DefaultBranch: vcsutils.PointerOf("main")},
+		"/orgs/jfrog/repos": github.Repository{DefaultBranch: vcsutils.PointerOf("main")},
+		"/repos/frogger/new-repo/branches/main/rename": github.Branch{},
+	})
+	defer cleanUp()
+
+	options := CreateRepositoryOptions{Name: "new-repo", Description: "A new repository", Visibility: Private, DefaultBranch: "develop", InitReadme: true}
+	err := client.CreateRepository(ctx, "frogger", options)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"name":"new-repo","description":"A new repository","private":true,"visibility":"private","auto_init":true}`,
+		string(requestBodies["POST /user/repos"]))
+	assert.JSONEq(t, `{"new_name":"develop"}`, string(requestBodies["POST /repos/frogger/new-repo/branches/main/rename"]))
+
+	err = client.CreateRepository(ctx, owner, CreateRepositoryOptions{Name: "new-repo", Visibility: Internal})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"name":"new-repo","description":"","private":true,"visibility":"internal","auto_init":false}`,
+		string(requestBodies["POST /orgs/jfrog/repos"]))
+
+	err = createBadGitHubClient(t).CreateRepository(ctx, owner, options)
+	assert.Error(t, err)
+}
+
+func TestGitHubClient_ForkRepository(t *testing.T) {
+	ctx := context.Background()
+	// The fork is created asynchronously, so GitHub responds with 202 Accepted
+	client, cleanUp := createServerAndClientReturningStatus(t, vcsutils.GitHub, false, github.Repository{},
+		"/repos/jfrog/repo-1/forks?organization=frogs", http.StatusAccepted, createGitHubHandler)
+	defer cleanUp()
+
+	err := client.ForkRepository(ctx, owner, repo1, "frogs")
+	assert.NoError(t, err)
+
+	err = createBadGitHubClient(t).ForkRepository(ctx, owner, repo1, "frogs")
+	assert.Error(t, err)
+}
+
+func TestGitHubClient_ArchiveRepository(t *testing.T) {
+	ctx := context.Background()
+	client, requestBodies, cleanUp := createRoutingServerAndClient(t, vcsutils.GitHub, false, map[string]interface{}{
+		"/repos/jfrog/repo-1": github.Repository{Archived: vcsutils.PointerOf(true)},
+	})
+	defer cleanUp()
+
+	err := client.ArchiveRepository(ctx, owner, repo1)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"archived":true}`, string(requestBodies["PATCH /repos/jfrog/repo-1"]))
+
+	err = createBadGitHubClient(t).ArchiveRepository(ctx, owner, repo1)
+	assert.Error(t, err)
+}
+
+func TestGitHubClient_DeleteRepository(t *testing.T) {
+	ctx := context.Background()
+	client, cleanUp := createServerAndClient(t, vcsutils.GitHub, false, nil, "/repos/jfrog/repo-1", createGitHubHandler)
+	defer cleanUp()
+
+	err := client.DeleteRepository(ctx, owner, repo1)
+	assert.NoError(t, err)
+
+	err = createBadGitHubClient(t).DeleteRepository(ctx, owner, repo1)
+	assert.Error(t, err)
+}
+
 func TestGitHubClient_CreateLabel(t *testing.T) {
 	ctx := context.Background()
 	client, cleanUp := createServerAndClient(t, vcsutils.GitHub, false, github.Label{}, fmt.Sprintf("/repos/jfrog/%s/labels", repo1), createGitHubHandler)
@@ -497,15 +1260,75 @@ func TestGitGubClient_GetLabelNotExisted(t *testing.T) {
 
 func TestGitHubClient_ListPullRequestLabels(t *testing.T) {
 	ctx := context.Background()
-	client, cleanUp := createServerAndClient(t, vcsutils.GitHub, false, []*github.Label{{Name: &labelName}}, "/repos/jfrog/repo-1/issues/1/labels", createGitHubHandler)
+	client, cleanUp := createServerAndClient(t, vcsutils.GitHub, false, []*github.Label{{Name: &labelName}}, "/repos/jfrog/repo-1/issues/1/labels?page=1", createGitHubHandler)
 	defer cleanUp()
 
-	labels, err := client.ListPullRequestLabels(ctx, owner, repo1, 1)
+	labels, err := client.ListPullRequestLabels(owner, repo1, 1).All(ctx)
 	assert.NoError(t, err)
 	assert.Len(t, labels, 1)
 	assert.Equal(t, labelName, labels[0])
 
-	_, err = createBadGitHubClient(t).ListPullRequestLabels(ctx, owner, repo1, 1)
+	_, err = createBadGitHubClient(t).ListPullRequestLabels(owner, repo1, 1).All(ctx)
+	assert.Error(t, err)
+}
+
+func TestGitHubClient_ListLabels(t *testing.T) {
+	ctx := context.Background()
+	description := "label-description"
+	color := "001122"
+	client, cleanUp := createServerAndClient(t, vcsutils.GitHub, false,
+		[]*github.Label{{Name: &labelName, Description: &description, Color: &color}},
+		"/repos/jfrog/repo-1/labels?page=1", createGitHubHandler)
+	defer cleanUp()
+
+	labels, err := client.ListLabels(owner, repo1).All(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []LabelInfo{{Name: labelName, Description: description, Color: color}}, labels)
+
+	_, err = createBadGitHubClient(t).ListLabels(owner, repo1).All(ctx)
+	assert.Error(t, err)
+}
+
+func TestGitHubClient_UpdateLabel(t *testing.T) {
+	ctx := context.Background()
+	expectedBody := []byte(`{"name":"new-name","color":"001122","description":"label-description"}` + "\n")
+	client, cleanUp := createBodyHandlingServerAndClient(t, vcsutils.GitHub, false, github.Label{},
+		fmt.Sprintf("/repos/jfrog/repo-1/labels/%s", url.PathEscape(labelName)), http.StatusOK, expectedBody, http.MethodPatch,
+		createGitHubWithBodyHandler)
+	defer cleanUp()
+
+	labelInfo := LabelInfo{Name: "new-name", Description: "label-description", Color: "001122"}
+	err := client.UpdateLabel(ctx, owner, repo1, labelName, labelInfo)
+	assert.NoError(t, err)
+
+	err = createBadGitHubClient(t).UpdateLabel(ctx, owner, repo1, labelName, labelInfo)
+	assert.Error(t, err)
+}
+
+func TestGitHubClient_DeleteLabel(t *testing.T) {
+	ctx := context.Background()
+	client, cleanUp := createServerAndClient(t, vcsutils.GitHub, false, nil,
+		fmt.Sprintf("/repos/jfrog/repo-1/labels/%s", url.PathEscape(labelName)), createGitHubHandler)
+	defer cleanUp()
+
+	err := client.DeleteLabel(ctx, owner, repo1, labelName)
+	assert.NoError(t, err)
+
+	err = createBadGitHubClient(t).DeleteLabel(ctx, owner, repo1, labelName)
+	assert.Error(t, err)
+}
+
+func TestGitHubClient_AddPullRequestLabels(t *testing.T) {
+	ctx := context.Background()
+	expectedBody := []byte(`["` + labelName + `","other-label"]` + "\n")
+	client, cleanUp := createBodyHandlingServerAndClient(t, vcsutils.GitHub, false, []*github.Label{},
+		"/repos/jfrog/repo-1/issues/1/labels", http.StatusOK, expectedBody, http.MethodPost, createGitHubWithBodyHandler)
+	defer cleanUp()
+
+	err := client.AddPullRequestLabels(ctx, owner, repo1, 1, labelName, "other-label")
+	assert.NoError(t, err)
+
+	err = createBadGitHubClient(t).AddPullRequestLabels(ctx, owner, repo1, 1, labelName)
 	assert.Error(t, err)
 }
 
@@ -514,10 +1337,10 @@ func TestGitHubClient_ListOpenPullRequests(t *testing.T) {
 	response, err := os.ReadFile(filepath.Join("testdata", "github", "pull_requests_list_response.json"))
 	assert.NoError(t, err)
 	client, cleanUp := createServerAndClient(t, vcsutils.GitHub, false, response,
-		fmt.Sprintf("/repos/%s/%s/pulls?state=open", owner, repo1), createGitHubHandler)
+		fmt.Sprintf("/repos/%s/%s/pulls?page=1&state=open", owner, repo1), createGitHubHandler)
 	defer cleanUp()
 
-	result, err := client.ListOpenPullRequests(ctx, owner, repo1)
+	result, err := client.ListOpenPullRequests(owner, repo1).All(ctx)
 	require.NoError(t, err)
 	assert.Len(t, result, 1)
 	assert.NoError(t, err)
@@ -527,7 +1350,7 @@ func TestGitHubClient_ListOpenPullRequests(t *testing.T) {
 		Target: BranchInfo{Name: "master", Repository: "Hello-World"},
 	}, result[0]))
 
-	_, err = createBadGitHubClient(t).ListPullRequestComments(ctx, owner, repo1, 1)
+	_, err = createBadGitHubClient(t).ListPullRequestComments(owner, repo1, 1).All(ctx)
 	assert.Error(t, err)
 }
 
@@ -536,10 +1359,10 @@ func TestGitHubClient_ListPullRequestComments(t *testing.T) {
 	response, err := os.ReadFile(filepath.Join("testdata", "github", "pull_request_comments_list_response.json"))
 	assert.NoError(t, err)
 	client, cleanUp := createServerAndClient(t, vcsutils.GitHub, false, response,
-		fmt.Sprintf("/repos/%s/%s/issues/1/comments", owner, repo1), createGitHubHandler)
+		fmt.Sprintf("/repos/%s/%s/issues/1/comments?page=1", owner, repo1), createGitHubHandler)
 	defer cleanUp()
 
-	result, err := client.ListPullRequestComments(ctx, owner, repo1, 1)
+	result, err := client.ListPullRequestComments(owner, repo1, 1).All(ctx)
 	require.NoError(t, err)
 	assert.Len(t, result, 2)
 	expectedCreated, err := time.Parse(time.RFC3339, "2011-04-14T16:00:49Z")
@@ -550,7 +1373,7 @@ func TestGitHubClient_ListPullRequestComments(t *testing.T) {
 		Created: expectedCreated,
 	}, result[0])
 
-	_, err = createBadGitHubClient(t).ListPullRequestComments(ctx, owner, repo1, 1)
+	_, err = createBadGitHubClient(t).ListPullRequestComments(owner, repo1, 1).All(ctx)
 	assert.Error(t, err)
 }
 
@@ -689,6 +1512,25 @@ func TestGitHubClient_GetModifiedFiles(t *testing.T) {
 	})
 }
 
+func TestGitHubClient_GetFileChanges(t *testing.T) {
+	ctx := context.Background()
+	response, err := os.ReadFile(filepath.Join("testdata", "github", "compare_commits.json"))
+	assert.NoError(t, err)
+	client, cleanUp := createServerAndClient(t, vcsutils.GitHub, false, response,
+		"/repos/jfrog/repo-1/compare/sha-1...sha-2?per_page=1", createGitHubHandler)
+	defer cleanUp()
+
+	fileChanges, err := client.GetFileChanges(ctx, owner, repo1, "sha-1", "sha-2")
+	require.NoError(t, err)
+	require.Len(t, fileChanges, 17)
+	assert.Equal(t, FileChange{Path: "README.md", Status: vcsutils.FileModified}, fileChanges[0])
+	assert.Contains(t, fileChanges, FileChange{Path: "vcsclient/testdata/github/repository_environment_response.json", Status: vcsutils.FileAdded})
+	assert.Contains(t, fileChanges, FileChange{Path: "vcsclient/vcsclient.go", PreviousPath: "vcsclient/vcsclient_old.go", Status: vcsutils.FileRenamed})
+
+	_, err = createBadGitHubClient(t).GetFileChanges(ctx, owner, repo1, "sha-1", "sha-2")
+	assert.Error(t, err)
+}
+
 func createBadGitHubClient(t *testing.T) VcsClient {
 	client, err := NewClientBuilder(vcsutils.GitHub).ApiEndpoint("https://bad^endpoint").Build()
 	require.NoError(t, err)
@@ -744,6 +1586,9 @@ func createGitHubWithPaginationHandler(t *testing.T, _ string, response []byte,
 		lastPage := int(math.Ceil(float64(count) / float64(pageSize)))
 		lastLink := fmt.Sprintf("<https://api.github.com/user/repos?page=%v>; rel=\"last\"", lastPage) //https://docs.github.com/en/rest/guides/traversing-with-pagination
 
+		if page < lastPage {
+			lastLink = fmt.Sprintf("<https://api.github.com/user/repos?page=%v>; rel=\"next\", ", page+1) + lastLink
+		}
 		writer.Header().Add(link, lastLink)
 		writer.WriteHeader(expectedStatusCode)
 
@@ -810,3 +1655,10 @@ func createGitHubSarifUploadHandler(t *testing.T, _ string, _ []byte, _ int) htt
 		}
 	}
 }
+
+func TestGetGitHubWebhookEvents(t *testing.T) {
+	assert.Equal(t, []string{"push", "pull_request"},
+		getGitHubWebhookEvents(vcsutils.Push, vcsutils.TagPushed, vcsutils.BranchDeleted, vcsutils.PrOpened, vcsutils.PrMerged))
+	assert.Equal(t, []string{"issue_comment", "pull_request_review_comment", "pull_request_review", "release"},
+		getGitHubWebhookEvents(vcsutils.PrCommentAdded, vcsutils.PrReviewSubmitted, vcsutils.PrApproved, vcsutils.ReleasePublished))
+}
@@ -6,9 +6,10 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
-	"github.com/jfrog/gofrog/datastructures"
 	"net/http"
-	"sort"
+	"net/url"
+	"path"
+	"path/filepath"
 	"strconv"
 	"strings"
 
@@ -51,39 +52,306 @@ func (client *GitLabClient) TestConnection(ctx context.Context) error {
 
 // ListRepositories on GitLab
 func (client *GitLabClient) ListRepositories(ctx context.Context) (map[string][]string, error) {
-	simple := true
+	listOptions := &gitlab.ListProjectsOptions{Simple: vcsutils.PointerOf(true), Membership: vcsutils.PointerOf(true)}
+	projects, err := client.listProjects(listOptions).All(ctx)
+	if err != nil {
+		return nil, err
+	}
 	results := make(map[string][]string)
-	membership := true
-	for pageID := 1; ; pageID++ {
-		options := &gitlab.ListProjectsOptions{ListOptions: gitlab.ListOptions{Page: pageID}, Simple: &simple, Membership: &membership}
-		projects, response, err := client.glClient.Projects.ListProjects(options, gitlab.WithContext(ctx))
+	for _, project := range projects {
+		owner := project.Namespace.Path
+		results[owner] = append(results[owner], project.Path)
+	}
+	return results, nil
+}
+
+// StreamRepositories on GitLab. The owner is the full path of the project namespace.
+func (client *GitLabClient) StreamRepositories(ctx context.Context, options ListRepositoriesOptions) <-chan RepositoryResult {
+	listOptions := &gitlab.ListProjectsOptions{
+		ListOptions: gitlab.ListOptions{PerPage: repositoriesPageSize},
+		Membership:  vcsutils.PointerOf(true),
+		Archived:    options.Archived,
+	}
+	if options.Visibility != nil {
+		listOptions.Visibility = vcsutils.PointerOf(getGitLabVisibilityValue(*options.Visibility))
+	}
+	if !options.UpdatedSince.IsZero() {
+		listOptions.LastActivityAfter = &options.UpdatedSince
+	}
+	if options.Search != "" {
+		listOptions.Search = &options.Search
+	}
+	projects := client.listProjects(listOptions)
+	if options.Owner != "" {
+		projects = client.listOwnerProjects(options.Owner, listOptions)
+	}
+	repositories := mapPager(projects, func(project *gitlab.Project) RepositoryListItem {
+		item := RepositoryListItem{Name: project.Path, RepositoryInfo: mapGitLabProjectToRepositoryInfo(project)}
+		if project.Namespace != nil {
+			item.Owner = project.Namespace.FullPath
+		}
+		return item
+	})
+	return streamRepositories(ctx, options, repositories)
+}
+
+// listProjects lists the projects. GitLab doesn't return the total number of pages for more than 10,000 projects, so the next page is used.
+func (client *GitLabClient) listProjects(listOptions *gitlab.ListProjectsOptions) *Pager[*gitlab.Project] {
+	return newNumberedPager(1, func(ctx context.Context, page int) ([]*gitlab.Project, int, error) {
+		listOptions.Page = page
+		projects, response, err := client.glClient.Projects.ListProjects(listOptions, gitlab.WithContext(ctx))
 		if err != nil {
-			return nil, err
+			return nil, 0, err
 		}
-		for _, project := range projects {
-			owner := project.Namespace.Path
-			results[owner] = append(results[owner], project.Path)
+		return projects, response.NextPage, nil
+	})
+}
+
+// listOwnerProjects lists the projects of a group or a user, by the filters of listOptions.
+// The subgroups of a group are not listed, and the last activity filter is applied by the caller.
+func (client *GitLabClient) listOwnerProjects(owner string, listOptions *gitlab.ListProjectsOptions) *Pager[*gitlab.Project] {
+	var isGroup *bool
+	return newNumberedPager(1, func(ctx context.Context, page int) ([]*gitlab.Project, int, error) {
+		if isGroup == nil {
+			namespace, _, err := client.glClient.Namespaces.GetNamespace(owner, gitlab.WithContext(ctx))
+			if err != nil {
+				return nil, 0, err
+			}
+			isGroup = vcsutils.PointerOf(namespace.Kind == "group")
 		}
-		if pageID >= response.TotalPages {
-			break
+		var projects []*gitlab.Project
+		var response *gitlab.Response
+		var err error
+		if *isGroup {
+			projects, response, err = client.glClient.Groups.ListGroupProjects(owner, &gitlab.ListGroupProjectsOptions{
+				ListOptions: gitlab.ListOptions{Page: page, PerPage: listOptions.PerPage},
+				Archived:    listOptions.Archived,
+				Search:      listOptions.Search,
+				Visibility:  listOptions.Visibility,
+			}, gitlab.WithContext(ctx))
+		} else {
+			userListOptions := *listOptions
+			userListOptions.Page = page
+			userListOptions.Membership = nil
+			projects, response, err = client.glClient.Projects.ListUserProjects(owner, &userListOptions, gitlab.WithContext(ctx))
 		}
-	}
-	return results, nil
+		if err != nil {
+			return nil, 0, err
+		}
+		return projects, response.NextPage, nil
+	})
 }
 
 // ListBranches on GitLab
-func (client *GitLabClient) ListBranches(ctx context.Context, owner, repository string) ([]string, error) {
-	branches, _, err := client.glClient.Branches.ListBranches(getProjectID(owner, repository), nil,
-		gitlab.WithContext(ctx))
+func (client *GitLabClient) ListBranches(owner, repository string) *Pager[string] {
+	return newNumberedPager(1, func(ctx context.Context, page int) ([]string, int, error) {
+		options := &gitlab.ListBranchesOptions{ListOptions: gitlab.ListOptions{Page: page}}
+		branches, response, err := client.glClient.Branches.ListBranches(getProjectID(owner, repository), options, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, 0, err
+		}
+		results := make([]string, 0, len(branches))
+		for _, branch := range branches {
+			results = append(results, branch.Name)
+		}
+		return results, response.NextPage, nil
+	})
+}
+
+// CreateBranch on GitLab
+func (client *GitLabClient) CreateBranch(ctx context.Context, owner, repository, branch, fromRef string) error {
+	err := validateParametersNotBlank(map[string]string{
+		"owner":      owner,
+		"repository": repository,
+		"branch":     branch,
+		"fromRef":    fromRef,
+	})
+	if err != nil {
+		return err
+	}
+	_, _, err = client.glClient.Branches.CreateBranch(getProjectID(owner, repository), &gitlab.CreateBranchOptions{
+		Branch: &branch,
+		Ref:    &fromRef,
+	}, gitlab.WithContext(ctx))
+	return err
+}
+
+// DeleteBranch on GitLab
+func (client *GitLabClient) DeleteBranch(ctx context.Context, owner, repository, branch string) error {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository, "branch": branch})
+	if err != nil {
+		return err
+	}
+	_, err = client.glClient.Branches.DeleteBranch(getProjectID(owner, repository), branch, gitlab.WithContext(ctx))
+	return err
+}
+
+// GetBranch on GitLab
+func (client *GitLabClient) GetBranch(ctx context.Context, owner, repository, branch string) (BranchDetails, error) {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository, "branch": branch})
+	if err != nil {
+		return BranchDetails{}, err
+	}
+	glBranch, _, err := client.glClient.Branches.GetBranch(getProjectID(owner, repository), branch, gitlab.WithContext(ctx))
+	if err != nil {
+		return BranchDetails{}, err
+	}
+	branchDetails := BranchDetails{Name: glBranch.Name, Protected: glBranch.Protected, Default: glBranch.Default}
+	if glBranch.Commit != nil {
+		branchDetails.CommitSHA = glBranch.Commit.ID
+	}
+	return branchDetails, nil
+}
+
+// CommitFiles on GitLab
+func (client *GitLabClient) CommitFiles(ctx context.Context, owner, repository, branch, message string, changes []CommitFileChange, author CommitAuthor) error {
+	err := validateParametersNotBlank(map[string]string{
+		"owner":      owner,
+		"repository": repository,
+		"branch":     branch,
+		"message":    message,
+	})
+	if err != nil {
+		return err
+	}
+	if err = validateCommitFileChanges(changes); err != nil {
+		return err
+	}
+	options := &gitlab.CreateCommitOptions{
+		Branch:        &branch,
+		CommitMessage: &message,
+		Actions:       make([]*gitlab.CommitActionOptions, 0, len(changes)),
+	}
+	for _, change := range changes {
+		options.Actions = append(options.Actions, mapCommitFileChangeToGitLabAction(change))
+	}
+	if author.Name != "" {
+		options.AuthorName = &author.Name
+	}
+	if author.Email != "" {
+		options.AuthorEmail = &author.Email
+	}
+	_, _, err = client.glClient.Commits.CreateCommit(getProjectID(owner, repository), options, gitlab.WithContext(ctx))
+	return err
+}
+
+// ListTags on GitLab
+func (client *GitLabClient) ListTags(owner, repository string) *Pager[TagInfo] {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository})
+	if err != nil {
+		return newErrorPager[TagInfo](err)
+	}
+	return newNumberedPager(1, func(ctx context.Context, page int) ([]TagInfo, int, error) {
+		options := &gitlab.ListTagsOptions{ListOptions: gitlab.ListOptions{Page: page}}
+		tags, response, err := client.glClient.Tags.ListTags(getProjectID(owner, repository), options, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, 0, err
+		}
+		results := make([]TagInfo, 0, len(tags))
+		for _, tag := range tags {
+			tagInfo := TagInfo{Name: tag.Name}
+			if tag.Commit != nil {
+				tagInfo.CommitSHA = tag.Commit.ID
+			}
+			results = append(results, tagInfo)
+		}
+		return results, response.NextPage, nil
+	})
+}
+
+// CreateTag on GitLab
+func (client *GitLabClient) CreateTag(ctx context.Context, owner, repository, tag, fromRef, message string) error {
+	err := validateParametersNotBlank(map[string]string{
+		"owner":      owner,
+		"repository": repository,
+		"tag":        tag,
+		"fromRef":    fromRef,
+	})
+	if err != nil {
+		return err
+	}
+	options := &gitlab.CreateTagOptions{TagName: &tag, Ref: &fromRef}
+	if message != "" {
+		options.Message = &message
+	}
+	_, _, err = client.glClient.Tags.CreateTag(getProjectID(owner, repository), options, gitlab.WithContext(ctx))
+	return err
+}
+
+// DeleteTag on GitLab
+func (client *GitLabClient) DeleteTag(ctx context.Context, owner, repository, tag string) error {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository, "tag": tag})
+	if err != nil {
+		return err
+	}
+	_, err = client.glClient.Tags.DeleteTag(getProjectID(owner, repository), tag, gitlab.WithContext(ctx))
+	return err
+}
+
+// CreateRelease on GitLab.
+// The assets are uploaded to the project, and linked to the release.
+func (client *GitLabClient) CreateRelease(ctx context.Context, owner, repository string, release CreateReleaseOptions) (ReleaseInfo, error) {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository, "tag name": release.TagName})
+	if err != nil {
+		return ReleaseInfo{}, err
+	}
+	options := &gitlab.CreateReleaseOptions{TagName: &release.TagName, Description: &release.Notes}
+	// Without a name, the tag name is used as the release name
+	if release.Name != "" {
+		options.Name = &release.Name
+	}
+	if release.TargetRef != "" {
+		options.Ref = &release.TargetRef
+	}
+	if len(release.AssetPaths) > 0 {
+		links, err := client.uploadReleaseAssets(ctx, owner, repository, release.AssetPaths)
+		if err != nil {
+			return ReleaseInfo{}, err
+		}
+		options.Assets = &gitlab.ReleaseAssets{Links: links}
+	}
+	glRelease, _, err := client.glClient.Releases.CreateRelease(getProjectID(owner, repository), options, gitlab.WithContext(ctx))
+	if err != nil {
+		return ReleaseInfo{}, err
+	}
+	return mapGitLabReleaseToReleaseInfo(glRelease), nil
+}
+
+func (client *GitLabClient) uploadReleaseAssets(ctx context.Context, owner, repository string, assetPaths []string) ([]*gitlab.ReleaseAssetLink, error) {
+	// The uploaded file URL is relative to the project web URL
+	project, _, err := client.glClient.Projects.GetProject(getProjectID(owner, repository), nil, gitlab.WithContext(ctx))
 	if err != nil {
 		return nil, err
 	}
+	links := make([]*gitlab.ReleaseAssetLink, 0, len(assetPaths))
+	for _, assetPath := range assetPaths {
+		file, _, err := client.glClient.Projects.UploadFile(getProjectID(owner, repository), assetPath, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+		links = append(links, &gitlab.ReleaseAssetLink{Name: filepath.Base(assetPath), URL: project.WebURL + file.URL})
+	}
+	return links, nil
+}
 
-	results := make([]string, 0, len(branches))
-	for _, branch := range branches {
-		results = append(results, branch.Name)
+// ListReleases on GitLab
+func (client *GitLabClient) ListReleases(owner, repository string) *Pager[ReleaseInfo] {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository})
+	if err != nil {
+		return newErrorPager[ReleaseInfo](err)
 	}
-	return results, nil
+	return newNumberedPager(1, func(ctx context.Context, page int) ([]ReleaseInfo, int, error) {
+		options := &gitlab.ListReleasesOptions{Page: page}
+		releases, response, err := client.glClient.Releases.ListReleases(getProjectID(owner, repository), options, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, 0, err
+		}
+		results := make([]ReleaseInfo, 0, len(releases))
+		for _, release := range releases {
+			results = append(results, mapGitLabReleaseToReleaseInfo(release))
+		}
+		return results, response.NextPage, nil
+	})
 }
 
 // AddSshKeyToRepository on GitLab
@@ -122,6 +390,9 @@ func (client *GitLabClient) CreateWebhook(ctx context.Context, owner, repository
 		MergeRequestsEvents:    &projectHook.MergeRequestsEvents,
 		PushEvents:             &projectHook.PushEvents,
 		PushEventsBranchFilter: &projectHook.PushEventsBranchFilter,
+		TagPushEvents:          &projectHook.TagPushEvents,
+		NoteEvents:             &projectHook.NoteEvents,
+		ReleasesEvents:         &projectHook.ReleasesEvents,
 	}
 	response, _, err := client.glClient.Projects.AddProjectHook(getProjectID(owner, repository), options,
 		gitlab.WithContext(ctx))
@@ -141,6 +412,9 @@ func (client *GitLabClient) UpdateWebhook(ctx context.Context, owner, repository
 		MergeRequestsEvents:    &projectHook.MergeRequestsEvents,
 		PushEvents:             &projectHook.PushEvents,
 		PushEventsBranchFilter: &projectHook.PushEventsBranchFilter,
+		TagPushEvents:          &projectHook.TagPushEvents,
+		NoteEvents:             &projectHook.NoteEvents,
+		ReleasesEvents:         &projectHook.ReleasesEvents,
 	}
 	intWebhook, err := strconv.Atoi(webhookID)
 	if err != nil {
@@ -162,21 +436,237 @@ func (client *GitLabClient) DeleteWebhook(ctx context.Context, owner, repository
 	return err
 }
 
-// SetCommitStatus on GitLab
-func (client *GitLabClient) SetCommitStatus(ctx context.Context, commitStatus CommitStatus, owner, repository, ref,
-	title, description, detailsURL string) error {
+// ListWebhooks on GitLab
+func (client *GitLabClient) ListWebhooks(owner, repository string) *Pager[WebhookInfo] {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository})
+	if err != nil {
+		return newErrorPager[WebhookInfo](err)
+	}
+	return newNumberedPager(1, func(ctx context.Context, page int) ([]WebhookInfo, int, error) {
+		hooks, response, err := client.glClient.Projects.ListProjectHooks(getProjectID(owner, repository),
+			&gitlab.ListProjectHooksOptions{Page: page}, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, 0, err
+		}
+		results := make([]WebhookInfo, 0, len(hooks))
+		for _, hook := range hooks {
+			results = append(results, mapGitLabProjectHookToWebhookInfo(hook))
+		}
+		return results, response.NextPage, nil
+	})
+}
+
+// GetWebhook on GitLab
+func (client *GitLabClient) GetWebhook(ctx context.Context, owner, repository, webhookID string) (WebhookInfo, error) {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository, "webhookID": webhookID})
+	if err != nil {
+		return WebhookInfo{}, err
+	}
+	intWebhook, err := strconv.Atoi(webhookID)
+	if err != nil {
+		return WebhookInfo{}, err
+	}
+	hook, _, err := client.glClient.Projects.GetProjectHook(getProjectID(owner, repository), intWebhook,
+		gitlab.WithContext(ctx))
+	if err != nil {
+		return WebhookInfo{}, err
+	}
+	return mapGitLabProjectHookToWebhookInfo(hook), nil
+}
+
+// PingWebhook on GitLab. GitLab sends a test push event to the payload URL.
+func (client *GitLabClient) PingWebhook(ctx context.Context, owner, repository, webhookID string) error {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository, "webhookID": webhookID})
+	if err != nil {
+		return err
+	}
+	intWebhook, err := strconv.Atoi(webhookID)
+	if err != nil {
+		return err
+	}
+	// The go-gitlab client doesn't support the project hook test endpoint
+	testPath := fmt.Sprintf("projects/%s/hooks/%d/test/push_events", url.PathEscape(getProjectID(owner, repository)), intWebhook)
+	request, err := client.glClient.NewRequest(http.MethodPost, testPath, nil, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		return err
+	}
+	_, err = client.glClient.Do(request, nil)
+	return err
+}
+
+// CreateOrganizationWebhook on GitLab. The webhook is created on the group and all its subgroups.
+func (client *GitLabClient) CreateOrganizationWebhook(ctx context.Context, owner, payloadURL string,
+	webhookEvents ...vcsutils.WebhookEvent) (string, string, error) {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "payloadURL": payloadURL})
+	if err != nil {
+		return "", "", err
+	}
+	token := vcsutils.CreateToken()
+	projectHook := createProjectHook("", payloadURL, webhookEvents...)
+	options := &gitlab.AddGroupHookOptions{
+		Token:               &token,
+		URL:                 &projectHook.URL,
+		MergeRequestsEvents: &projectHook.MergeRequestsEvents,
+		PushEvents:          &projectHook.PushEvents,
+		TagPushEvents:       &projectHook.TagPushEvents,
+		NoteEvents:          &projectHook.NoteEvents,
+		ReleasesEvents:      &projectHook.ReleasesEvents,
+	}
+	response, _, err := client.glClient.Groups.AddGroupHook(owner, options, gitlab.WithContext(ctx))
+	if err != nil {
+		return "", "", err
+	}
+	return strconv.Itoa(response.ID), token, nil
+}
+
+// UpdateOrganizationWebhook on GitLab
+func (client *GitLabClient) UpdateOrganizationWebhook(ctx context.Context, owner, payloadURL, token, webhookID string,
+	webhookEvents ...vcsutils.WebhookEvent) error {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "payloadURL": payloadURL, "webhookID": webhookID})
+	if err != nil {
+		return err
+	}
+	intWebhook, err := strconv.Atoi(webhookID)
+	if err != nil {
+		return err
+	}
+	projectHook := createProjectHook("", payloadURL, webhookEvents...)
+	options := &gitlab.EditGroupHookOptions{
+		Token:               &token,
+		URL:                 &projectHook.URL,
+		MergeRequestsEvents: &projectHook.MergeRequestsEvents,
+		PushEvents:          &projectHook.PushEvents,
+		TagPushEvents:       &projectHook.TagPushEvents,
+		NoteEvents:          &projectHook.NoteEvents,
+		ReleasesEvents:      &projectHook.ReleasesEvents,
+	}
+	_, _, err = client.glClient.Groups.EditGroupHook(owner, intWebhook, options, gitlab.WithContext(ctx))
+	return err
+}
+
+// DeleteOrganizationWebhook on GitLab
+func (client *GitLabClient) DeleteOrganizationWebhook(ctx context.Context, owner, webhookID string) error {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "webhookID": webhookID})
+	if err != nil {
+		return err
+	}
+	intWebhook, err := strconv.Atoi(webhookID)
+	if err != nil {
+		return err
+	}
+	_, err = client.glClient.Groups.DeleteGroupHook(owner, intWebhook, gitlab.WithContext(ctx))
+	return err
+}
+
+// ListOrganizationWebhooks on GitLab
+func (client *GitLabClient) ListOrganizationWebhooks(owner string) *Pager[WebhookInfo] {
+	err := validateParametersNotBlank(map[string]string{"owner": owner})
+	if err != nil {
+		return newErrorPager[WebhookInfo](err)
+	}
+	return newNumberedPager(1, func(ctx context.Context, page int) ([]WebhookInfo, int, error) {
+		// The go-gitlab client lists the group hooks without pagination
+		hooksPath := fmt.Sprintf("groups/%s/hooks", url.PathEscape(owner))
+		request, err := client.glClient.NewRequest(http.MethodGet, hooksPath, &gitlab.ListOptions{Page: page},
+			[]gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+		if err != nil {
+			return nil, 0, err
+		}
+		var hooks []*gitlab.GroupHook
+		response, err := client.glClient.Do(request, &hooks)
+		if err != nil {
+			return nil, 0, err
+		}
+		results := make([]WebhookInfo, 0, len(hooks))
+		for _, hook := range hooks {
+			results = append(results, mapGitLabProjectHookToWebhookInfo(&gitlab.ProjectHook{
+				ID:                  hook.ID,
+				URL:                 hook.URL,
+				PushEvents:          hook.PushEvents,
+				MergeRequestsEvents: hook.MergeRequestsEvents,
+				TagPushEvents:       hook.TagPushEvents,
+				NoteEvents:          hook.NoteEvents,
+				ReleasesEvents:      hook.ReleasesEvents,
+			}))
+		}
+		return results, response.NextPage, nil
+	})
+}
+
+// SetCommitStatus on GitLab. The key is the status name, and the build number is the ID of the pipeline of the status.
+func (client *GitLabClient) SetCommitStatus(ctx context.Context, owner, repository, ref string, status CommitStatusOptions) error {
 	options := &gitlab.SetCommitStatusOptions{
-		State:       gitlab.BuildStateValue(getGitLabCommitState(commitStatus)),
+		State:       gitlab.BuildStateValue(getGitLabCommitState(status.State)),
 		Ref:         &ref,
-		Name:        &title,
-		Description: &description,
-		TargetURL:   &detailsURL,
+		Name:        &status.Key,
+		Description: &status.Description,
+		TargetURL:   &status.DetailsURL,
+	}
+	if status.BuildNumber != "" {
+		pipelineID, err := strconv.Atoi(status.BuildNumber)
+		if err != nil {
+			return fmt.Errorf("the build number of a GitLab commit status must be a pipeline ID: %w", err)
+		}
+		options.PipelineID = &pipelineID
 	}
 	_, _, err := client.glClient.Commits.SetCommitStatus(getProjectID(owner, repository), ref, options,
 		gitlab.WithContext(ctx))
 	return err
 }
 
+// ListCommitStatuses on GitLab
+func (client *GitLabClient) ListCommitStatuses(owner, repository, ref string) *Pager[CommitStatusInfo] {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository, "ref": ref})
+	if err != nil {
+		return newErrorPager[CommitStatusInfo](err)
+	}
+	return newNumberedPager(1, func(ctx context.Context, page int) ([]CommitStatusInfo, int, error) {
+		statuses, response, err := client.glClient.Commits.GetCommitStatuses(getProjectID(owner, repository), ref,
+			&gitlab.GetCommitStatusesOptions{ListOptions: gitlab.ListOptions{Page: page}}, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, 0, err
+		}
+		results := make([]CommitStatusInfo, 0, len(statuses))
+		for _, status := range statuses {
+			results = append(results, mapGitLabCommitStatusToCommitStatusInfo(status))
+		}
+		return results, response.NextPage, nil
+	})
+}
+
+// CreateCheckRun on GitLab. The check run is reported as a commit status, which doesn't show the annotations.
+func (client *GitLabClient) CreateCheckRun(ctx context.Context, owner, repository string, checkRun CheckRunOptions) (string, error) {
+	err := validateParametersNotBlank(map[string]string{
+		"owner":              owner,
+		"repository":         repository,
+		"checkRun.Name":      checkRun.Name,
+		"checkRun.CommitSHA": checkRun.CommitSHA,
+	})
+	if err != nil {
+		return "", err
+	}
+	err = client.SetCommitStatus(ctx, owner, repository, checkRun.CommitSHA, CommitStatusOptions{
+		State:       checkRun.State,
+		Key:         checkRun.Name,
+		Description: checkRun.Title,
+		DetailsURL:  checkRun.DetailsURL,
+	})
+	return checkRun.Name, err
+}
+
+// UpdateCheckRun on GitLab. The commit status named by the check run ID is set.
+func (client *GitLabClient) UpdateCheckRun(ctx context.Context, owner, repository, checkRunID string, checkRun CheckRunOptions) error {
+	checkRun.Name = checkRunID
+	_, err := client.CreateCheckRun(ctx, owner, repository, checkRun)
+	return err
+}
+
+// GetCombinedCommitStatus on GitLab.
+// The statuses include the jobs of the pipelines which ran on the commit.
+func (client *GitLabClient) GetCombinedCommitStatus(ctx context.Context, owner, repository, ref string) (CombinedCommitStatus, error) {
+	return getCombinedCommitStatus(ctx, client.ListCommitStatuses(owner, repository, ref))
+}
+
 // DownloadRepository on GitLab
 func (client *GitLabClient) DownloadRepository(ctx context.Context, owner, repository, branch, localPath string) error {
 	format := "tar.gz"
@@ -214,20 +704,25 @@ func (client *GitLabClient) CreatePullRequest(ctx context.Context, owner, reposi
 }
 
 // ListOpenPullRequests on GitLab
-func (client *GitLabClient) ListOpenPullRequests(ctx context.Context, _, repository string) ([]PullRequestInfo, error) {
-	openState := "open"
-	allScope := "all"
-	options := &gitlab.ListMergeRequestsOptions{
-		State: &openState,
-		Scope: &allScope,
-	}
-	client.logger.Debug("fetching open pull requests in", repository)
-	mergeRequests, _, err := client.glClient.MergeRequests.ListMergeRequests(options,
-		gitlab.WithContext(ctx))
+func (client *GitLabClient) ListOpenPullRequests(owner, repository string) *Pager[PullRequestInfo] {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository})
 	if err != nil {
-		return []PullRequestInfo{}, err
+		return newErrorPager[PullRequestInfo](err)
 	}
-	return mapGitLabMergeRequestToPullRequestInfoList(mergeRequests), nil
+	return newNumberedPager(1, func(ctx context.Context, page int) ([]PullRequestInfo, int, error) {
+		openState := "open"
+		options := &gitlab.ListProjectMergeRequestsOptions{
+			ListOptions: gitlab.ListOptions{Page: page},
+			State:       &openState,
+		}
+		client.logger.Debug("fetching open pull requests in", repository)
+		mergeRequests, response, err := client.glClient.MergeRequests.ListProjectMergeRequests(getProjectID(owner, repository), options,
+			gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, 0, err
+		}
+		return mapGitLabMergeRequestToPullRequestInfoList(mergeRequests), response.NextPage, nil
+	})
 }
 
 // AddPullRequestComment on GitLab
@@ -246,17 +741,152 @@ func (client *GitLabClient) AddPullRequestComment(ctx context.Context, owner, re
 }
 
 // ListPullRequestComments on GitLab
-func (client *GitLabClient) ListPullRequestComments(ctx context.Context, owner, repository string, pullRequestID int) ([]CommentInfo, error) {
+func (client *GitLabClient) ListPullRequestComments(owner, repository string, pullRequestID int) *Pager[CommentInfo] {
 	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository})
 	if err != nil {
-		return []CommentInfo{}, err
+		return newErrorPager[CommentInfo](err)
 	}
-	commentsList, _, err := client.glClient.Notes.ListMergeRequestNotes(getProjectID(owner, repository), pullRequestID, &gitlab.ListMergeRequestNotesOptions{},
+	return newNumberedPager(1, func(ctx context.Context, page int) ([]CommentInfo, int, error) {
+		options := &gitlab.ListMergeRequestNotesOptions{ListOptions: gitlab.ListOptions{Page: page}}
+		commentsList, response, err := client.glClient.Notes.ListMergeRequestNotes(getProjectID(owner, repository), pullRequestID, options,
+			gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, 0, err
+		}
+		return mapGitLabNotesToCommentInfoList(commentsList), response.NextPage, nil
+	})
+}
+
+// EditPullRequestComment on GitLab
+func (client *GitLabClient) EditPullRequestComment(ctx context.Context, owner, repository string, pullRequestID int, commentID int64, content string) error {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository, "content": content})
+	if err != nil {
+		return err
+	}
+	options := &gitlab.UpdateMergeRequestNoteOptions{
+		Body: &content,
+	}
+	_, _, err = client.glClient.Notes.UpdateMergeRequestNote(getProjectID(owner, repository), pullRequestID, int(commentID), options,
 		gitlab.WithContext(ctx))
+	return err
+}
+
+// DeletePullRequestComment on GitLab
+func (client *GitLabClient) DeletePullRequestComment(ctx context.Context, owner, repository string, pullRequestID int, commentID int64) error {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository})
+	if err != nil {
+		return err
+	}
+	_, err = client.glClient.Notes.DeleteMergeRequestNote(getProjectID(owner, repository), pullRequestID, int(commentID),
+		gitlab.WithContext(ctx))
+	return err
+}
+
+// AddPullRequestReviewComments on GitLab
+func (client *GitLabClient) AddPullRequestReviewComments(ctx context.Context, owner, repository string, pullRequestID int, comments []ReviewComment) error {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository})
+	if err != nil {
+		return err
+	}
+	if err = validateReviewComments(comments); err != nil {
+		return err
+	}
+	projectID := getProjectID(owner, repository)
+	// The diff refs of the merge request are required to position the comments on its latest version
+	mergeRequest, _, err := client.glClient.MergeRequests.GetMergeRequest(projectID, pullRequestID,
+		&gitlab.GetMergeRequestsOptions{}, gitlab.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	for _, comment := range comments {
+		options := &gitlab.CreateMergeRequestDiscussionOptions{
+			Body:     vcsutils.PointerOf(comment.Content),
+			Position: createGitLabNotePosition(mergeRequest, comment),
+		}
+		_, _, err = client.glClient.Discussions.CreateMergeRequestDiscussion(projectID, pullRequestID, options,
+			gitlab.WithContext(ctx))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ListPullRequestReviewComments on GitLab
+func (client *GitLabClient) ListPullRequestReviewComments(owner, repository string, pullRequestID int) *Pager[ReviewCommentInfo] {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository})
+	if err != nil {
+		return newErrorPager[ReviewCommentInfo](err)
+	}
+	return newNumberedPager(1, func(ctx context.Context, page int) ([]ReviewCommentInfo, int, error) {
+		options := &gitlab.ListMergeRequestDiscussionsOptions{Page: page}
+		discussions, response, err := client.glClient.Discussions.ListMergeRequestDiscussions(getProjectID(owner, repository),
+			pullRequestID, options, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, 0, err
+		}
+		var results []ReviewCommentInfo
+		for _, discussion := range discussions {
+			for _, note := range discussion.Notes {
+				// Only the notes positioned on the diff are review comments
+				if note.Position != nil {
+					results = append(results, mapGitLabNoteToReviewCommentInfo(note))
+				}
+			}
+		}
+		return results, response.NextPage, nil
+	})
+}
+
+// ListPullRequestFiles on GitLab
+func (client *GitLabClient) ListPullRequestFiles(owner, repository string, pullRequestID int) *Pager[vcsutils.FileDiff] {
+	// The merge request changes are returned in a single response
+	return newSinglePagePager(func(ctx context.Context) ([]vcsutils.FileDiff, error) {
+		fileDiffs, err := client.GetPullRequestDiff(ctx, owner, repository, pullRequestID)
+		if err != nil {
+			return nil, err
+		}
+		// The numbers of added and removed lines are available only by parsing the diff
+		for i := range fileDiffs {
+			fileDiffs[i].Hunks = nil
+		}
+		return fileDiffs, nil
+	})
+}
+
+// GetPullRequestDiff on GitLab
+func (client *GitLabClient) GetPullRequestDiff(ctx context.Context, owner, repository string, pullRequestID int) ([]vcsutils.FileDiff, error) {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository})
+	if err != nil {
+		return nil, err
+	}
+	mergeRequest, _, err := client.glClient.MergeRequests.GetMergeRequestChanges(getProjectID(owner, repository), pullRequestID,
+		&gitlab.GetMergeRequestChangesOptions{}, gitlab.WithContext(ctx))
 	if err != nil {
-		return []CommentInfo{}, err
+		return nil, err
+	}
+
+	results := make([]vcsutils.FileDiff, 0, len(mergeRequest.Changes))
+	for _, change := range mergeRequest.Changes {
+		fileDiff := vcsutils.FileDiff{Path: change.NewPath, Status: vcsutils.FileModified}
+		switch {
+		case change.NewFile:
+			fileDiff.Status = vcsutils.FileAdded
+		case change.DeletedFile:
+			fileDiff.Status = vcsutils.FileRemoved
+			fileDiff.Path = change.OldPath
+		case change.RenamedFile:
+			fileDiff.Status = vcsutils.FileRenamed
+			fileDiff.PreviousPath = change.OldPath
+		}
+		fileDiff.Binary = strings.HasPrefix(change.Diff, "Binary files")
+		if fileDiff.Hunks, err = vcsutils.ParseDiffHunks(change.Diff); err != nil {
+			return nil, err
+		}
+		fileDiff.Additions, fileDiff.Deletions = vcsutils.CountDiffChanges(fileDiff.Hunks)
+		results = append(results, fileDiff)
 	}
-	return mapGitLabNotesToCommentInfoList(commentsList), nil
+	return results, nil
 }
 
 // GetLatestCommit on GitLab
@@ -296,12 +926,81 @@ func (client *GitLabClient) GetRepositoryInfo(ctx context.Context, owner, reposi
 		return RepositoryInfo{}, err
 	}
 
-	project, _, err := client.glClient.Projects.GetProject(getProjectID(owner, repository), nil, gitlab.WithContext(ctx))
+	projectID := getProjectID(owner, repository)
+	options := &gitlab.GetProjectOptions{Statistics: vcsutils.PointerOf(true)}
+	project, _, err := client.glClient.Projects.GetProject(projectID, options, gitlab.WithContext(ctx))
 	if err != nil {
 		return RepositoryInfo{}, err
 	}
+	repositoryInfo := mapGitLabProjectToRepositoryInfo(project)
 
-	return RepositoryInfo{RepositoryVisibility: getGitLabProjectVisibility(project), CloneInfo: CloneInfo{HTTP: project.HTTPURLToRepo, SSH: project.SSHURLToRepo}}, nil
+	// The project response doesn't include the languages
+	languages, _, err := client.glClient.Projects.GetProjectLanguages(projectID, gitlab.WithContext(ctx))
+	if err != nil {
+		return RepositoryInfo{}, err
+	}
+	repositoryInfo.Language = getGitLabPrimaryLanguage(languages)
+	return repositoryInfo, nil
+}
+
+// CreateRepository on GitLab
+func (client *GitLabClient) CreateRepository(ctx context.Context, owner string, options CreateRepositoryOptions) error {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository name": options.Name})
+	if err != nil {
+		return err
+	}
+	// The owner is either a user or a group, and both have a namespace
+	namespace, _, err := client.glClient.Namespaces.GetNamespace(owner, gitlab.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	projectOptions := &gitlab.CreateProjectOptions{
+		Name:                 &options.Name,
+		Path:                 &options.Name,
+		NamespaceID:          &namespace.ID,
+		Description:          &options.Description,
+		Visibility:           vcsutils.PointerOf(getGitLabVisibilityValue(options.Visibility)),
+		InitializeWithReadme: &options.InitReadme,
+	}
+	if options.DefaultBranch != "" {
+		projectOptions.DefaultBranch = &options.DefaultBranch
+	}
+	_, _, err = client.glClient.Projects.CreateProject(projectOptions, gitlab.WithContext(ctx))
+	return err
+}
+
+// ForkRepository on GitLab
+func (client *GitLabClient) ForkRepository(ctx context.Context, owner, repository, targetOwner string) error {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository})
+	if err != nil {
+		return err
+	}
+	forkOptions := &gitlab.ForkProjectOptions{}
+	if targetOwner != "" {
+		forkOptions.Namespace = &targetOwner
+	}
+	_, _, err = client.glClient.Projects.ForkProject(getProjectID(owner, repository), forkOptions, gitlab.WithContext(ctx))
+	return err
+}
+
+// ArchiveRepository on GitLab
+func (client *GitLabClient) ArchiveRepository(ctx context.Context, owner, repository string) error {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository})
+	if err != nil {
+		return err
+	}
+	_, _, err = client.glClient.Projects.ArchiveProject(getProjectID(owner, repository), gitlab.WithContext(ctx))
+	return err
+}
+
+// DeleteRepository on GitLab
+func (client *GitLabClient) DeleteRepository(ctx context.Context, owner, repository string) error {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository})
+	if err != nil {
+		return err
+	}
+	_, err = client.glClient.Projects.DeleteProject(getProjectID(owner, repository), gitlab.WithContext(ctx))
+	return err
 }
 
 // GetCommitBySha on GitLab
@@ -319,7 +1018,57 @@ func (client *GitLabClient) GetCommitBySha(ctx context.Context, owner, repositor
 	if err != nil {
 		return CommitInfo{}, err
 	}
-	return mapGitLabCommitToCommitInfo(commit), nil
+	commitInfo := mapGitLabCommitToCommitInfo(commit)
+	commitInfo.Verification, err = client.getCommitVerification(ctx, owner, repository, sha)
+	return commitInfo, err
+}
+
+// getCommitVerification returns the verification of the commit signature, which is returned by GitLab in a separate request
+func (client *GitLabClient) getCommitVerification(ctx context.Context, owner, repository, sha string) (*CommitVerification, error) {
+	signature, response, err := client.glClient.Commits.GetGPGSiganature(getProjectID(owner, repository), sha, gitlab.WithContext(ctx))
+	if err != nil {
+		// The signature of an unsigned commit isn't found
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			return &CommitVerification{Reason: "unsigned"}, nil
+		}
+		return nil, err
+	}
+	return &CommitVerification{Verified: signature.VerificationStatus == "verified", Reason: signature.VerificationStatus}, nil
+}
+
+// ListCommits on GitLab. The commits aren't filtered by the author on GitLab, so they are filtered after listing.
+func (client *GitLabClient) ListCommits(owner, repository string, options ListCommitsOptions) *Pager[CommitInfo] {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository})
+	if err != nil {
+		return newErrorPager[CommitInfo](err)
+	}
+	listOptions := &gitlab.ListCommitsOptions{WithStats: vcsutils.PointerOf(true), ListOptions: gitlab.ListOptions{PerPage: options.PageSize}}
+	if options.Ref != "" {
+		listOptions.RefName = &options.Ref
+	}
+	if options.Path != "" {
+		listOptions.Path = &options.Path
+	}
+	if !options.Since.IsZero() {
+		listOptions.Since = &options.Since
+	}
+	if !options.Until.IsZero() {
+		listOptions.Until = &options.Until
+	}
+	return newNumberedPager(1, func(ctx context.Context, page int) ([]CommitInfo, int, error) {
+		listOptions.Page = page
+		commits, response, err := client.glClient.Commits.ListCommits(getProjectID(owner, repository), listOptions, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, 0, err
+		}
+		results := make([]CommitInfo, 0, len(commits))
+		for _, commit := range commits {
+			if options.matchesCommit(commit.AuthorName, commit.AuthorEmail, vcsutils.DefaultIfNotNil(commit.CommittedDate)) {
+				results = append(results, mapGitLabCommitToCommitInfo(commit))
+			}
+		}
+		return results, response.NextPage, nil
+	})
 }
 
 // CreateLabel on GitLab
@@ -352,30 +1101,93 @@ func (client *GitLabClient) GetLabel(ctx context.Context, owner, repository, nam
 
 	for _, label := range labels {
 		if label.Name == name {
-			return &LabelInfo{
-				Name:        label.Name,
-				Description: label.Description,
-				Color:       strings.TrimPrefix(label.Color, "#"),
-			}, err
+			labelInfo := mapGitLabLabelToLabelInfo(label)
+			return &labelInfo, err
 		}
 	}
 
 	return nil, nil
 }
 
-// ListPullRequestLabels on GitLab
-func (client *GitLabClient) ListPullRequestLabels(ctx context.Context, owner, repository string, pullRequestID int) ([]string, error) {
+// ListLabels on GitLab
+func (client *GitLabClient) ListLabels(owner, repository string) *Pager[LabelInfo] {
 	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository})
 	if err != nil {
-		return []string{}, err
+		return newErrorPager[LabelInfo](err)
 	}
-	mergeRequest, _, err := client.glClient.MergeRequests.GetMergeRequest(getProjectID(owner, repository), pullRequestID,
-		&gitlab.GetMergeRequestsOptions{}, gitlab.WithContext(ctx))
+	return newNumberedPager(1, func(ctx context.Context, page int) ([]LabelInfo, int, error) {
+		options := &gitlab.ListLabelsOptions{ListOptions: gitlab.ListOptions{Page: page}}
+		labels, response, err := client.glClient.Labels.ListLabels(getProjectID(owner, repository), options, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, 0, err
+		}
+		results := make([]LabelInfo, 0, len(labels))
+		for _, label := range labels {
+			results = append(results, mapGitLabLabelToLabelInfo(label))
+		}
+		return results, response.NextPage, nil
+	})
+}
+
+// UpdateLabel on GitLab
+func (client *GitLabClient) UpdateLabel(ctx context.Context, owner, repository, name string, labelInfo LabelInfo) error {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository, "name": name, "LabelInfo.name": labelInfo.Name})
 	if err != nil {
-		return []string{}, err
+		return err
 	}
+	options := &gitlab.UpdateLabelOptions{
+		Name:        &name,
+		Description: &labelInfo.Description,
+		Color:       &labelInfo.Color,
+	}
+	if labelInfo.Name != name {
+		options.NewName = &labelInfo.Name
+	}
+	_, _, err = client.glClient.Labels.UpdateLabel(getProjectID(owner, repository), options, gitlab.WithContext(ctx))
+	return err
+}
 
-	return mergeRequest.Labels, nil
+// DeleteLabel on GitLab
+func (client *GitLabClient) DeleteLabel(ctx context.Context, owner, repository, name string) error {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository, "name": name})
+	if err != nil {
+		return err
+	}
+	_, err = client.glClient.Labels.DeleteLabel(getProjectID(owner, repository), &gitlab.DeleteLabelOptions{Name: &name},
+		gitlab.WithContext(ctx))
+	return err
+}
+
+// AddPullRequestLabels on GitLab
+func (client *GitLabClient) AddPullRequestLabels(ctx context.Context, owner, repository string, pullRequestID int, labels ...string) error {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository})
+	if err != nil {
+		return err
+	}
+	if len(labels) == 0 {
+		return nil
+	}
+	_, _, err = client.glClient.MergeRequests.UpdateMergeRequest(getProjectID(owner, repository), pullRequestID, &gitlab.UpdateMergeRequestOptions{
+		AddLabels: labels,
+	}, gitlab.WithContext(ctx))
+	return err
+}
+
+// ListPullRequestLabels on GitLab
+func (client *GitLabClient) ListPullRequestLabels(owner, repository string, pullRequestID int) *Pager[string] {
+	err := validateParametersNotBlank(map[string]string{"owner": owner, "repository": repository})
+	if err != nil {
+		return newErrorPager[string](err)
+	}
+	// The labels are returned in the merge request
+	return newSinglePagePager(func(ctx context.Context) ([]string, error) {
+		mergeRequest, _, err := client.glClient.MergeRequests.GetMergeRequest(getProjectID(owner, repository), pullRequestID,
+			&gitlab.GetMergeRequestsOptions{}, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+		return mergeRequest.Labels, nil
+	})
 }
 
 // UnlabelPullRequest on GitLab
@@ -418,7 +1230,16 @@ func (client *GitLabClient) DownloadFileFromRepo(_ context.Context, owner, repos
 	return content, response.StatusCode, err
 }
 
-func (client *GitLabClient) GetModifiedFiles(_ context.Context, owner, repository, refBefore, refAfter string) ([]string, error) {
+func (client *GitLabClient) GetModifiedFiles(ctx context.Context, owner, repository, refBefore, refAfter string) ([]string, error) {
+	fileChanges, err := client.GetFileChanges(ctx, owner, repository, refBefore, refAfter)
+	if err != nil {
+		return nil, err
+	}
+	return getFileChangesPaths(fileChanges), nil
+}
+
+// GetFileChanges on GitLab
+func (client *GitLabClient) GetFileChanges(ctx context.Context, owner, repository, refBefore, refAfter string) ([]FileChange, error) {
 	if err := validateParametersNotBlank(map[string]string{
 		"owner":      owner,
 		"repository": repository,
@@ -433,20 +1254,29 @@ func (client *GitLabClient) GetModifiedFiles(_ context.Context, owner, repositor
 	compare, _, err := client.glClient.Repositories.Compare(
 		getProjectID(owner, repository),
 		&gitlab.CompareOptions{From: &refBefore, To: &refAfter},
+		gitlab.WithContext(ctx),
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	fileNamesSet := datastructures.MakeSet[string]()
+	fileChanges := make([]FileChange, 0, len(compare.Diffs))
 	for _, diff := range compare.Diffs {
-		fileNamesSet.Add(diff.NewPath)
-		fileNamesSet.Add(diff.OldPath)
+		fileChange := FileChange{Path: diff.NewPath, Status: vcsutils.FileModified}
+		switch {
+		case diff.NewFile:
+			fileChange.Status = vcsutils.FileAdded
+		case diff.DeletedFile:
+			fileChange.Status = vcsutils.FileRemoved
+			fileChange.Path = diff.OldPath
+		case diff.RenamedFile || diff.OldPath != diff.NewPath:
+			fileChange.Status = vcsutils.FileRenamed
+			fileChange.PreviousPath = diff.OldPath
+		}
+		fileChanges = append(fileChanges, fileChange)
 	}
-	_ = fileNamesSet.Remove("") // Make sure there are no blank filepath.
-	fileNamesList := fileNamesSet.ToSlice()
-	sort.Strings(fileNamesList)
-	return fileNamesList, nil
+	sortFileChanges(fileChanges)
+	return fileChanges, nil
 }
 
 func getProjectID(owner, project string) string {
@@ -457,16 +1287,86 @@ func createProjectHook(branch string, payloadURL string, webhookEvents ...vcsuti
 	options := &gitlab.ProjectHook{URL: payloadURL}
 	for _, webhookEvent := range webhookEvents {
 		switch webhookEvent {
-		case vcsutils.PrOpened, vcsutils.PrEdited, vcsutils.PrRejected, vcsutils.PrMerged:
+		case vcsutils.PrOpened, vcsutils.PrEdited, vcsutils.PrRejected, vcsutils.PrMerged, vcsutils.PrApproved:
 			options.MergeRequestsEvents = true
 		case vcsutils.Push:
 			options.PushEvents = true
 			options.PushEventsBranchFilter = branch
+		case vcsutils.BranchCreated, vcsutils.BranchDeleted:
+			options.PushEvents = true
+		case vcsutils.TagPushed, vcsutils.TagDeleted:
+			options.TagPushEvents = true
+		// GitLab has no pull request reviews, so the comments of a review are delivered as comment events
+		case vcsutils.PrCommentAdded, vcsutils.PrReviewSubmitted:
+			options.NoteEvents = true
+		case vcsutils.ReleasePublished:
+			options.ReleasesEvents = true
 		}
 	}
 	return options
 }
 
+func mapGitLabProjectHookToWebhookInfo(hook *gitlab.ProjectHook) WebhookInfo {
+	var events []vcsutils.WebhookEvent
+	if hook.MergeRequestsEvents {
+		events = append(events, vcsutils.PrOpened, vcsutils.PrEdited, vcsutils.PrMerged, vcsutils.PrRejected, vcsutils.PrApproved)
+	}
+	if hook.PushEvents {
+		events = append(events, vcsutils.Push, vcsutils.BranchCreated, vcsutils.BranchDeleted)
+	}
+	if hook.TagPushEvents {
+		events = append(events, vcsutils.TagPushed, vcsutils.TagDeleted)
+	}
+	if hook.NoteEvents {
+		events = append(events, vcsutils.PrCommentAdded, vcsutils.PrReviewSubmitted)
+	}
+	if hook.ReleasesEvents {
+		events = append(events, vcsutils.ReleasePublished)
+	}
+	// GitLab has no inactive project hooks, and doesn't return the last delivery in the project hook
+	return WebhookInfo{
+		ID:         strconv.Itoa(hook.ID),
+		PayloadURL: hook.URL,
+		Events:     events,
+		Active:     true,
+	}
+}
+
+// GitLab positions a comment on a single line, so a comment on a range of lines is positioned on its last line
+func createGitLabNotePosition(mergeRequest *gitlab.MergeRequest, comment ReviewComment) *gitlab.NotePosition {
+	position := &gitlab.NotePosition{
+		BaseSHA:      mergeRequest.DiffRefs.BaseSha,
+		StartSHA:     mergeRequest.DiffRefs.StartSha,
+		HeadSHA:      mergeRequest.DiffRefs.HeadSha,
+		PositionType: "text",
+		NewPath:      comment.Path,
+		OldPath:      comment.Path,
+	}
+	if comment.Side == LeftSide {
+		position.OldLine = comment.Line
+	} else {
+		position.NewLine = comment.Line
+	}
+	return position
+}
+
+func mapGitLabNoteToReviewCommentInfo(note *gitlab.Note) ReviewCommentInfo {
+	reviewComment := ReviewCommentInfo{
+		ID:      int64(note.ID),
+		Content: note.Body,
+		Created: vcsutils.DefaultIfNotNil(note.CreatedAt),
+		Path:    note.Position.NewPath,
+		Line:    note.Position.NewLine,
+		Side:    RightSide,
+	}
+	if note.Position.NewLine == 0 {
+		reviewComment.Path = note.Position.OldPath
+		reviewComment.Line = note.Position.OldLine
+		reviewComment.Side = LeftSide
+	}
+	return reviewComment
+}
+
 func getGitLabProjectVisibility(project *gitlab.Project) RepositoryVisibility {
 	switch project.Visibility {
 	case gitlab.PublicVisibility:
@@ -478,6 +1378,17 @@ func getGitLabProjectVisibility(project *gitlab.Project) RepositoryVisibility {
 	}
 }
 
+func getGitLabVisibilityValue(visibility RepositoryVisibility) gitlab.VisibilityValue {
+	switch visibility {
+	case Public:
+		return gitlab.PublicVisibility
+	case Internal:
+		return gitlab.InternalVisibility
+	default:
+		return gitlab.PrivateVisibility
+	}
+}
+
 func getGitLabCommitState(commitState CommitStatus) string {
 	switch commitState {
 	case Pass:
@@ -488,20 +1399,163 @@ func getGitLabCommitState(commitState CommitStatus) string {
 		return "failed"
 	case InProgress:
 		return "running"
+	case Skipped:
+		return "skipped"
+	case Cancelled:
+		return "canceled"
 	}
 	return ""
 }
 
+func mapGitLabCommitStatusToCommitStatusInfo(status *gitlab.CommitStatus) CommitStatusInfo {
+	lastUpdatedAt := status.CreatedAt
+	if status.FinishedAt != nil {
+		lastUpdatedAt = status.FinishedAt
+	} else if status.StartedAt != nil {
+		lastUpdatedAt = status.StartedAt
+	}
+	return CommitStatusInfo{
+		State:         mapGitLabCommitState(status.Status, status.AllowFailure),
+		Context:       status.Name,
+		Description:   status.Description,
+		DetailsURL:    status.TargetURL,
+		CreatedAt:     vcsutils.DefaultIfNotNil(status.CreatedAt),
+		LastUpdatedAt: vcsutils.DefaultIfNotNil(lastUpdatedAt),
+	}
+}
+
+// mapGitLabCommitState maps the status of a GitLab job or external status.
+// Failed jobs which are allowed to fail don't fail the pipeline, and therefore they pass.
+func mapGitLabCommitState(status string, allowFailure bool) CommitStatus {
+	switch status {
+	case "success":
+		return Pass
+	case "failed":
+		if allowFailure {
+			return Pass
+		}
+		return Fail
+	case "skipped":
+		return Skipped
+	case "canceled":
+		return Cancelled
+	}
+	return InProgress
+}
+
+func mapCommitFileChangeToGitLabAction(change CommitFileChange) *gitlab.CommitActionOptions {
+	action := &gitlab.CommitActionOptions{
+		Action:   gitlab.FileAction(getGitLabFileAction(change.Action)),
+		FilePath: vcsutils.PointerOf(change.Path),
+	}
+	if change.Action == DeleteFile {
+		return action
+	}
+	if change.Action == MoveFile {
+		action.PreviousPath = vcsutils.PointerOf(change.PreviousPath)
+	}
+	action.Content = vcsutils.PointerOf(encodeFileContent(change.Content))
+	action.Encoding = vcsutils.PointerOf("base64")
+	return action
+}
+
+func getGitLabFileAction(action CommitFileAction) gitlab.FileActionValue {
+	switch action {
+	case UpdateFile:
+		return gitlab.FileUpdate
+	case DeleteFile:
+		return gitlab.FileDelete
+	case MoveFile:
+		return gitlab.FileMove
+	}
+	return gitlab.FileCreate
+}
+
+func mapGitLabReleaseToReleaseInfo(release *gitlab.Release) ReleaseInfo {
+	releaseInfo := ReleaseInfo{
+		TagName:   release.TagName,
+		Name:      release.Name,
+		Notes:     release.Description,
+		CreatedAt: vcsutils.DefaultIfNotNil(release.CreatedAt),
+	}
+	for _, link := range release.Assets.Links {
+		releaseInfo.Assets = append(releaseInfo.Assets, ReleaseAsset{Name: link.Name, URL: link.URL})
+	}
+	return releaseInfo
+}
+
+func mapGitLabProjectToRepositoryInfo(project *gitlab.Project) RepositoryInfo {
+	repositoryInfo := RepositoryInfo{
+		RepositoryVisibility: getGitLabProjectVisibility(project),
+		CloneInfo:            CloneInfo{HTTP: project.HTTPURLToRepo, SSH: project.SSHURLToRepo},
+		DefaultBranch:        project.DefaultBranch,
+		Description:          project.Description,
+		WebURL:               project.WebURL,
+		Topics:               project.Topics,
+		Archived:             project.Archived,
+		Fork:                 project.ForkedFromProject != nil,
+		CreatedAt:            vcsutils.DefaultIfNotNil(project.CreatedAt),
+		// GitLab doesn't return the time of the last push, the last activity includes pushes
+		LastPushedAt: vcsutils.DefaultIfNotNil(project.LastActivityAt),
+	}
+	// Older GitLab versions return the topics as tags
+	if len(repositoryInfo.Topics) == 0 {
+		repositoryInfo.Topics = project.TagList
+	}
+	if project.ForkedFromProject != nil {
+		repositoryInfo.Parent = &RepositoryReference{
+			Owner: path.Dir(project.ForkedFromProject.PathWithNamespace),
+			Name:  project.ForkedFromProject.Path,
+		}
+	}
+	if project.Statistics != nil {
+		repositoryInfo.Size = project.Statistics.RepositorySize
+	}
+	return repositoryInfo
+}
+
+func getGitLabPrimaryLanguage(languages *gitlab.ProjectLanguages) string {
+	var language string
+	var maxPercentage float32
+	if languages == nil {
+		return language
+	}
+	for name, percentage := range *languages {
+		// Compare the names on equal percentages to return the same language in every call
+		if percentage > maxPercentage || (percentage == maxPercentage && name < language) {
+			language, maxPercentage = name, percentage
+		}
+	}
+	return language
+}
+
+func mapGitLabLabelToLabelInfo(label *gitlab.Label) LabelInfo {
+	return LabelInfo{
+		Name:        label.Name,
+		Description: label.Description,
+		Color:       strings.TrimPrefix(label.Color, "#"),
+	}
+}
+
 func mapGitLabCommitToCommitInfo(commit *gitlab.Commit) CommitInfo {
-	return CommitInfo{
-		Hash:          commit.ID,
-		AuthorName:    commit.AuthorName,
-		CommitterName: commit.CommitterName,
-		Url:           commit.WebURL,
-		Timestamp:     commit.CommittedDate.UTC().Unix(),
-		Message:       commit.Message,
-		ParentHashes:  commit.ParentIDs,
+	commitInfo := CommitInfo{
+		Hash:           commit.ID,
+		AuthorName:     commit.AuthorName,
+		AuthorEmail:    commit.AuthorEmail,
+		CommitterName:  commit.CommitterName,
+		CommitterEmail: commit.CommitterEmail,
+		Url:            commit.WebURL,
+		WebURL:         commit.WebURL,
+		Timestamp:      commit.CommittedDate.UTC().Unix(),
+		AuthorDate:     vcsutils.DefaultIfNotNil(commit.AuthoredDate).UTC(),
+		CommitDate:     vcsutils.DefaultIfNotNil(commit.CommittedDate).UTC(),
+		Message:        commit.Message,
+		ParentHashes:   commit.ParentIDs,
+	}
+	if commit.Stats != nil {
+		commitInfo.Stats = &CommitStats{Additions: commit.Stats.Additions, Deletions: commit.Stats.Deletions}
 	}
+	return commitInfo
 }
 
 func mapGitLabNotesToCommentInfoList(notes []*gitlab.Note) (res []CommentInfo) {
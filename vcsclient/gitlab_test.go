@@ -9,6 +9,7 @@ import (
 	"math"
 	"math/rand"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"os"
 	"path/filepath"
@@ -74,16 +75,220 @@ func TestGitLabClient_ListRepositories(t *testing.T) {
 	}, actualRepositories)
 }
 
+func TestGitLabClient_StreamRepositories(t *testing.T) {
+	ctx := context.Background()
+	lastActivity := time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)
+	client, _, cleanUp := createRoutingServerAndClient(t, vcsutils.GitLab, false, map[string]interface{}{
+		"/api/v4/projects?archived=false&last_activity_after=2023-01-01T00%3A00%3A00Z&membership=true&page=1&per_page=100&search=repo&visibility=private": []gitlab.Project{
+			{Path: repo1, Namespace: &gitlab.ProjectNamespace{FullPath: "froggit/go"}, Visibility: gitlab.PrivateVisibility, DefaultBranch: "main", LastActivityAt: &lastActivity},
+			{Path: repo2, Namespace: &gitlab.ProjectNamespace{FullPath: "froggit"}, Visibility: gitlab.PrivateVisibility, LastActivityAt: &lastActivity},
+		},
+	})
+	defer cleanUp()
+
+	options := ListRepositoriesOptions{
+		Visibility:   vcsutils.PointerOf(Private),
+		Archived:     vcsutils.PointerOf(false),
+		UpdatedSince: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+		Search:       "repo",
+	}
+	repositories, err := collectRepositories(client.StreamRepositories(ctx, options))
+	assert.NoError(t, err)
+	assert.Equal(t, []RepositoryListItem{
+		{Owner: "froggit/go", Name: repo1, RepositoryInfo: RepositoryInfo{RepositoryVisibility: Private, DefaultBranch: "main", LastPushedAt: lastActivity}},
+		{Owner: "froggit", Name: repo2, RepositoryInfo: RepositoryInfo{RepositoryVisibility: Private, LastPushedAt: lastActivity}},
+	}, repositories)
+}
+
+func TestGitLabClient_StreamRepositoriesOfOwner(t *testing.T) {
+	ctx := context.Background()
+	lastActivity := time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)
+	client, _, cleanUp := createRoutingServerAndClient(t, vcsutils.GitLab, false, map[string]interface{}{
+		"/api/v4/namespaces/froggit%2Fgo": gitlab.Namespace{Kind: "group", FullPath: "froggit/go"},
+		"/api/v4/namespaces/frogger":      gitlab.Namespace{Kind: "user", FullPath: "frogger"},
+		"/api/v4/groups/froggit%2Fgo/projects?archived=false&page=1&per_page=100&search=repo&visibility=private": []gitlab.Project{
+			{Path: repo1, Namespace: &gitlab.ProjectNamespace{FullPath: "froggit/go"}, Visibility: gitlab.PrivateVisibility, DefaultBranch: "main", LastActivityAt: &lastActivity},
+		},
+		"/api/v4/users/frogger/projects?page=1&per_page=100": []gitlab.Project{
+			{Path: repo2, Namespace: &gitlab.ProjectNamespace{FullPath: "frogger"}, Visibility: gitlab.PublicVisibility, LastActivityAt: &lastActivity},
+		},
+	})
+	defer cleanUp()
+
+	// The projects of a group, whose last activity is filtered by the client
+	options := ListRepositoriesOptions{
+		Owner:        "froggit/go",
+		Visibility:   vcsutils.PointerOf(Private),
+		Archived:     vcsutils.PointerOf(false),
+		UpdatedSince: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+		Search:       "repo",
+	}
+	repositories, err := collectRepositories(client.StreamRepositories(ctx, options))
+	assert.NoError(t, err)
+	assert.Equal(t, []RepositoryListItem{{
+		Owner:          "froggit/go",
+		Name:           repo1,
+		RepositoryInfo: RepositoryInfo{RepositoryVisibility: Private, DefaultBranch: "main", LastPushedAt: lastActivity},
+	}}, repositories)
+
+	// The projects of a user
+	repositories, err = collectRepositories(client.StreamRepositories(ctx, ListRepositoriesOptions{Owner: "frogger"}))
+	assert.NoError(t, err)
+	assert.Equal(t, []RepositoryListItem{{
+		Owner:          "frogger",
+		Name:           repo2,
+		RepositoryInfo: RepositoryInfo{RepositoryVisibility: Public, LastPushedAt: lastActivity},
+	}}, repositories)
+}
+
 func TestGitLabClient_ListBranches(t *testing.T) {
 	ctx := context.Background()
-	client, cleanUp := createServerAndClient(t, vcsutils.GitLab, false, []gitlab.Branch{{Name: branch1}, {Name: branch2}}, fmt.Sprintf("/api/v4/projects/%s/repository/branches", url.PathEscape(owner+"/"+repo1)), createGitLabHandler)
+	client, cleanUp := createServerAndClient(t, vcsutils.GitLab, false, []gitlab.Branch{{Name: branch1}, {Name: branch2}}, fmt.Sprintf("/api/v4/projects/%s/repository/branches?page=1", url.PathEscape(owner+"/"+repo1)), createGitLabHandler)
 	defer cleanUp()
 
-	actualRepositories, err := client.ListBranches(ctx, owner, repo1)
+	actualRepositories, err := client.ListBranches(owner, repo1).All(ctx)
 	assert.NoError(t, err)
 	assert.ElementsMatch(t, actualRepositories, []string{branch1, branch2})
 }
 
+func TestGitLabClient_CreateBranch(t *testing.T) {
+	ctx := context.Background()
+	expectedBody := []byte(`{"branch":"` + branch1 + `","ref":"` + branch2 + `"}`)
+	client, cleanUp := createBodyHandlingServerAndClient(t, vcsutils.GitLab, false, gitlab.Branch{Name: branch1},
+		fmt.Sprintf("/api/v4/projects/%s/repository/branches", url.PathEscape(owner+"/"+repo1)), http.StatusCreated, expectedBody,
+		http.MethodPost, createGitLabWithBodyHandler)
+	defer cleanUp()
+
+	err := client.CreateBranch(ctx, owner, repo1, branch1, branch2)
+	assert.NoError(t, err)
+}
+
+func TestGitLabClient_DeleteBranch(t *testing.T) {
+	ctx := context.Background()
+	client, cleanUp := createServerAndClient(t, vcsutils.GitLab, false, nil,
+		fmt.Sprintf("/api/v4/projects/%s/repository/branches/%s", url.PathEscape(owner+"/"+repo1), branch1),
+		createGitLabHandler)
+	defer cleanUp()
+
+	err := client.DeleteBranch(ctx, owner, repo1, branch1)
+	assert.NoError(t, err)
+}
+
+func TestGitLabClient_GetBranch(t *testing.T) {
+	ctx := context.Background()
+	client, cleanUp := createServerAndClient(t, vcsutils.GitLab, false,
+		gitlab.Branch{Name: branch1, Commit: &gitlab.Commit{ID: commitHash}, Protected: true},
+		fmt.Sprintf("/api/v4/projects/%s/repository/branches/%s", url.PathEscape(owner+"/"+repo1), branch1),
+		createGitLabHandler)
+	defer cleanUp()
+
+	branchDetails, err := client.GetBranch(ctx, owner, repo1, branch1)
+	assert.NoError(t, err)
+	assert.Equal(t, BranchDetails{Name: branch1, CommitSHA: commitHash, Protected: true}, branchDetails)
+}
+
+func TestGitLabClient_CommitFiles(t *testing.T) {
+	ctx := context.Background()
+	expectedBody := []byte(`{"branch":"` + branch1 + `","commit_message":"Update files","actions":[` +
+		`{"action":"update","file_path":"go.mod","content":"bW9kdWxlIGV4YW1wbGU=","encoding":"base64"},` +
+		`{"action":"delete","file_path":"old.txt"},` +
+		`{"action":"move","file_path":"new.txt","previous_path":"moved.txt","content":"bW92ZWQ=","encoding":"base64"}],` +
+		`"author_email":"frogger@jfrog.com","author_name":"frogger"}`)
+	client, cleanUp := createBodyHandlingServerAndClient(t, vcsutils.GitLab, false, gitlab.Commit{ID: commitHash},
+		fmt.Sprintf("/api/v4/projects/%s/repository/commits", url.PathEscape(owner+"/"+repo1)), http.StatusCreated, expectedBody,
+		http.MethodPost, createGitLabWithBodyHandler)
+	defer cleanUp()
+
+	err := client.CommitFiles(ctx, owner, repo1, branch1, "Update files", []CommitFileChange{
+		{Action: UpdateFile, Path: "go.mod", Content: []byte("module example")},
+		{Action: DeleteFile, Path: "old.txt"},
+		{Action: MoveFile, Path: "new.txt", PreviousPath: "moved.txt", Content: []byte("moved")},
+	}, CommitAuthor{Name: "frogger", Email: "frogger@jfrog.com"})
+	assert.NoError(t, err)
+}
+
+func TestGitLabClient_ListTags(t *testing.T) {
+	ctx := context.Background()
+	client, cleanUp := createServerAndClient(t, vcsutils.GitLab, false,
+		[]gitlab.Tag{{Name: "v1.0.0", Commit: &gitlab.Commit{ID: commitHash}}},
+		fmt.Sprintf("/api/v4/projects/%s/repository/tags?page=1", url.PathEscape(owner+"/"+repo1)), createGitLabHandler)
+	defer cleanUp()
+
+	tags, err := client.ListTags(owner, repo1).All(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []TagInfo{{Name: "v1.0.0", CommitSHA: commitHash}}, tags)
+}
+
+func TestGitLabClient_CreateTag(t *testing.T) {
+	ctx := context.Background()
+	expectedBody := []byte(`{"tag_name":"v1.0.0","ref":"` + branch1 + `","message":"Release 1.0.0"}`)
+	client, cleanUp := createBodyHandlingServerAndClient(t, vcsutils.GitLab, false, gitlab.Tag{Name: "v1.0.0"},
+		fmt.Sprintf("/api/v4/projects/%s/repository/tags", url.PathEscape(owner+"/"+repo1)), http.StatusCreated, expectedBody,
+		http.MethodPost, createGitLabWithBodyHandler)
+	defer cleanUp()
+
+	err := client.CreateTag(ctx, owner, repo1, "v1.0.0", branch1, "Release 1.0.0")
+	assert.NoError(t, err)
+}
+
+func TestGitLabClient_DeleteTag(t *testing.T) {
+	ctx := context.Background()
+	client, cleanUp := createServerAndClient(t, vcsutils.GitLab, false, nil,
+		fmt.Sprintf("/api/v4/projects/%s/repository/tags/v1.0.0", url.PathEscape(owner+"/"+repo1)),
+		createGitLabHandler)
+	defer cleanUp()
+
+	err := client.DeleteTag(ctx, owner, repo1, "v1.0.0")
+	assert.NoError(t, err)
+}
+
+func TestGitLabClient_CreateRelease(t *testing.T) {
+	ctx := context.Background()
+	assetPath := filepath.Join(t.TempDir(), "asset.txt")
+	assert.NoError(t, os.WriteFile(assetPath, []byte("asset content"), 0600))
+	projectURI := "/api/v4/projects/" + url.PathEscape(owner+"/"+repo1)
+	assetURL := "https://gitlab.com/jfrog/repo-1/uploads/1234/asset.txt"
+	client, requestBodies, cleanUp := createRoutingServerAndClient(t, vcsutils.GitLab, false, map[string]interface{}{
+		projectURI:              gitlab.Project{WebURL: "https://gitlab.com/jfrog/repo-1"},
+		projectURI + "/uploads": gitlab.ProjectFile{URL: "/uploads/1234/asset.txt"},
+		projectURI + "/releases": []byte(`{"tag_name":"v1.0.0","name":"Release 1.0.0","description":"Notes",` +
+			`"assets":{"links":[{"name":"asset.txt","url":"` + assetURL + `"}]}}`),
+	})
+	defer cleanUp()
+
+	release, err := client.CreateRelease(ctx, owner, repo1, CreateReleaseOptions{
+		TagName:    "v1.0.0",
+		TargetRef:  branch1,
+		Name:       "Release 1.0.0",
+		Notes:      "Notes",
+		AssetPaths: []string{assetPath},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, ReleaseInfo{
+		TagName: "v1.0.0",
+		Name:    "Release 1.0.0",
+		Notes:   "Notes",
+		Assets:  []ReleaseAsset{{Name: "asset.txt", URL: assetURL}},
+	}, release)
+	assert.JSONEq(t, `{"name":"Release 1.0.0","tag_name":"v1.0.0","description":"Notes","ref":"`+branch1+`",
+		"assets":{"links":[{"name":"asset.txt","url":"`+assetURL+`"}]}}`,
+		string(requestBodies["POST "+projectURI+"/releases"]))
+	assert.Contains(t, string(requestBodies["POST "+projectURI+"/uploads"]), "asset content")
+}
+
+func TestGitLabClient_ListReleases(t *testing.T) {
+	ctx := context.Background()
+	createdAt := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+	client, cleanUp := createServerAndClient(t, vcsutils.GitLab, false,
+		[]gitlab.Release{{TagName: "v1.0.0", Name: "Release 1.0.0", CreatedAt: &createdAt}},
+		fmt.Sprintf("/api/v4/projects/%s/releases?page=1", url.PathEscape(owner+"/"+repo1)), createGitLabHandler)
+	defer cleanUp()
+
+	releases, err := client.ListReleases(owner, repo1).All(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []ReleaseInfo{{TagName: "v1.0.0", Name: "Release 1.0.0", CreatedAt: createdAt}}, releases)
+}
+
 func TestGitLabClient_CreateWebhook(t *testing.T) {
 	ctx := context.Background()
 	id := rand.Int()
@@ -118,15 +323,130 @@ func TestGitLabClient_DeleteWebhook(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestGitLabClient_ListWebhooks(t *testing.T) {
+	ctx := context.Background()
+	projectID := url.PathEscape(owner + "/" + repo1)
+	hook := gitlab.ProjectHook{ID: 1, URL: "https://jfrog.com/hook", PushEvents: true}
+	client, _, cleanUp := createRoutingServerAndClient(t, vcsutils.GitLab, false, map[string]interface{}{
+		fmt.Sprintf("/api/v4/projects/%s/hooks?page=1", projectID):             []gitlab.ProjectHook{hook},
+		fmt.Sprintf("/api/v4/projects/%s/hooks/1", projectID):                  hook,
+		fmt.Sprintf("/api/v4/projects/%s/hooks/1/test/push_events", projectID): []byte(`{"message":"201 Created"}`),
+	})
+	defer cleanUp()
+
+	expected := WebhookInfo{ID: "1", PayloadURL: "https://jfrog.com/hook", Events: []vcsutils.WebhookEvent{vcsutils.Push, vcsutils.BranchCreated, vcsutils.BranchDeleted}, Active: true}
+	webhooks, err := client.ListWebhooks(owner, repo1).All(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []WebhookInfo{expected}, webhooks)
+
+	webhook, err := client.GetWebhook(ctx, owner, repo1, "1")
+	require.NoError(t, err)
+	assert.Equal(t, expected, webhook)
+
+	assert.NoError(t, client.PingWebhook(ctx, owner, repo1, "1"))
+	assert.Error(t, client.PingWebhook(ctx, owner, repo1, "not-a-number"))
+}
+
+func TestGitLabClient_OrganizationWebhooks(t *testing.T) {
+	ctx := context.Background()
+	group := url.PathEscape("jfrog/frogbot")
+	hook := gitlab.GroupHook{ID: 1, URL: "https://jfrog.com/hook", MergeRequestsEvents: true}
+	client, bodies, cleanUp := createRoutingServerAndClient(t, vcsutils.GitLab, false, map[string]interface{}{
+		fmt.Sprintf("/api/v4/groups/%s/hooks", group):        hook,
+		fmt.Sprintf("/api/v4/groups/%s/hooks/1", group):      hook,
+		fmt.Sprintf("/api/v4/groups/%s/hooks?page=1", group): []gitlab.GroupHook{hook},
+	})
+	defer cleanUp()
+
+	id, token, err := client.CreateOrganizationWebhook(ctx, "jfrog/frogbot", "https://jfrog.com/hook", vcsutils.PrOpened)
+	require.NoError(t, err)
+	assert.Equal(t, "1", id)
+	var created gitlab.AddGroupHookOptions
+	require.NoError(t, json.Unmarshal(bodies[fmt.Sprintf("POST /api/v4/groups/%s/hooks", group)], &created))
+	assert.Equal(t, token, *created.Token)
+	assert.True(t, *created.MergeRequestsEvents)
+	assert.False(t, *created.PushEvents)
+
+	err = client.UpdateOrganizationWebhook(ctx, "jfrog/frogbot", "https://jfrog.com/hook", token, id, vcsutils.Push)
+	require.NoError(t, err)
+
+	webhooks, err := client.ListOrganizationWebhooks("jfrog/frogbot").All(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []WebhookInfo{{
+		ID:         "1",
+		PayloadURL: "https://jfrog.com/hook",
+		Events:     []vcsutils.WebhookEvent{vcsutils.PrOpened, vcsutils.PrEdited, vcsutils.PrMerged, vcsutils.PrRejected, vcsutils.PrApproved},
+		Active:     true,
+	}}, webhooks)
+
+	assert.NoError(t, client.DeleteOrganizationWebhook(ctx, "jfrog/frogbot", id))
+}
+
 func TestGitLabClient_CreateCommitStatus(t *testing.T) {
+	ctx := context.Background()
+	ref := "5fbf81b31ff7a3b06bd362d1891e2f01bdb2be69"
+	statusURI := fmt.Sprintf("/api/v4/projects/%s/statuses/%s", url.PathEscape(owner+"/"+repo1), ref)
+	client, bodies, cleanUp := createRoutingServerAndClient(t, vcsutils.GitLab, false, map[string]interface{}{statusURI: gitlab.CommitStatus{}})
+	defer cleanUp()
+
+	status := CommitStatusOptions{
+		State:       Cancelled,
+		Key:         "frogbot",
+		Description: "Commit status description",
+		DetailsURL:  "https://httpbin.org/anything",
+		BuildNumber: "12",
+	}
+	err := client.SetCommitStatus(ctx, owner, repo1, ref, status)
+	require.NoError(t, err)
+	assert.JSONEq(t, fmt.Sprintf(`{"state":"canceled","ref":"%s","name":"frogbot","target_url":"https://httpbin.org/anything",
+		"description":"Commit status description","pipeline_id":12}`, ref), string(bodies[http.MethodPost+" "+statusURI]))
+
+	// The build number is the pipeline ID
+	status.BuildNumber = "build-12"
+	assert.Error(t, client.SetCommitStatus(ctx, owner, repo1, ref, status))
+}
+
+func TestGitLabClient_ListCommitStatuses(t *testing.T) {
+	ctx := context.Background()
+	ref := "5fbf81b31ff7a3b06bd362d1891e2f01bdb2be69"
+	createdAt := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+	finishedAt := createdAt.Add(time.Minute)
+	client, _, cleanUp := createRoutingServerAndClient(t, vcsutils.GitLab, false, map[string]interface{}{
+		fmt.Sprintf("/api/v4/projects/%s/repository/commits/%s/statuses?page=1", url.PathEscape(owner+"/"+repo1), ref): []gitlab.CommitStatus{
+			{Status: "success", Name: "build", TargetURL: "https://gitlab.example.com/jobs/1", CreatedAt: &createdAt, FinishedAt: &finishedAt},
+			{Status: "failed", Name: "lint", AllowFailure: true, CreatedAt: &createdAt},
+			{Status: "running", Name: "test", Description: "Running tests", CreatedAt: &createdAt, StartedAt: &finishedAt},
+		},
+	})
+	defer cleanUp()
+
+	statuses, err := client.ListCommitStatuses(owner, repo1, ref).All(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []CommitStatusInfo{
+		{State: Pass, Context: "build", DetailsURL: "https://gitlab.example.com/jobs/1", CreatedAt: createdAt, LastUpdatedAt: finishedAt},
+		{State: Pass, Context: "lint", CreatedAt: createdAt, LastUpdatedAt: createdAt},
+		{State: InProgress, Context: "test", Description: "Running tests", CreatedAt: createdAt, LastUpdatedAt: finishedAt},
+	}, statuses)
+
+	combined, err := client.GetCombinedCommitStatus(ctx, owner, repo1, ref)
+	require.NoError(t, err)
+	assert.Equal(t, InProgress, combined.State)
+	assert.Len(t, combined.Statuses, 3)
+}
+
+func TestGitLabClient_CreateCheckRun(t *testing.T) {
 	ctx := context.Background()
 	ref := "5fbf81b31ff7a3b06bd362d1891e2f01bdb2be69"
 	client, cleanUp := createServerAndClient(t, vcsutils.GitLab, false, gitlab.CommitStatus{}, fmt.Sprintf("/api/v4/projects/%s/statuses/%s", url.PathEscape(owner+"/"+repo1), ref), createGitLabHandler)
 	defer cleanUp()
 
-	err := client.SetCommitStatus(ctx, InProgress, owner, repo1, ref, "Commit status title",
-		"Commit status description", "https://httpbin.org/anything")
-	assert.NoError(t, err)
+	checkRun := CheckRunOptions{Name: "Frogbot", CommitSHA: ref, State: InProgress, Title: "Scanning"}
+	checkRunID, err := client.CreateCheckRun(ctx, owner, repo1, checkRun)
+	require.NoError(t, err)
+	assert.Equal(t, "Frogbot", checkRunID)
+
+	checkRun.State = Pass
+	assert.NoError(t, client.UpdateCheckRun(ctx, owner, repo1, checkRunID, checkRun))
 }
 
 func TestGitLabClient_DownloadRepository(t *testing.T) {
@@ -187,10 +507,10 @@ func TestGitLabClient_ListPullRequestComments(t *testing.T) {
 	assert.NoError(t, err)
 
 	client, cleanUp := createServerAndClient(t, vcsutils.GitLab, false, response,
-		fmt.Sprintf("/api/v4/projects/%s/merge_requests/1/notes", url.PathEscape(owner+"/"+repo1)), createGitLabHandler)
+		fmt.Sprintf("/api/v4/projects/%s/merge_requests/1/notes?page=1", url.PathEscape(owner+"/"+repo1)), createGitLabHandler)
 	defer cleanUp()
 
-	result, err := client.ListPullRequestComments(ctx, owner, repo1, 1)
+	result, err := client.ListPullRequestComments(owner, repo1, 1).All(ctx)
 	require.NoError(t, err)
 	expectedCreated, err := time.Parse(time.RFC3339, "2013-10-02T09:56:03Z")
 	assert.NoError(t, err)
@@ -202,16 +522,103 @@ func TestGitLabClient_ListPullRequestComments(t *testing.T) {
 	}, result[1])
 }
 
+func TestGitLabClient_EditPullRequestComment(t *testing.T) {
+	ctx := context.Background()
+	client, cleanUp := createServerAndClient(t, vcsutils.GitLab, false, &gitlab.Note{},
+		fmt.Sprintf("/api/v4/projects/%s/merge_requests/1/notes/2", url.PathEscape(owner+"/"+repo1)), createGitLabHandler)
+	defer cleanUp()
+
+	err := client.EditPullRequestComment(ctx, owner, repo1, 1, 2, "Comment content")
+	assert.NoError(t, err)
+}
+
+func TestGitLabClient_DeletePullRequestComment(t *testing.T) {
+	ctx := context.Background()
+	client, cleanUp := createServerAndClient(t, vcsutils.GitLab, false, nil,
+		fmt.Sprintf("/api/v4/projects/%s/merge_requests/1/notes/2", url.PathEscape(owner+"/"+repo1)), createGitLabHandler)
+	defer cleanUp()
+
+	err := client.DeletePullRequestComment(ctx, owner, repo1, 1, 2)
+	assert.NoError(t, err)
+}
+
+func TestGitLabClient_AddPullRequestReviewComments(t *testing.T) {
+	ctx := context.Background()
+	mergeRequestURI := fmt.Sprintf("/api/v4/projects/%s/merge_requests/1", url.PathEscape(owner+"/"+repo1))
+	discussionsURI := mergeRequestURI + "/discussions"
+	discussionsCount := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.RequestURI == "/api/v4/" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		assert.Equal(t, token, r.Header.Get("Private-Token"))
+		switch r.RequestURI {
+		case mergeRequestURI:
+			mergeRequest := gitlab.MergeRequest{IID: 1}
+			mergeRequest.DiffRefs.BaseSha = "base-sha"
+			mergeRequest.DiffRefs.HeadSha = "head-sha"
+			mergeRequest.DiffRefs.StartSha = "start-sha"
+			response, err := json.Marshal(mergeRequest)
+			require.NoError(t, err)
+			_, err = w.Write(response)
+			require.NoError(t, err)
+		case discussionsURI:
+			assert.Equal(t, http.MethodPost, r.Method)
+			options := gitlab.CreateMergeRequestDiscussionOptions{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&options))
+			assert.Equal(t, "Comment content", *options.Body)
+			assert.Equal(t, "head-sha", options.Position.HeadSHA)
+			assert.Equal(t, "file1.txt", options.Position.NewPath)
+			assert.Equal(t, 5, options.Position.NewLine)
+			assert.Zero(t, options.Position.OldLine)
+			discussionsCount++
+			_, err := w.Write([]byte("{}"))
+			require.NoError(t, err)
+		default:
+			assert.Fail(t, "Unexpected request URI", r.RequestURI)
+		}
+	}))
+	defer server.Close()
+	client := buildClient(t, vcsutils.GitLab, false, server)
+
+	err := client.AddPullRequestReviewComments(ctx, owner, repo1, 1, []ReviewComment{
+		{Path: "file1.txt", Line: 5, Content: "Comment content"},
+		{Path: "file1.txt", StartLine: 3, Line: 5, Content: "Comment content"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, discussionsCount)
+}
+
+func TestGitLabClient_ListPullRequestReviewComments(t *testing.T) {
+	ctx := context.Background()
+	response, err := os.ReadFile(filepath.Join("testdata", "gitlab", "pull_request_discussions_list_response.json"))
+	assert.NoError(t, err)
+
+	client, cleanUp := createServerAndClient(t, vcsutils.GitLab, false, response,
+		fmt.Sprintf("/api/v4/projects/%s/merge_requests/1/discussions?page=1", url.PathEscape(owner+"/"+repo1)), createGitLabHandler)
+	defer cleanUp()
+
+	result, err := client.ListPullRequestReviewComments(owner, repo1, 1).All(ctx)
+	require.NoError(t, err)
+	expectedCreated, err := time.Parse(time.RFC3339, "2018-03-04T09:17:22.520Z")
+	assert.NoError(t, err)
+	assert.Equal(t, []ReviewCommentInfo{
+		{ID: 1128, Content: "diff comment", Created: expectedCreated, Path: "package.json", Line: 27, Side: RightSide},
+		{ID: 1129, Content: "removed line comment", Created: expectedCreated, Path: "old.json", Line: 3, Side: LeftSide},
+	}, result)
+}
+
 func TestGitLabClient_ListOpenPullRequests(t *testing.T) {
 	ctx := context.Background()
 	response, err := os.ReadFile(filepath.Join("testdata", "gitlab", "pull_requests_list_response.json"))
 	assert.NoError(t, err)
 
 	client, cleanUp := createServerAndClient(t, vcsutils.GitLab, false, response,
-		"/api/v4/merge_requests?scope=all&state=open", createGitLabHandler)
+		"/api/v4/projects/jfrog%2Frepo-1/merge_requests?page=1&state=open", createGitLabHandler)
 	defer cleanUp()
 
-	result, err := client.ListOpenPullRequests(ctx, owner, repo1)
+	result, err := client.ListOpenPullRequests(owner, repo1).All(ctx)
 	require.NoError(t, err)
 	assert.Len(t, result, 1)
 	assert.True(t, reflect.DeepEqual(PullRequestInfo{
@@ -221,6 +628,43 @@ func TestGitLabClient_ListOpenPullRequests(t *testing.T) {
 	}, result[0]))
 }
 
+func TestGitLabClient_GetPullRequestDiff(t *testing.T) {
+	ctx := context.Background()
+	response, err := os.ReadFile(filepath.Join("testdata", "gitlab", "pull_request_changes_response.json"))
+	require.NoError(t, err)
+	client, cleanUp := createServerAndClient(t, vcsutils.GitLab, false, response,
+		"/api/v4/projects/jfrog%2Frepo-1/merge_requests/1/changes", createGitLabHandler)
+	defer cleanUp()
+
+	result, err := client.GetPullRequestDiff(ctx, owner, repo1, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []vcsutils.FileDiff{
+		{
+			Path: "README.md", Status: vcsutils.FileAdded, Additions: 1,
+			Hunks: []vcsutils.DiffHunk{{
+				OldStart: 0, OldLines: 0, NewStart: 1, NewLines: 1,
+				Lines: []vcsutils.DiffLine{{Type: vcsutils.AddedLine, Content: "# Title", NewLine: 1}},
+			}},
+		},
+		{Path: "old.txt", Status: vcsutils.FileRemoved},
+		{Path: "new.txt", PreviousPath: "renamed.txt", Status: vcsutils.FileRenamed},
+	}, result)
+}
+
+func TestGitLabClient_ListPullRequestFiles(t *testing.T) {
+	ctx := context.Background()
+	response, err := os.ReadFile(filepath.Join("testdata", "gitlab", "pull_request_changes_response.json"))
+	require.NoError(t, err)
+	client, cleanUp := createServerAndClient(t, vcsutils.GitLab, false, response,
+		"/api/v4/projects/jfrog%2Frepo-1/merge_requests/1/changes", createGitLabHandler)
+	defer cleanUp()
+
+	result, err := client.ListPullRequestFiles(owner, repo1, 1).All(ctx)
+	require.NoError(t, err)
+	require.Len(t, result, 3)
+	assert.Equal(t, vcsutils.FileDiff{Path: "README.md", Status: vcsutils.FileAdded, Additions: 1}, result[0])
+}
+
 func TestGitLabClient_GetLatestCommit(t *testing.T) {
 	ctx := context.Background()
 	response, err := os.ReadFile(filepath.Join("testdata", "gitlab", "commit_list_response.json"))
@@ -234,17 +678,53 @@ func TestGitLabClient_GetLatestCommit(t *testing.T) {
 	result, err := client.GetLatestCommit(ctx, owner, repo1, "master")
 
 	require.NoError(t, err)
+	commitTime := time.Date(2012, 9, 20, 8, 50, 22, 0, time.UTC)
 	assert.Equal(t, CommitInfo{
-		Hash:          "ed899a2f4b50b4370feeea94676502b42383c746",
-		AuthorName:    "Example User",
-		CommitterName: "Administrator",
-		Url:           "https://gitlab.example.com/thedude/gitlab-foss/-/commit/ed899a2f4b50b4370feeea94676502b42383c746",
-		Timestamp:     1348131022,
-		Message:       "Replace sanitize with escape once",
-		ParentHashes:  []string{"6104942438c14ec7bd21c6cd5bd995272b3faff6"},
+		Hash:           "ed899a2f4b50b4370feeea94676502b42383c746",
+		AuthorName:     "Example User",
+		AuthorEmail:    "user@example.com",
+		CommitterName:  "Administrator",
+		CommitterEmail: "admin@example.com",
+		Url:            "https://gitlab.example.com/thedude/gitlab-foss/-/commit/ed899a2f4b50b4370feeea94676502b42383c746",
+		WebURL:         "https://gitlab.example.com/thedude/gitlab-foss/-/commit/ed899a2f4b50b4370feeea94676502b42383c746",
+		Timestamp:      1348131022,
+		AuthorDate:     commitTime,
+		CommitDate:     commitTime,
+		Message:        "Replace sanitize with escape once",
+		ParentHashes:   []string{"6104942438c14ec7bd21c6cd5bd995272b3faff6"},
 	}, result)
 }
 
+func TestGitLabClient_ListCommits(t *testing.T) {
+	ctx := context.Background()
+	response, err := os.ReadFile(filepath.Join("testdata", "gitlab", "commit_list_response.json"))
+	assert.NoError(t, err)
+
+	client, cleanUp := createServerAndClient(t, vcsutils.GitLab, false, response,
+		fmt.Sprintf("/api/v4/projects/%s/repository/commits?page=1&path=README.md&ref_name=master&with_stats=true",
+			url.PathEscape(owner+"/"+repo1)), createGitLabHandler)
+	defer cleanUp()
+
+	// The commits are filtered by the author after listing
+	result, err := client.ListCommits(owner, repo1, ListCommitsOptions{Ref: "master", Path: "README.md", Author: "example user"}).All(ctx)
+	require.NoError(t, err)
+	commitTime := time.Date(2012, 9, 20, 8, 50, 22, 0, time.UTC)
+	assert.Equal(t, []CommitInfo{{
+		Hash:           "ed899a2f4b50b4370feeea94676502b42383c746",
+		AuthorName:     "Example User",
+		AuthorEmail:    "user@example.com",
+		CommitterName:  "Administrator",
+		CommitterEmail: "admin@example.com",
+		Url:            "https://gitlab.example.com/thedude/gitlab-foss/-/commit/ed899a2f4b50b4370feeea94676502b42383c746",
+		WebURL:         "https://gitlab.example.com/thedude/gitlab-foss/-/commit/ed899a2f4b50b4370feeea94676502b42383c746",
+		Timestamp:      1348131022,
+		AuthorDate:     commitTime,
+		CommitDate:     commitTime,
+		Message:        "Replace sanitize with escape once",
+		ParentHashes:   []string{"6104942438c14ec7bd21c6cd5bd995272b3faff6"},
+	}}, result)
+}
+
 func TestGitLabClient_GetLatestCommitNotFound(t *testing.T) {
 	ctx := context.Background()
 	response := []byte(`{
@@ -327,8 +807,10 @@ func TestGitLabClient_GetRepositoryInfo(t *testing.T) {
 	response, err := os.ReadFile(filepath.Join("testdata", "gitlab", "repository_response.json"))
 	require.NoError(t, err)
 
-	client, cleanUp := createServerAndClientReturningStatus(t, vcsutils.GitLab, false, response,
-		"/api/v4/projects/diaspora%2Fdiaspora-project-site", http.StatusOK, createGitLabHandler)
+	client, _, cleanUp := createRoutingServerAndClient(t, vcsutils.GitLab, false, map[string]interface{}{
+		"/api/v4/projects/diaspora%2Fdiaspora-project-site?statistics=true": response,
+		"/api/v4/projects/diaspora%2Fdiaspora-project-site/languages":       gitlab.ProjectLanguages{"Ruby": 66.69, "JavaScript": 22.98, "HTML": 7.91},
+	})
 	defer cleanUp()
 
 	result, err := client.GetRepositoryInfo(ctx, "diaspora", "diaspora-project-site")
@@ -339,33 +821,124 @@ func TestGitLabClient_GetRepositoryInfo(t *testing.T) {
 			CloneInfo: CloneInfo{
 				HTTP: "http://example.com/diaspora/diaspora-project-site.git",
 				SSH:  "git@example.com:diaspora/diaspora-project-site.git"},
+			DefaultBranch: "master",
+			WebURL:        "http://example.com/diaspora/diaspora-project-site",
+			Topics:        []string{"example", "disapora project"},
+			Size:          1038090,
+			CreatedAt:     time.Date(2013, 9, 30, 13, 46, 2, 0, time.UTC),
+			LastPushedAt:  time.Date(2013, 9, 30, 13, 46, 2, 0, time.UTC),
+			Language:      "Ruby",
 		},
 		result,
 	)
 }
 
+func TestGitLabClient_GetRepositoryInfoFork(t *testing.T) {
+	ctx := context.Background()
+	client, _, cleanUp := createRoutingServerAndClient(t, vcsutils.GitLab, false, map[string]interface{}{
+		"/api/v4/projects/jfrog%2Frepo-1?statistics=true": gitlab.Project{
+			Archived:          true,
+			TagList:           []string{"frog"},
+			ForkedFromProject: &gitlab.ForkParent{Path: "upstream-repo", PathWithNamespace: "group/subgroup/upstream-repo"},
+		},
+		"/api/v4/projects/jfrog%2Frepo-1/languages": gitlab.ProjectLanguages{},
+	})
+	defer cleanUp()
+
+	result, err := client.GetRepositoryInfo(ctx, owner, repo1)
+	require.NoError(t, err)
+	assert.True(t, result.Archived)
+	assert.True(t, result.Fork)
+	assert.Equal(t, []string{"frog"}, result.Topics)
+	assert.Equal(t, &RepositoryReference{Owner: "group/subgroup", Name: "upstream-repo"}, result.Parent)
+	assert.Empty(t, result.Language)
+}
+
+func TestGitLabClient_CreateRepository(t *testing.T) {
+	ctx := context.Background()
+	client, requestBodies, cleanUp := createRoutingServerAndClient(t, vcsutils.GitLab, false, map[string]interface{}{
+		"/api/v4/namespaces/jfrog": gitlab.Namespace{ID: 5},
+		"/api/v4/projects":         gitlab.Project{ID: 10},
+	})
+	defer cleanUp()
+
+	err := client.CreateRepository(ctx, owner, CreateRepositoryOptions{
+		Name:          "new-repo",
+		Description:   "A new repository",
+		Visibility:    Internal,
+		DefaultBranch: "develop",
+		InitReadme:    true,
+	})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"default_branch":"develop","description":"A new repository","initialize_with_readme":true,
+		"name":"new-repo","namespace_id":5,"path":"new-repo","visibility":"internal"}`,
+		string(requestBodies["POST /api/v4/projects"]))
+}
+
+func TestGitLabClient_ForkRepository(t *testing.T) {
+	ctx := context.Background()
+	expectedBody := []byte(`{"namespace":"frogs"}`)
+	client, cleanUp := createBodyHandlingServerAndClient(t, vcsutils.GitLab, false, gitlab.Project{},
+		fmt.Sprintf("/api/v4/projects/%s/fork", url.PathEscape(owner+"/"+repo1)), http.StatusCreated, expectedBody,
+		http.MethodPost, createGitLabWithBodyHandler)
+	defer cleanUp()
+
+	err := client.ForkRepository(ctx, owner, repo1, "frogs")
+	assert.NoError(t, err)
+}
+
+func TestGitLabClient_ArchiveRepository(t *testing.T) {
+	ctx := context.Background()
+	client, cleanUp := createServerAndClient(t, vcsutils.GitLab, false, gitlab.Project{Archived: true},
+		fmt.Sprintf("/api/v4/projects/%s/archive", url.PathEscape(owner+"/"+repo1)), createGitLabHandler)
+	defer cleanUp()
+
+	err := client.ArchiveRepository(ctx, owner, repo1)
+	assert.NoError(t, err)
+}
+
+func TestGitLabClient_DeleteRepository(t *testing.T) {
+	ctx := context.Background()
+	client, cleanUp := createServerAndClient(t, vcsutils.GitLab, false, nil,
+		"/api/v4/projects/"+url.PathEscape(owner+"/"+repo1), createGitLabHandler)
+	defer cleanUp()
+
+	err := client.DeleteRepository(ctx, owner, repo1)
+	assert.NoError(t, err)
+}
+
 func TestGitLabClient_GetCommitBySha(t *testing.T) {
 	ctx := context.Background()
 	sha := "ff4a54b88fbd387ac4d9e8cdeb54b049978e450a"
 	response, err := os.ReadFile(filepath.Join("testdata", "gitlab", "commit_single_response.json"))
 	assert.NoError(t, err)
 
-	client, cleanUp := createServerAndClient(t, vcsutils.GitLab, false, response,
-		fmt.Sprintf("/api/v4/projects/%s/repository/commits/%s",
-			url.PathEscape(owner+"/"+repo1), sha), createGitLabHandler)
+	commitURI := fmt.Sprintf("/api/v4/projects/%s/repository/commits/%s", url.PathEscape(owner+"/"+repo1), sha)
+	client, _, cleanUp := createRoutingServerAndClient(t, vcsutils.GitLab, false, map[string]interface{}{
+		commitURI:                response,
+		commitURI + "/signature": gitlab.GPGSignature{VerificationStatus: "verified"},
+	})
 	defer cleanUp()
 
 	result, err := client.GetCommitBySha(ctx, owner, repo1, sha)
 
 	require.NoError(t, err)
+	commitTime := time.Date(2021, 11, 8, 14, 56, 28, 0, time.UTC)
 	assert.Equal(t, CommitInfo{
-		Hash:          sha,
-		AuthorName:    "Example User",
-		CommitterName: "Administrator",
-		Url:           "https://gitlab.example.com/thedude/gitlab-foss/-/commit/ff4a54b88fbd387ac4d9e8cdeb54b049978e450a",
-		Timestamp:     1636383388,
-		Message:       "Initial commit",
-		ParentHashes:  []string{"667fb1d7f3854da3ee036ba3ad711c87c8b37fbd"},
+		Hash:           sha,
+		AuthorName:     "Example User",
+		AuthorEmail:    "user@example.com",
+		CommitterName:  "Administrator",
+		CommitterEmail: "admin@example.com",
+		Url:            "https://gitlab.example.com/thedude/gitlab-foss/-/commit/ff4a54b88fbd387ac4d9e8cdeb54b049978e450a",
+		WebURL:         "https://gitlab.example.com/thedude/gitlab-foss/-/commit/ff4a54b88fbd387ac4d9e8cdeb54b049978e450a",
+		Timestamp:      1636383388,
+		AuthorDate:     commitTime,
+		CommitDate:     commitTime,
+		Message:        "Initial commit",
+		ParentHashes:   []string{"667fb1d7f3854da3ee036ba3ad711c87c8b37fbd"},
+		Verification:   &CommitVerification{Verified: true, Reason: "verified"},
+		Stats:          &CommitStats{Additions: 1},
 	}, result)
 }
 
@@ -399,7 +972,9 @@ func TestGitlabClient_getGitlabCommitState(t *testing.T) {
 	assert.Equal(t, "failed", getGitLabCommitState(Fail))
 	assert.Equal(t, "failed", getGitLabCommitState(Error))
 	assert.Equal(t, "running", getGitLabCommitState(InProgress))
-	assert.Equal(t, "", getGitLabCommitState(5))
+	assert.Equal(t, "skipped", getGitLabCommitState(Skipped))
+	assert.Equal(t, "canceled", getGitLabCommitState(Cancelled))
+	assert.Equal(t, "", getGitLabCommitState(7))
 }
 
 func TestGitlabClient_CreateLabel(t *testing.T) {
@@ -440,12 +1015,59 @@ func TestGitlabClient_ListPullRequestLabels(t *testing.T) {
 		fmt.Sprintf("/api/v4/projects/%s/merge_requests/1", url.PathEscape(owner+"/"+repo1)), createGitLabHandler)
 	defer cleanUp()
 
-	labels, err := client.ListPullRequestLabels(ctx, owner, repo1, 1)
+	labels, err := client.ListPullRequestLabels(owner, repo1, 1).All(ctx)
 	assert.NoError(t, err)
 	assert.Len(t, labels, 1)
 	assert.Equal(t, labelName, labels[0])
 }
 
+func TestGitlabClient_ListLabels(t *testing.T) {
+	ctx := context.Background()
+	client, cleanUp := createServerAndClient(t, vcsutils.GitLab, false,
+		[]gitlab.Label{{Name: labelName, Description: "label-description", Color: "#001122"}},
+		fmt.Sprintf("/api/v4/projects/%s/labels?page=1", url.PathEscape(owner+"/"+repo1)), createGitLabHandler)
+	defer cleanUp()
+
+	labels, err := client.ListLabels(owner, repo1).All(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []LabelInfo{{Name: labelName, Description: "label-description", Color: "001122"}}, labels)
+}
+
+func TestGitlabClient_UpdateLabel(t *testing.T) {
+	ctx := context.Background()
+	expectedBody := []byte(`{"name":"` + labelName + `","new_name":"new-name","color":"001122","description":"label-description"}`)
+	client, cleanUp := createBodyHandlingServerAndClient(t, vcsutils.GitLab, false, gitlab.Label{},
+		fmt.Sprintf("/api/v4/projects/%s/labels", url.PathEscape(owner+"/"+repo1)), http.StatusOK, expectedBody, http.MethodPut,
+		createGitLabWithBodyHandler)
+	defer cleanUp()
+
+	err := client.UpdateLabel(ctx, owner, repo1, labelName, LabelInfo{Name: "new-name", Description: "label-description", Color: "001122"})
+	assert.NoError(t, err)
+}
+
+func TestGitlabClient_DeleteLabel(t *testing.T) {
+	ctx := context.Background()
+	client, cleanUp := createBodyHandlingServerAndClient(t, vcsutils.GitLab, false, nil,
+		fmt.Sprintf("/api/v4/projects/%s/labels?name=%s", url.PathEscape(owner+"/"+repo1), url.QueryEscape(labelName)), http.StatusOK,
+		[]byte{}, http.MethodDelete, createGitLabWithBodyHandler)
+	defer cleanUp()
+
+	err := client.DeleteLabel(ctx, owner, repo1, labelName)
+	assert.NoError(t, err)
+}
+
+func TestGitlabClient_AddPullRequestLabels(t *testing.T) {
+	ctx := context.Background()
+	expectedBody := []byte(`{"add_labels":"` + labelName + `,other-label"}`)
+	client, cleanUp := createBodyHandlingServerAndClient(t, vcsutils.GitLab, false, &gitlab.MergeRequest{},
+		fmt.Sprintf("/api/v4/projects/%s/merge_requests/1", url.PathEscape(owner+"/"+repo1)), http.StatusOK, expectedBody, http.MethodPut,
+		createGitLabWithBodyHandler)
+	defer cleanUp()
+
+	err := client.AddPullRequestLabels(ctx, owner, repo1, 1, labelName, "other-label")
+	assert.NoError(t, err)
+}
+
 func TestGitlabClient_UnlabelPullRequest(t *testing.T) {
 	ctx := context.Background()
 	client, cleanUp := createServerAndClient(t, vcsutils.GitLab, false, nil,
@@ -528,6 +1150,33 @@ func TestGitLabClient_GetModifiedFiles(t *testing.T) {
 	})
 }
 
+func TestGitLabClient_GetFileChanges(t *testing.T) {
+	ctx := context.Background()
+	response, err := os.ReadFile(filepath.Join("testdata", "gitlab", "compare_commits.json"))
+	assert.NoError(t, err)
+	client, cleanUp := createServerAndClient(t, vcsutils.GitLab, false, response,
+		fmt.Sprintf("/api/v4/projects/%s/repository/compare?from=sha-1&to=sha-2", url.PathEscape(owner+"/"+repo1)),
+		createGitLabHandler)
+	defer cleanUp()
+
+	fileChanges, err := client.GetFileChanges(ctx, owner, repo1, "sha-1", "sha-2")
+	require.NoError(t, err)
+	assert.Equal(t, []FileChange{
+		{Path: "doc/user/project/integrations/gitlab_slack_application.md", Status: vcsutils.FileModified},
+		{Path: "doc/user/project/integrations/slack.md", Status: vcsutils.FileModified},
+		{
+			Path:         "doc/user/project/integrations/slack_slash_commands.md",
+			PreviousPath: "doc/user/project/integrations/slack_slash_commands_2.md",
+			Status:       vcsutils.FileRenamed,
+		},
+	}, fileChanges)
+
+	cancelledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = client.GetFileChanges(cancelledCtx, owner, repo1, "sha-1", "sha-2")
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
 func createGitLabHandler(t *testing.T, expectedURI string, response []byte, expectedStatusCode int) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if r.RequestURI == "/api/v4/" {
@@ -549,6 +1198,7 @@ func createGitLabWithPaginationHandler(t *testing.T, _ string, response []byte,
 	const (
 		defaultPerPage = 20
 		xTotalPages    = "X-Total-Pages"
+		xNextPage      = "X-Next-Page"
 		qPage          = "page"
 		qPerPage       = "per_page"
 		qMembership    = "membership"
@@ -587,6 +1237,9 @@ func createGitLabWithPaginationHandler(t *testing.T, _ string, response []byte,
 
 		lastPage := int(math.Ceil(float64(count) / float64(pageSize)))
 		writer.Header().Add(xTotalPages, strconv.Itoa(lastPage))
+		if page < lastPage {
+			writer.Header().Add(xNextPage, strconv.Itoa(page+1))
+		}
 		writer.WriteHeader(expectedStatusCode)
 
 		var pageItems []gitlab.Project
@@ -628,3 +1281,17 @@ func createGitLabWithBodyHandler(t *testing.T, expectedURI string, response []by
 		assert.NoError(t, err)
 	}
 }
+
+func TestCreateProjectHook(t *testing.T) {
+	hook := createProjectHook("main", "https://jfrog.com/hook", vcsutils.TagPushed, vcsutils.PrApproved, vcsutils.PrCommentAdded, vcsutils.ReleasePublished)
+	assert.Equal(t, &gitlab.ProjectHook{
+		URL:                 "https://jfrog.com/hook",
+		TagPushEvents:       true,
+		MergeRequestsEvents: true,
+		NoteEvents:          true,
+		ReleasesEvents:      true,
+	}, hook)
+
+	hook = createProjectHook("main", "https://jfrog.com/hook", vcsutils.Push, vcsutils.BranchCreated)
+	assert.Equal(t, &gitlab.ProjectHook{URL: "https://jfrog.com/hook", PushEvents: true, PushEventsBranchFilter: "main"}, hook)
+}
@@ -0,0 +1,111 @@
+package vcsclient
+
+import (
+	"context"
+	"strconv"
+)
+
+// Pager iterates over the items of a paginated list.
+// A page is fetched only when its items are needed, so a caller can stop early without fetching the remaining pages.
+type Pager[T any] struct {
+	fetchPage  pageFetcher[T]
+	items      []T
+	nextCursor string
+	lastPage   bool
+	err        error
+}
+
+// pageFetcher fetches the page of the cursor, and returns its items and the cursor of the next page.
+// The cursor of the first page is empty, and an empty next cursor means that the page is the last one.
+type pageFetcher[T any] func(ctx context.Context, cursor string) (items []T, nextCursor string, err error)
+
+func newPager[T any](fetchPage pageFetcher[T]) *Pager[T] {
+	return &Pager[T]{fetchPage: fetchPage}
+}
+
+// newNumberedPager creates a pager of a list whose pages are identified by numbers, starting at firstPage.
+// fetchPage returns the number of the next page, or zero if the page is the last one.
+func newNumberedPager[T any](firstPage int, fetchPage func(ctx context.Context, page int) (items []T, nextPage int, err error)) *Pager[T] {
+	return newPager(func(ctx context.Context, cursor string) ([]T, string, error) {
+		page := firstPage
+		if cursor != "" {
+			var err error
+			if page, err = strconv.Atoi(cursor); err != nil {
+				return nil, "", err
+			}
+		}
+		items, nextPage, err := fetchPage(ctx, page)
+		if err != nil || nextPage == 0 {
+			return items, "", err
+		}
+		return items, strconv.Itoa(nextPage), nil
+	})
+}
+
+// newSinglePagePager creates a pager of a list returned in a single response
+func newSinglePagePager[T any](fetchItems func(ctx context.Context) ([]T, error)) *Pager[T] {
+	return newPager(func(ctx context.Context, _ string) ([]T, string, error) {
+		items, err := fetchItems(ctx)
+		return items, "", err
+	})
+}
+
+// mapPager creates a pager of the mapped items of a pager which wasn't iterated yet
+func mapPager[T, U any](pager *Pager[T], mapItem func(T) U) *Pager[U] {
+	if pager.fetchPage == nil {
+		return newErrorPager[U](pager.err)
+	}
+	return newPager(func(ctx context.Context, cursor string) ([]U, string, error) {
+		items, nextCursor, err := pager.fetchPage(ctx, cursor)
+		if err != nil {
+			return nil, "", err
+		}
+		results := make([]U, 0, len(items))
+		for _, item := range items {
+			results = append(results, mapItem(item))
+		}
+		return results, nextCursor, nil
+	})
+}
+
+// newErrorPager creates a pager which returns the error, for example when the list parameters are invalid
+func newErrorPager[T any](err error) *Pager[T] {
+	return &Pager[T]{err: err}
+}
+
+// Next returns the next item, and fetches the next page if all the items of the current page were returned.
+// The returned bool is false after the last item, or if an error occurred.
+// After an error, the following calls return the same error.
+func (pager *Pager[T]) Next(ctx context.Context) (T, bool, error) {
+	var item T
+	for len(pager.items) == 0 {
+		if pager.err != nil {
+			return item, false, pager.err
+		}
+		if pager.lastPage {
+			return item, false, nil
+		}
+		pager.items, pager.nextCursor, pager.err = pager.fetchPage(ctx, pager.nextCursor)
+		pager.lastPage = pager.nextCursor == ""
+		if pager.err != nil {
+			pager.items = nil
+		}
+	}
+	item, pager.items = pager.items[0], pager.items[1:]
+	return item, true, nil
+}
+
+// All returns all the remaining items
+func (pager *Pager[T]) All(ctx context.Context) ([]T, error) {
+	results := []T{}
+	for {
+		item, ok, err := pager.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return results, nil
+		}
+		results = append(results, item)
+	}
+}
@@ -0,0 +1,101 @@
+package vcsclient
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPager_Next(t *testing.T) {
+	ctx := context.Background()
+	var fetchedPages []int
+	pager := newNumberedPager(1, func(_ context.Context, page int) ([]string, int, error) {
+		fetchedPages = append(fetchedPages, page)
+		if page == 1 {
+			return []string{branch1, branch2}, 2, nil
+		}
+		// An empty page which isn't the last one is skipped
+		if page == 2 {
+			return nil, 3, nil
+		}
+		return []string{"branch-3"}, 0, nil
+	})
+
+	item, ok, err := pager.Next(ctx)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, branch1, item)
+	// The next page isn't fetched before the items of the current page are returned
+	assert.Equal(t, []int{1}, fetchedPages)
+
+	items, err := pager.All(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{branch2, "branch-3"}, items)
+	assert.Equal(t, []int{1, 2, 3}, fetchedPages)
+
+	_, ok, err = pager.Next(ctx)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	assert.Equal(t, []int{1, 2, 3}, fetchedPages)
+}
+
+func TestPager_Error(t *testing.T) {
+	ctx := context.Background()
+	pager := newNumberedPager(0, func(_ context.Context, page int) ([]string, int, error) {
+		if page == 0 {
+			return []string{branch1}, 1, nil
+		}
+		return nil, 0, errors.New("fetching failed")
+	})
+	item, ok, err := pager.Next(ctx)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, branch1, item)
+
+	_, ok, err = pager.Next(ctx)
+	assert.EqualError(t, err, "fetching failed")
+	assert.False(t, ok)
+	// The error is returned again by the following calls
+	items, err := pager.All(ctx)
+	assert.EqualError(t, err, "fetching failed")
+	assert.Nil(t, items)
+
+	items, err = newErrorPager[string](errors.New("invalid parameters")).All(ctx)
+	assert.EqualError(t, err, "invalid parameters")
+	assert.Nil(t, items)
+}
+
+func TestPager_All(t *testing.T) {
+	ctx := context.Background()
+	items, err := newSinglePagePager(func(context.Context) ([]string, error) { return nil, nil }).All(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{}, items)
+
+	pager := newPager(func(_ context.Context, cursor string) ([]string, string, error) {
+		if cursor == "" {
+			return []string{branch1}, "next-token", nil
+		}
+		return []string{cursor}, "", nil
+	})
+	items, err = pager.All(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{branch1, "next-token"}, items)
+}
+
+func TestMapPager(t *testing.T) {
+	ctx := context.Background()
+	pager := newNumberedPager(1, func(_ context.Context, page int) ([]int, int, error) {
+		if page == 1 {
+			return []int{1, 2}, 2, nil
+		}
+		return []int{3}, 0, nil
+	})
+	items, err := mapPager(pager, func(item int) int64 { return int64(item * 10) }).All(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{10, 20, 30}, items)
+
+	_, err = mapPager(newErrorPager[int](errors.New("invalid parameters")), func(item int) int64 { return int64(item) }).All(ctx)
+	assert.EqualError(t, err, "invalid parameters")
+}
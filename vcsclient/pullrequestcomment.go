@@ -0,0 +1,53 @@
+package vcsclient
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// The marker is embedded as a markdown link reference definition, which isn't rendered by any of the VCS providers
+const pullRequestCommentMarkerFormat = "[//]: # (%s)"
+
+// UpsertPullRequestComment keeps a single comment, identified by a hidden marker, on the requested pull request.
+// If a comment containing the marker exists, its content is replaced. Otherwise, a new comment is added.
+// Any participant of the pull request can copy the marker to their own comment, which the authenticated user can't edit.
+// Therefore, a marked comment which fails to be edited is skipped, and the next marked comment is looked for.
+// client         - The VCS client
+// owner          - User or organization
+// repository     - VCS repository name
+// pullRequestID  - Pull request ID
+// marker         - A unique identifier of the comment, which is hidden from the comment readers
+// content        - The comment content
+func UpsertPullRequestComment(ctx context.Context, client VcsClient, owner, repository string, pullRequestID int, marker, content string) error {
+	err := validateParametersNotBlank(map[string]string{"marker": marker, "content": content})
+	if err != nil {
+		return err
+	}
+	if strings.ContainsAny(marker, "()\r\n") {
+		return fmt.Errorf("validation failed: the comment marker '%s' can't contain parentheses or line breaks", marker)
+	}
+	hiddenMarker := fmt.Sprintf(pullRequestCommentMarkerFormat, marker)
+	markedContent := content + "\n\n" + hiddenMarker
+	// The comments are paged, so the iteration stops at the marked comment
+	comments := client.ListPullRequestComments(owner, repository, pullRequestID)
+	for {
+		comment, ok, err := comments.Next(ctx)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			break
+		}
+		if !strings.Contains(comment.Content, hiddenMarker) {
+			continue
+		}
+		if err = client.EditPullRequestComment(ctx, owner, repository, pullRequestID, comment.ID, markedContent); err == nil {
+			return nil
+		}
+		if ctx.Err() != nil {
+			return err
+		}
+	}
+	return client.AddPullRequestComment(ctx, owner, repository, markedContent, pullRequestID)
+}
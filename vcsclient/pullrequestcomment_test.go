@@ -0,0 +1,103 @@
+package vcsclient
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeCommentsClient keeps the pull request comments in memory. Calling any other VcsClient method panics.
+type fakeCommentsClient struct {
+	VcsClient
+	comments []CommentInfo
+	// The comments of other users, which can't be edited
+	forbidden map[int64]bool
+	edited    map[int64]string
+	added     []string
+}
+
+func (client *fakeCommentsClient) ListPullRequestComments(_, _ string, _ int) *Pager[CommentInfo] {
+	return newSinglePagePager(func(context.Context) ([]CommentInfo, error) {
+		return client.comments, nil
+	})
+}
+
+func (client *fakeCommentsClient) EditPullRequestComment(_ context.Context, _, _ string, _ int, commentID int64, content string) error {
+	if client.forbidden[commentID] {
+		return errors.New("403 Forbidden")
+	}
+	client.edited[commentID] = content
+	return nil
+}
+
+func (client *fakeCommentsClient) AddPullRequestComment(_ context.Context, _, _, content string, _ int) error {
+	client.added = append(client.added, content)
+	return nil
+}
+
+func TestUpsertPullRequestComment(t *testing.T) {
+	ctx := context.Background()
+	expectedContent := "New summary\n\n[//]: # (scan-summary)"
+	tests := []struct {
+		name           string
+		comments       []CommentInfo
+		forbidden      map[int64]bool
+		expectedEdited map[int64]string
+		expectedAdded  []string
+	}{
+		{
+			name:           "no comments",
+			expectedEdited: map[int64]string{},
+			expectedAdded:  []string{expectedContent},
+		},
+		{
+			name:           "no marked comment",
+			comments:       []CommentInfo{{ID: 1, Content: "Old summary\n\n[//]: # (other-marker)"}},
+			expectedEdited: map[int64]string{},
+			expectedAdded:  []string{expectedContent},
+		},
+		{
+			name: "marked comment",
+			comments: []CommentInfo{
+				{ID: 1, Content: "Unrelated comment"},
+				{ID: 2, Content: "Old summary\n\n[//]: # (scan-summary)"},
+			},
+			expectedEdited: map[int64]string{2: expectedContent},
+		},
+		{
+			name: "marker copied by another user",
+			comments: []CommentInfo{
+				{ID: 1, Content: "Copied\n\n[//]: # (scan-summary)"},
+				{ID: 2, Content: "Old summary\n\n[//]: # (scan-summary)"},
+			},
+			forbidden:      map[int64]bool{1: true},
+			expectedEdited: map[int64]string{2: expectedContent},
+		},
+		{
+			name:           "only a marker copied by another user",
+			comments:       []CommentInfo{{ID: 1, Content: "Copied\n\n[//]: # (scan-summary)"}},
+			forbidden:      map[int64]bool{1: true},
+			expectedEdited: map[int64]string{},
+			expectedAdded:  []string{expectedContent},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &fakeCommentsClient{comments: tt.comments, forbidden: tt.forbidden, edited: map[int64]string{}}
+			err := UpsertPullRequestComment(ctx, client, owner, repo1, 1, "scan-summary", "New summary")
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedEdited, client.edited)
+			assert.Equal(t, tt.expectedAdded, client.added)
+		})
+	}
+}
+
+func TestUpsertPullRequestCommentInvalidMarker(t *testing.T) {
+	ctx := context.Background()
+	client := &fakeCommentsClient{edited: map[int64]string{}}
+	assertMissingParam(t, UpsertPullRequestComment(ctx, client, owner, repo1, 1, "", "content"), "marker")
+	assert.Error(t, UpsertPullRequestComment(ctx, client, owner, repo1, 1, "scan (summary)", "content"))
+	assert.Empty(t, client.added)
+}
@@ -205,7 +205,7 @@ func TestRequiredParams_ListPullRequestLabels(t *testing.T) {
 		for _, tt := range tests {
 			t.Run(p.String()+" "+tt.name, func(t *testing.T) {
 				ctx, client := createClientAndContext(t, p)
-				result, err := client.ListPullRequestLabels(ctx, tt.owner, tt.repo, 0)
+				result, err := client.ListPullRequestLabels(tt.owner, tt.repo, 0).All(ctx)
 				assertMissingParam(t, err, tt.missingParams...)
 				assert.Empty(t, result)
 			})
@@ -237,6 +237,157 @@ func TestRequiredParams_UnlabelPullRequest(t *testing.T) {
 	}
 }
 
+func TestRequiredParams_CommitFiles(t *testing.T) {
+	changes := []CommitFileChange{{Action: CreateFile, Path: "file.txt"}}
+	tests := []struct {
+		name          string
+		owner         string
+		repo          string
+		branch        string
+		message       string
+		changes       []CommitFileChange
+		missingParams []string
+	}{
+		{name: "all empty", changes: changes, missingParams: []string{"owner", "repository", "branch", "message"}},
+		{name: "empty message", owner: "owner", repo: "repo", branch: "branch", changes: changes, missingParams: []string{"message"}},
+		{name: "empty path", owner: "owner", repo: "repo", branch: "branch", message: "message",
+			changes: []CommitFileChange{{Action: UpdateFile}}, missingParams: []string{"path"}},
+		{name: "empty previous path", owner: "owner", repo: "repo", branch: "branch", message: "message",
+			changes: []CommitFileChange{{Action: MoveFile, Path: "file.txt"}}, missingParams: []string{"previous path"}},
+	}
+
+	for _, p := range getAllProviders() {
+		for _, tt := range tests {
+			t.Run(p.String()+" "+tt.name, func(t *testing.T) {
+				ctx, client := createClientAndContext(t, p)
+				err := client.CommitFiles(ctx, tt.owner, tt.repo, tt.branch, tt.message, tt.changes, CommitAuthor{})
+				assertMissingParam(t, err, tt.missingParams...)
+			})
+		}
+		t.Run(p.String()+" no changes", func(t *testing.T) {
+			ctx, client := createClientAndContext(t, p)
+			assert.Error(t, client.CommitFiles(ctx, "owner", "repo", "branch", "message", nil, CommitAuthor{}))
+		})
+	}
+}
+
+func TestRequiredParams_CreateTag(t *testing.T) {
+	tests := []struct {
+		name          string
+		owner         string
+		repo          string
+		tag           string
+		fromRef       string
+		missingParams []string
+	}{
+		{name: "all empty", missingParams: []string{"owner", "repository", "tag", "fromRef"}},
+		{name: "empty tag", owner: "owner", repo: "repo", fromRef: "master", missingParams: []string{"tag"}},
+		{name: "empty fromRef", owner: "owner", repo: "repo", tag: "v1.0.0", missingParams: []string{"fromRef"}},
+	}
+
+	for _, p := range getAllProviders() {
+		for _, tt := range tests {
+			t.Run(p.String()+" "+tt.name, func(t *testing.T) {
+				ctx, client := createClientAndContext(t, p)
+				err := client.CreateTag(ctx, tt.owner, tt.repo, tt.tag, tt.fromRef, "")
+				assertMissingParam(t, err, tt.missingParams...)
+			})
+		}
+	}
+}
+
+func TestRequiredParams_DeleteTag(t *testing.T) {
+	tests := []struct {
+		name          string
+		owner         string
+		repo          string
+		tag           string
+		missingParams []string
+	}{
+		{name: "all empty", missingParams: []string{"owner", "repository", "tag"}},
+		{name: "empty tag", owner: "owner", repo: "repo", missingParams: []string{"tag"}},
+	}
+
+	for _, p := range getAllProviders() {
+		for _, tt := range tests {
+			t.Run(p.String()+" "+tt.name, func(t *testing.T) {
+				ctx, client := createClientAndContext(t, p)
+				err := client.DeleteTag(ctx, tt.owner, tt.repo, tt.tag)
+				assertMissingParam(t, err, tt.missingParams...)
+			})
+		}
+	}
+}
+
+func TestRequiredParams_CreateRelease(t *testing.T) {
+	tests := []struct {
+		name          string
+		owner         string
+		repo          string
+		release       CreateReleaseOptions
+		missingParams []string
+	}{
+		{name: "all empty", missingParams: []string{"owner", "repository", "tag name"}},
+		{name: "empty tag name", owner: "owner", repo: "repo", release: CreateReleaseOptions{Name: "Release"}, missingParams: []string{"tag name"}},
+	}
+
+	for _, p := range getNonBitbucketProviders() {
+		for _, tt := range tests {
+			t.Run(p.String()+" "+tt.name, func(t *testing.T) {
+				ctx, client := createClientAndContext(t, p)
+				result, err := client.CreateRelease(ctx, tt.owner, tt.repo, tt.release)
+				assertMissingParam(t, err, tt.missingParams...)
+				assert.Empty(t, result)
+			})
+		}
+	}
+}
+
+func TestRequiredParams_CreateRepository(t *testing.T) {
+	tests := []struct {
+		name          string
+		owner         string
+		options       CreateRepositoryOptions
+		missingParams []string
+	}{
+		{name: "all empty", missingParams: []string{"owner", "repository name"}},
+		{name: "empty name", owner: "owner", options: CreateRepositoryOptions{Description: "description"}, missingParams: []string{"repository name"}},
+	}
+
+	for _, p := range getAllProviders() {
+		for _, tt := range tests {
+			t.Run(p.String()+" "+tt.name, func(t *testing.T) {
+				ctx, client := createClientAndContext(t, p)
+				err := client.CreateRepository(ctx, tt.owner, tt.options)
+				assertMissingParam(t, err, tt.missingParams...)
+			})
+		}
+	}
+}
+
+func TestRequiredParams_DeleteRepository(t *testing.T) {
+	tests := []struct {
+		name          string
+		owner         string
+		repo          string
+		missingParams []string
+	}{
+		{name: "all empty", missingParams: []string{"owner", "repository"}},
+		{name: "empty owner", repo: "repo", missingParams: []string{"owner"}},
+		{name: "empty repo", owner: "owner", missingParams: []string{"repository"}},
+	}
+
+	for _, p := range getAllProviders() {
+		for _, tt := range tests {
+			t.Run(p.String()+" "+tt.name, func(t *testing.T) {
+				ctx, client := createClientAndContext(t, p)
+				err := client.DeleteRepository(ctx, tt.owner, tt.repo)
+				assertMissingParam(t, err, tt.missingParams...)
+			})
+		}
+	}
+}
+
 func createClientAndContext(t *testing.T, provider vcsutils.VcsProvider) (context.Context, VcsClient) {
 	ctx := context.Background()
 	client, err := NewClientBuilder(provider).Build()
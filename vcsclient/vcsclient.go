@@ -2,13 +2,30 @@ package vcsclient
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
+	"regexp"
+	"sort"
 	"strings"
 	"time"
 
+	"github.com/jfrog/gofrog/datastructures"
+
 	"github.com/jfrog/froggit-go/vcsutils"
 )
 
+const (
+	branchRefPrefix = "refs/heads/"
+	tagRefPrefix    = "refs/tags/"
+	// The initial branch of a new repository, on VCS providers which require a branch name to add the README file
+	defaultInitialBranch = "main"
+	initialCommitMessage = "Initial commit"
+	// The number of repositories fetched in a page, which is the maximum on GitHub, GitLab and Bitbucket cloud
+	repositoriesPageSize = 100
+)
+
+var commitSHARegexp = regexp.MustCompile(`^([0-9a-fA-F]{40}|[0-9a-fA-F]{64})$`)
+
 // CommitStatus the status of the commit in the VCS
 type CommitStatus int
 
@@ -21,6 +38,10 @@ const (
 	Error
 	// InProgress means than the status check is in progress
 	InProgress
+	// Skipped means that the status check didn't run, and it doesn't block the commit
+	Skipped
+	// Cancelled means that the status check was cancelled before it completed
+	Cancelled
 )
 
 // Permission the ssh key permission on the VCS repository
@@ -45,6 +66,54 @@ const (
 	Private
 )
 
+// CommitFileAction the action performed on a file by a commit
+type CommitFileAction int
+
+const (
+	// CreateFile adds a new file
+	CreateFile CommitFileAction = iota
+	// UpdateFile replaces the content of an existing file
+	UpdateFile
+	// DeleteFile removes an existing file
+	DeleteFile
+	// MoveFile moves an existing file to a new path
+	MoveFile
+)
+
+// ReviewCommentSide the side of the pull request diff a review comment is attached to
+type ReviewCommentSide int
+
+const (
+	// RightSide is the new version of the file, containing the added and unchanged lines
+	RightSide ReviewCommentSide = iota
+	// LeftSide is the previous version of the file, containing the removed lines
+	LeftSide
+)
+
+// AnnotationLevel the severity of a check run annotation
+type AnnotationLevel int
+
+const (
+	// NoticeAnnotation is an informative annotation
+	NoticeAnnotation AnnotationLevel = iota
+	// WarningAnnotation is an annotation of a problem which doesn't fail the check
+	WarningAnnotation
+	// FailureAnnotation is an annotation of a problem which fails the check
+	FailureAnnotation
+)
+
+// WebhookDeliveryStatus the result of the last delivery of a webhook
+type WebhookDeliveryStatus int
+
+const (
+	// DeliveryUnknown the webhook wasn't delivered yet, or the VCS provider doesn't return the last delivery
+	DeliveryUnknown WebhookDeliveryStatus = iota
+	// DeliverySucceeded the payload URL accepted the last delivery
+	DeliverySucceeded
+	// DeliveryFailed the payload URL couldn't be reached or responded with an error to the last delivery
+	DeliveryFailed
+)
+
 // VcsInfo is the connection details of the VcsClient to communicate with the server
 type VcsInfo struct {
 	APIEndpoint string
@@ -61,7 +130,8 @@ type RepositoryEnvironmentInfo struct {
 	Reviewers []string
 }
 
-// VcsClient is a base class of all Vcs clients - GitHub, GitLab, Bitbucket server and cloud clients
+// VcsClient is a base class of all Vcs clients - GitHub, GitLab, Bitbucket server and cloud clients.
+// The list methods which return a Pager fetch the pages of the list only while its items are iterated.
 type VcsClient interface {
 	// TestConnection Returns nil if connection and authorization established successfully
 	TestConnection(ctx context.Context) error
@@ -69,10 +139,78 @@ type VcsClient interface {
 	// ListRepositories Returns a map between all accessible owners to their list of repositories
 	ListRepositories(ctx context.Context) (map[string][]string, error)
 
+	// StreamRepositories Returns the accessible repositories matching the options.
+	// The repositories are sent to the returned channel while their pages are fetched.
+	// The channel is closed after the last repository, after a result with an error, or when the context is done.
+	// options - Filters of the listed repositories
+	StreamRepositories(ctx context.Context, options ListRepositoriesOptions) <-chan RepositoryResult
+
 	// ListBranches Lists all branches under the input repository
 	// owner      - User or organization
 	// repository - VCS repository name
-	ListBranches(ctx context.Context, owner, repository string) ([]string, error)
+	ListBranches(owner, repository string) *Pager[string]
+
+	// CreateBranch Creates a new branch
+	// owner      - User or organization
+	// repository - VCS repository name
+	// branch     - The name of the new branch
+	// fromRef    - The commit SHA or the branch name to create the new branch from
+	CreateBranch(ctx context.Context, owner, repository, branch, fromRef string) error
+
+	// DeleteBranch Deletes a branch
+	// owner      - User or organization
+	// repository - VCS repository name
+	// branch     - The name of the branch to delete
+	DeleteBranch(ctx context.Context, owner, repository, branch string) error
+
+	// GetBranch Gets the head commit of a branch, and whether the branch is protected or is the default branch
+	// owner      - User or organization
+	// repository - VCS repository name
+	// branch     - The branch name
+	GetBranch(ctx context.Context, owner, repository, branch string) (BranchDetails, error)
+
+	// CommitFiles Commits changes of multiple files to a branch, without cloning the repository.
+	// On Bitbucket server, every file is committed separately, so the changes are neither a single commit nor atomic.
+	// owner      - User or organization
+	// repository - VCS repository name
+	// branch     - The branch to commit to
+	// message    - The commit message
+	// changes    - The files to create, update, delete or move
+	// author     - The commit author. If empty, the authenticated user is the author
+	CommitFiles(ctx context.Context, owner, repository, branch, message string, changes []CommitFileChange, author CommitAuthor) error
+
+	// ListTags Lists all the tags of the repository
+	// owner      - User or organization
+	// repository - VCS repository name
+	ListTags(owner, repository string) *Pager[TagInfo]
+
+	// CreateTag Creates a tag. The tag is annotated if a message is provided, and lightweight otherwise.
+	// owner      - User or organization
+	// repository - VCS repository name
+	// tag        - The name of the new tag
+	// fromRef    - The commit SHA or the branch name to tag
+	// message    - The message of an annotated tag, or empty for a lightweight tag
+	CreateTag(ctx context.Context, owner, repository, tag, fromRef, message string) error
+
+	// DeleteTag Deletes a tag
+	// owner      - User or organization
+	// repository - VCS repository name
+	// tag        - The name of the tag to delete
+	DeleteTag(ctx context.Context, owner, repository, tag string) error
+
+	// CreateRelease Creates a release, and uploads its assets.
+	// On GitHub, the assets are uploaded after the release is created, and the release is deleted if an upload fails.
+	// Returns UnsupportedFeatureError if the VCS provider doesn't support releases.
+	// owner      - User or organization
+	// repository - VCS repository name
+	// release    - The release details
+	CreateRelease(ctx context.Context, owner, repository string, release CreateReleaseOptions) (ReleaseInfo, error)
+
+	// ListReleases Lists all the releases of the repository.
+	// Returns UnsupportedFeatureError if the VCS provider doesn't support releases.
+	// owner      - User or organization
+	// repository - VCS repository name
+	ListReleases(owner, repository string) *Pager[ReleaseInfo]
 
 	// CreateWebhook Creates a webhook
 	// owner         - User or organization
@@ -99,15 +237,88 @@ type VcsClient interface {
 	// webhookID    - The webhook ID returned from a previous CreateWebhook command
 	DeleteWebhook(ctx context.Context, owner, repository, webhookID string) error
 
+	// ListWebhooks Lists the webhooks of the repository
+	// Returns UnsupportedFeatureError if the VCS provider doesn't support listing webhooks.
+	// owner      - User or organization
+	// repository - VCS repository name
+	ListWebhooks(owner, repository string) *Pager[WebhookInfo]
+
+	// GetWebhook Gets a webhook of the repository
+	// Returns UnsupportedFeatureError if the VCS provider doesn't support getting webhooks.
+	// owner      - User or organization
+	// repository - VCS repository name
+	// webhookID  - The webhook ID returned from a previous CreateWebhook command
+	GetWebhook(ctx context.Context, owner, repository, webhookID string) (WebhookInfo, error)
+
+	// PingWebhook Sends a test delivery of a webhook to its payload URL.
+	// Returns UnsupportedFeatureError if the VCS provider doesn't support test deliveries.
+	// owner      - User or organization
+	// repository - VCS repository name
+	// webhookID  - The webhook ID returned from a previous CreateWebhook command
+	PingWebhook(ctx context.Context, owner, repository, webhookID string) error
+
+	// CreateOrganizationWebhook Creates a webhook which is triggered by the events of all the repositories of an organization,
+	// including repositories created after the webhook.
+	// Returns UnsupportedFeatureError if the VCS provider doesn't support organization webhooks.
+	// owner         - GitHub organization, GitLab group, Bitbucket server project key or Bitbucket cloud workspace
+	// payloadURL    - URL to send the payload when a webhook event occurs
+	// webhookEvents - The event type
+	// Return the webhook ID, token and an error, if occurred
+	CreateOrganizationWebhook(ctx context.Context, owner, payloadURL string, webhookEvents ...vcsutils.WebhookEvent) (string, string, error)
+
+	// UpdateOrganizationWebhook Updates an organization webhook
+	// owner         - GitHub organization, GitLab group, Bitbucket server project key or Bitbucket cloud workspace
+	// payloadURL    - URL to send the payload when a webhook event occurs
+	// token         - A token used to validate identity of the incoming webhook
+	// webhookID     - The webhook ID returned from a previous CreateOrganizationWebhook command
+	// webhookEvents - The event type
+	UpdateOrganizationWebhook(ctx context.Context, owner, payloadURL, token, webhookID string, webhookEvents ...vcsutils.WebhookEvent) error
+
+	// DeleteOrganizationWebhook Deletes an organization webhook
+	// owner     - GitHub organization, GitLab group, Bitbucket server project key or Bitbucket cloud workspace
+	// webhookID - The webhook ID returned from a previous CreateOrganizationWebhook command
+	DeleteOrganizationWebhook(ctx context.Context, owner, webhookID string) error
+
+	// ListOrganizationWebhooks Lists the webhooks of an organization
+	// owner - GitHub organization, GitLab group, Bitbucket server project key or Bitbucket cloud workspace
+	ListOrganizationWebhooks(owner string) *Pager[WebhookInfo]
+
 	// SetCommitStatus Sets commit status
-	// commitStatus - One of Pass, Fail, Error, or InProgress
-	// owner        - User or organization
-	// repository   - VCS repository name
-	// ref          - SHA, a branch name, or a tag name.
-	// title        - Title of the commit status
-	// description  - Description of the commit status
-	// detailsUrl   - The URL for component status link
-	SetCommitStatus(ctx context.Context, commitStatus CommitStatus, owner, repository, ref, title, description, detailsURL string) error
+	// owner      - User or organization
+	// repository - VCS repository name
+	// ref        - SHA, a branch name, or a tag name. A commit SHA on Bitbucket, and a SHA or a branch name on Azure Repos.
+	// status     - The commit status details
+	SetCommitStatus(ctx context.Context, owner, repository, ref string, status CommitStatusOptions) error
+
+	// ListCommitStatuses Lists the latest status of each context reported on a commit
+	// owner      - User or organization
+	// repository - VCS repository name
+	// ref        - SHA, a branch name, or a tag name.
+	ListCommitStatuses(owner, repository, ref string) *Pager[CommitStatusInfo]
+
+	// GetCombinedCommitStatus Gets the combined state of all the statuses reported on a commit.
+	// On GitHub, the check runs of the commit are combined with its statuses.
+	// owner      - User or organization
+	// repository - VCS repository name
+	// ref        - SHA, a branch name, or a tag name.
+	GetCombinedCommitStatus(ctx context.Context, owner, repository, ref string) (CombinedCommitStatus, error)
+
+	// CreateCheckRun Creates a check run on a commit, with a summary and file annotations, and returns the check run ID.
+	// On Bitbucket, the check run is a Code Insights report, and its ID is the check run name.
+	// On GitLab, the check run is a commit status without the annotations, and its ID is the check run name.
+	// Returns UnsupportedFeatureError if the VCS provider doesn't support check runs.
+	// owner      - User or organization
+	// repository - VCS repository name
+	// checkRun   - The check run details
+	CreateCheckRun(ctx context.Context, owner, repository string, checkRun CheckRunOptions) (string, error)
+
+	// UpdateCheckRun Updates a check run, and adds the annotations to its annotations.
+	// The check run is completed when its state isn't InProgress.
+	// owner      - User or organization
+	// repository - VCS repository name
+	// checkRunID - The check run ID returned from a previous CreateCheckRun command
+	// checkRun   - The check run details
+	UpdateCheckRun(ctx context.Context, owner, repository, checkRunID string, checkRun CheckRunOptions) error
 
 	// DownloadRepository Downloads and extracts a VCS repository
 	// owner      - User or organization
@@ -136,12 +347,53 @@ type VcsClient interface {
 	// owner          - User or organization
 	// repository     - VCS repository name
 	// pullRequestID  - Pull request ID
-	ListPullRequestComments(ctx context.Context, owner, repository string, pullRequestID int) ([]CommentInfo, error)
+	ListPullRequestComments(owner, repository string, pullRequestID int) *Pager[CommentInfo]
+
+	// EditPullRequestComment Replaces the content of an existing comment on the requested pull request
+	// owner          - User or organization
+	// repository     - VCS repository name
+	// pullRequestID  - Pull request ID
+	// commentID      - The comment ID, as returned by ListPullRequestComments
+	// content        - The new comment content
+	EditPullRequestComment(ctx context.Context, owner, repository string, pullRequestID int, commentID int64, content string) error
+
+	// DeletePullRequestComment Deletes a comment from the requested pull request
+	// owner          - User or organization
+	// repository     - VCS repository name
+	// pullRequestID  - Pull request ID
+	// commentID      - The comment ID, as returned by ListPullRequestComments
+	DeletePullRequestComment(ctx context.Context, owner, repository string, pullRequestID int, commentID int64) error
+
+	// AddPullRequestReviewComments Adds new review comments on specific lines of the pull request diff
+	// owner          - User or organization
+	// repository     - VCS repository name
+	// pullRequestID  - Pull request ID
+	// comments       - The review comments to add
+	AddPullRequestReviewComments(ctx context.Context, owner, repository string, pullRequestID int, comments []ReviewComment) error
+
+	// ListPullRequestReviewComments Gets all review comments attached to lines of the pull request diff.
+	// owner          - User or organization
+	// repository     - VCS repository name
+	// pullRequestID  - Pull request ID
+	ListPullRequestReviewComments(owner, repository string, pullRequestID int) *Pager[ReviewCommentInfo]
+
+	// ListPullRequestFiles Gets the files changed by a pull request, with the number of added and removed lines.
+	// The diff hunks are not included.
+	// owner          - User or organization
+	// repository     - VCS repository name
+	// pullRequestID  - Pull request ID
+	ListPullRequestFiles(owner, repository string, pullRequestID int) *Pager[vcsutils.FileDiff]
+
+	// GetPullRequestDiff Gets the changes of a pull request, including the parsed diff hunks of every file
+	// owner          - User or organization
+	// repository     - VCS repository name
+	// pullRequestID  - Pull request ID
+	GetPullRequestDiff(ctx context.Context, owner, repository string, pullRequestID int) ([]vcsutils.FileDiff, error)
 
 	// ListOpenPullRequests Gets all open pull requests ids.
 	// owner          - User or organization
 	// repository     - VCS repository name
-	ListOpenPullRequests(ctx context.Context, owner, repository string) ([]PullRequestInfo, error)
+	ListOpenPullRequests(owner, repository string) *Pager[PullRequestInfo]
 
 	// GetLatestCommit Gets the most recent commit of a branch
 	// owner      - User or organization
@@ -162,12 +414,40 @@ type VcsClient interface {
 	// repository - VCS repository name
 	GetRepositoryInfo(ctx context.Context, owner, repository string) (RepositoryInfo, error)
 
+	// CreateRepository Creates a new repository
+	// owner      - User or organization. On Azure Repos, the repository is created in the project of the client
+	// options    - The repository name, description, visibility and initial content
+	CreateRepository(ctx context.Context, owner string, options CreateRepositoryOptions) error
+
+	// ForkRepository Forks a repository. The fork has the same name as the forked repository.
+	// owner       - User or organization
+	// repository  - VCS repository name
+	// targetOwner - The user or organization to create the fork in. On Azure Repos, this is the target project.
+	//               If empty, the VCS provider default is used, usually the authenticated user
+	ForkRepository(ctx context.Context, owner, repository, targetOwner string) error
+
+	// ArchiveRepository Makes a repository read-only
+	// owner      - User or organization
+	// repository - VCS repository name
+	ArchiveRepository(ctx context.Context, owner, repository string) error
+
+	// DeleteRepository Deletes a repository
+	// owner      - User or organization
+	// repository - VCS repository name
+	DeleteRepository(ctx context.Context, owner, repository string) error
+
 	// GetCommitBySha Gets the commit by its SHA
 	// owner      - User or organization
 	// repository - VCS repository name
 	// sha        - The commit hash
 	GetCommitBySha(ctx context.Context, owner, repository, sha string) (CommitInfo, error)
 
+	// ListCommits Lists the commits of a repository, starting from the most recent commit
+	// owner      - User or organization
+	// repository - VCS repository name
+	// options    - The listed branch or ref, and the filters of the listed commits
+	ListCommits(owner, repository string, options ListCommitsOptions) *Pager[CommitInfo]
+
 	// CreateLabel Creates a label in repository
 	// owner      - User or organization
 	// repository - VCS repository name
@@ -180,11 +460,36 @@ type VcsClient interface {
 	// name       - Label name
 	GetLabel(ctx context.Context, owner, repository, name string) (*LabelInfo, error)
 
+	// ListLabels Gets all labels of a repository
+	// owner      - User or organization
+	// repository - VCS repository name
+	ListLabels(owner, repository string) *Pager[LabelInfo]
+
+	// UpdateLabel Updates a label in repository
+	// owner      - User or organization
+	// repository - VCS repository name
+	// name       - The current label name
+	// labelInfo  - The updated label info. The label is renamed if the name is different
+	UpdateLabel(ctx context.Context, owner, repository, name string, labelInfo LabelInfo) error
+
+	// DeleteLabel Deletes a label from repository
+	// owner      - User or organization
+	// repository - VCS repository name
+	// name       - Label name
+	DeleteLabel(ctx context.Context, owner, repository, name string) error
+
+	// AddPullRequestLabels Assigns labels to a pull request
+	// owner         - User or organization
+	// repository    - VCS repository name
+	// pullRequestID - Pull request ID
+	// labels        - Label names
+	AddPullRequestLabels(ctx context.Context, owner, repository string, pullRequestID int, labels ...string) error
+
 	// ListPullRequestLabels Gets all labels assigned to a pull request.
 	// owner         - User or organization
 	// repository    - VCS repository name
 	// pullRequestID - Pull request ID
-	ListPullRequestLabels(ctx context.Context, owner, repository string, pullRequestID int) ([]string, error)
+	ListPullRequestLabels(owner, repository string, pullRequestID int) *Pager[string]
 
 	// UnlabelPullRequest Removes a label from a pull request
 	// owner         - User or organization
@@ -219,6 +524,14 @@ type VcsClient interface {
 	// refBefore     - A VCS reference: commit SHA, branch name, tag name
 	// refAfter      - A VCS reference: commit SHA, branch name, tag name
 	GetModifiedFiles(ctx context.Context, owner, repository, refBefore, refAfter string) ([]string, error)
+
+	// GetFileChanges returns the files changed between two VCS references, with the change status of every file.
+	// Unlike GetModifiedFiles, renamed files are returned once, with both the new and the previous paths.
+	// owner         - User or organization
+	// repository    - VCS repository name
+	// refBefore     - A VCS reference: commit SHA, branch name, tag name
+	// refAfter      - A VCS reference: commit SHA, branch name, tag name
+	GetFileChanges(ctx context.Context, owner, repository, refBefore, refAfter string) ([]FileChange, error)
 }
 
 // CommitInfo contains the details of a commit
@@ -227,16 +540,125 @@ type CommitInfo struct {
 	Hash string
 	// The author's name
 	AuthorName string
+	// The author's email
+	AuthorEmail string
 	// The committer's name
 	CommitterName string
+	// The committer's email
+	CommitterEmail string
 	// The commit URL
 	Url string
+	// The URL of the commit page in the VCS provider UI
+	WebURL string
 	// Seconds from epoch
 	Timestamp int64
+	// The time the commit was authored
+	AuthorDate time.Time
+	// The time the commit was committed
+	CommitDate time.Time
 	// The commit message
 	Message string
 	// The SHA-1 hashes of the parent commits
 	ParentHashes []string
+	// The verification of the commit signature, or nil if it isn't returned by the VCS provider
+	Verification *CommitVerification
+	// The changes of the commit, or nil if they aren't returned by the VCS provider
+	Stats *CommitStats
+}
+
+// CommitVerification is the verification status of a GPG or SSH commit signature
+type CommitVerification struct {
+	Verified bool
+	// The verification status as returned by the VCS provider, for example "valid" or "unsigned" on GitHub
+	Reason string
+}
+
+// CommitStats contains the number of changed lines and files of a commit
+type CommitStats struct {
+	Additions int
+	Deletions int
+	// The number of changed files. Zero on GitLab, which doesn't return it
+	FilesChanged int
+}
+
+// WebhookInfo contains the details of a repository webhook
+type WebhookInfo struct {
+	// The webhook ID, as returned from CreateWebhook
+	ID string
+	// The URL the payload is sent to, without the token added to it by CreateWebhook
+	PayloadURL string
+	// The events which trigger the webhook
+	Events []vcsutils.WebhookEvent
+	Active bool
+	// The result of the last delivery. DeliveryUnknown on GitLab and Bitbucket cloud, which don't return it.
+	LastDeliveryStatus WebhookDeliveryStatus
+}
+
+// CommitStatusOptions contains the details of a commit status
+type CommitStatusOptions struct {
+	// One of Pass, Fail, Error, InProgress, Skipped or Cancelled
+	State CommitStatus
+	// The key which identifies the status. Setting a status with the same key replaces the previous status.
+	// On GitHub and GitLab, the key is also the displayed status context.
+	// On Azure Repos, the key is split on its last '/' to the genre and the name of the status context.
+	Key string
+	// The displayed name of the status on Bitbucket. The key is displayed if the name is empty.
+	Name        string
+	Description string
+	// The URL of the status details
+	DetailsURL string
+	// The number of the pipeline or the build which reported the status.
+	// Sent to Bitbucket server, and to GitLab as the pipeline ID.
+	BuildNumber string
+}
+
+// CommitStatusInfo is a status reported on a commit by a CI system or another integration
+type CommitStatusInfo struct {
+	State CommitStatus
+	// The context of the status, or its key on VCS providers which don't have a status context
+	Context     string
+	Description string
+	// The URL of the status details
+	DetailsURL    string
+	CreatedAt     time.Time
+	LastUpdatedAt time.Time
+}
+
+// CheckRunOptions contains the details of a check run, which publishes the result of a check on a commit
+type CheckRunOptions struct {
+	// The name of the check
+	Name string
+	// The SHA of the checked commit
+	CommitSHA string
+	// InProgress while the check runs. Any other state completes the check run with the matching conclusion.
+	State CommitStatus
+	// The URL of the check details
+	DetailsURL string
+	Title      string
+	// The check result summary, in Markdown
+	Summary     string
+	Annotations []CheckRunAnnotation
+}
+
+// CheckRunAnnotation is a finding of a check run, attached to lines of a file
+type CheckRunAnnotation struct {
+	// The file path, relative to the repository root
+	Path      string
+	StartLine int
+	// The last line of the annotation, or zero for a single line annotation.
+	// Bitbucket annotations are attached only to their start line.
+	EndLine int
+	Level   AnnotationLevel
+	Title   string
+	Message string
+}
+
+// CombinedCommitStatus is the combined state of the statuses reported on a commit
+type CombinedCommitStatus struct {
+	// Fail if any status failed, Error if any status had an error, Cancelled if any status was cancelled,
+	// InProgress if any status is in progress or if there are no statuses, and Pass otherwise
+	State    CommitStatus
+	Statuses []CommitStatusInfo
 }
 
 type CommentInfo struct {
@@ -245,6 +667,31 @@ type CommentInfo struct {
 	Created time.Time
 }
 
+// ReviewComment is a comment attached to a line, or a range of lines, of a file in the pull request diff
+type ReviewComment struct {
+	// The file path, relative to the repository root
+	Path string
+	// The line the comment is attached to. For a range of lines, this is the last line of the range
+	Line int
+	// The first line of a range of lines. Zero for a single line comment
+	StartLine int
+	// The side of the diff the line numbers refer to
+	Side ReviewCommentSide
+	// The comment content
+	Content string
+}
+
+// ReviewCommentInfo contains the details of a review comment attached to the pull request diff
+type ReviewCommentInfo struct {
+	ID        int64
+	Content   string
+	Created   time.Time
+	Path      string
+	Line      int
+	StartLine int
+	Side      ReviewCommentSide
+}
+
 type PullRequestInfo struct {
 	ID     int64
 	Source BranchInfo
@@ -256,10 +703,301 @@ type BranchInfo struct {
 	Repository string
 }
 
+// BranchDetails contains the details of a branch
+type BranchDetails struct {
+	Name string
+	// The SHA of the head commit of the branch
+	CommitSHA string
+	// True if the branch has protection rules, such as restricted pushes or required reviews
+	Protected bool
+	// True if the branch is the default branch of the repository
+	Default bool
+}
+
+// CommitFileChange is a change of a single file in a commit
+type CommitFileChange struct {
+	Action CommitFileAction
+	// The file path
+	Path string
+	// The path of the file before it was moved. Required only by MoveFile
+	PreviousPath string
+	// The file content. A moved file is written with this content to the new path. Ignored by DeleteFile
+	Content []byte
+}
+
+// CommitAuthor contains the name and email of a commit author
+type CommitAuthor struct {
+	Name  string
+	Email string
+}
+
+// TagInfo contains the details of a tag
+type TagInfo struct {
+	Name string
+	// The SHA of the tagged commit
+	CommitSHA string
+}
+
+// CreateReleaseOptions contains the details of a new release
+type CreateReleaseOptions struct {
+	// The tag of the release. The tag is created if it doesn't exist
+	TagName string
+	// The commit SHA or the branch name to create the tag from. Required only if the tag doesn't exist
+	TargetRef string
+	// The release title
+	Name string
+	// The release notes, in markdown
+	Notes string
+	// The paths of local files to upload as the release assets
+	AssetPaths []string
+}
+
+// ReleaseInfo contains the details of a release
+type ReleaseInfo struct {
+	TagName string
+	Name    string
+	// The release notes, in markdown
+	Notes     string
+	CreatedAt time.Time
+	Assets    []ReleaseAsset
+}
+
+// ReleaseAsset is a file attached to a release
+type ReleaseAsset struct {
+	Name string
+	// The download URL of the file
+	URL string
+}
+
+// UnsupportedFeatureError is returned when the VCS provider doesn't support the requested feature
+type UnsupportedFeatureError struct {
+	Provider vcsutils.VcsProvider
+	// The unsupported feature, for example "releases"
+	Feature string
+}
+
+func (err *UnsupportedFeatureError) Error() string {
+	return fmt.Sprintf("%s are not supported on %s", err.Feature, err.Provider)
+}
+
+// CreateRepositoryOptions contains the details of a new repository
+type CreateRepositoryOptions struct {
+	Name        string
+	Description string
+	// Bitbucket doesn't support internal repositories, which are created as private.
+	// On Azure Repos, the visibility is defined by the project.
+	Visibility RepositoryVisibility
+	// The name of the default branch. If empty, the VCS provider default is used.
+	// Empty repositories have no branches, so on GitHub, Bitbucket cloud and Azure Repos it is used only with InitReadme.
+	DefaultBranch string
+	// Adds a README file in an initial commit on the default branch
+	InitReadme bool
+}
+
 // RepositoryInfo contains general information about repository.
 type RepositoryInfo struct {
 	CloneInfo            CloneInfo
 	RepositoryVisibility RepositoryVisibility
+	// The default branch name. Empty if the repository has no branches
+	DefaultBranch string
+	Description   string
+	// The URL of the repository page in the VCS provider UI
+	WebURL string
+	// Not supported on Bitbucket and Azure Repos
+	Topics []string
+	// Not supported on Bitbucket cloud and Azure Repos
+	Archived bool
+	Fork     bool
+	// The repository this repository was forked from. Nil unless the repository is a fork
+	Parent *RepositoryReference
+	// The repository size in bytes. Zero if the VCS provider doesn't return it
+	Size int64
+	// Zero if the VCS provider doesn't return it
+	CreatedAt time.Time
+	// The time of the last push. Zero if the VCS provider doesn't return it
+	LastPushedAt time.Time
+	// The primary programming language. Empty if the VCS provider doesn't detect it
+	Language string
+}
+
+// ListRepositoriesOptions contains the filters of the listed repositories. Empty filters match all the repositories.
+type ListRepositoriesOptions struct {
+	// User, organization, group, workspace or project of the repositories
+	Owner      string
+	Visibility *RepositoryVisibility
+	Archived   *bool
+	// Lists only the repositories pushed to since this time, according to RepositoryInfo.LastPushedAt.
+	// Not supported on Bitbucket server and Azure Repos
+	UpdatedSince time.Time
+	// Lists only the repositories whose name contains the search term, ignoring case
+	Search string
+}
+
+// RepositoryListItem contains the owner, the name and the information of a listed repository
+type RepositoryListItem struct {
+	Owner string
+	Name  string
+	RepositoryInfo
+}
+
+// RepositoryResult is a listed repository, or the error which stopped the listing
+type RepositoryResult struct {
+	Repository RepositoryListItem
+	Err        error
+}
+
+func (options ListRepositoriesOptions) matches(repository RepositoryListItem) bool {
+	switch {
+	case options.Owner != "" && !strings.EqualFold(options.Owner, repository.Owner):
+		return false
+	case options.Visibility != nil && *options.Visibility != repository.RepositoryVisibility:
+		return false
+	case options.Archived != nil && *options.Archived != repository.Archived:
+		return false
+	case !options.UpdatedSince.IsZero() && repository.LastPushedAt.Before(options.UpdatedSince):
+		return false
+	}
+	return strings.Contains(strings.ToLower(repository.Name), strings.ToLower(options.Search))
+}
+
+// ListCommitsOptions contains the listed branch or ref, and the filters of the listed commits. Empty filters match all the commits.
+type ListCommitsOptions struct {
+	// The branch, tag or commit SHA whose history is listed. The default branch is listed by default
+	Ref string
+	// Lists only the commits which changed the file or the directory
+	Path string
+	// Lists only the commits of the author, matched by name or email
+	Author string
+	// Lists only the commits committed at or after this time
+	Since time.Time
+	// Lists only the commits committed at or before this time
+	Until time.Time
+	// The number of commits fetched in each page. The VCS provider's default is used by default
+	PageSize int
+}
+
+// matchesCommit is used by the VCS providers which don't filter the listed commits by the author or by the commit time
+func (options ListCommitsOptions) matchesCommit(authorName, authorEmail string, committedAt time.Time) bool {
+	switch {
+	case options.Author != "" && !strings.EqualFold(options.Author, authorName) && !strings.EqualFold(options.Author, authorEmail):
+		return false
+	case !options.Since.IsZero() && committedAt.Before(options.Since):
+		return false
+	case !options.Until.IsZero() && committedAt.After(options.Until):
+		return false
+	}
+	return true
+}
+
+// committedBeforeSince is used by the VCS providers which don't filter the listed commits by the commit time,
+// to stop listing the commits once they are older than Since
+func (options ListCommitsOptions) committedBeforeSince(committedAt time.Time) bool {
+	return !options.Since.IsZero() && committedAt.Before(options.Since)
+}
+
+// newCombinedCommitStatus combines the statuses of a commit. The combined state is the most severe state of the statuses,
+// or InProgress if the commit has no statuses.
+func newCombinedCommitStatus(statuses []CommitStatusInfo) CombinedCommitStatus {
+	combined := CombinedCommitStatus{State: Pass, Statuses: statuses}
+	if len(statuses) == 0 {
+		combined.State = InProgress
+	}
+	for _, status := range statuses {
+		if getCommitStatusPriority(status.State) > getCommitStatusPriority(combined.State) {
+			combined.State = status.State
+		}
+	}
+	return combined
+}
+
+func getCommitStatusPriority(state CommitStatus) int {
+	switch state {
+	case Fail:
+		return 4
+	case Error:
+		return 3
+	case Cancelled:
+		return 2
+	case InProgress:
+		return 1
+	}
+	return 0
+}
+
+// getCombinedCommitStatus lists all the statuses of the pager and combines them
+func getCombinedCommitStatus(ctx context.Context, statuses *Pager[CommitStatusInfo]) (CombinedCommitStatus, error) {
+	allStatuses, err := statuses.All(ctx)
+	if err != nil {
+		return CombinedCommitStatus{}, err
+	}
+	return newCombinedCommitStatus(allStatuses), nil
+}
+
+// splitToBatches splits the items to batches of up to batchSize items.
+// At least one batch is returned, so that an empty list of items is also sent in a request.
+func splitToBatches[T any](items []T, batchSize int) [][]T {
+	batches := [][]T{}
+	for start := 0; start == 0 || start < len(items); start += batchSize {
+		end := start + batchSize
+		if end > len(items) {
+			end = len(items)
+		}
+		batches = append(batches, items[start:end])
+	}
+	return batches
+}
+
+// appendMissing appends the values which aren't already in the slice
+func appendMissing[T comparable](slice []T, values ...T) []T {
+	for _, value := range values {
+		found := false
+		for _, existing := range slice {
+			if existing == value {
+				found = true
+				break
+			}
+		}
+		if !found {
+			slice = append(slice, value)
+		}
+	}
+	return slice
+}
+
+// streamRepositories iterates over the repositories in a new goroutine, and sends the repositories matching the options to the returned channel
+func streamRepositories(ctx context.Context, options ListRepositoriesOptions, repositories *Pager[RepositoryListItem]) <-chan RepositoryResult {
+	results := make(chan RepositoryResult)
+	send := func(result RepositoryResult) bool {
+		select {
+		case results <- result:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+	go func() {
+		defer close(results)
+		for {
+			repository, ok, err := repositories.Next(ctx)
+			if err != nil {
+				send(RepositoryResult{Err: err})
+				return
+			}
+			if !ok {
+				return
+			}
+			if options.matches(repository) && !send(RepositoryResult{Repository: repository}) {
+				return
+			}
+		}
+	}()
+	return results
+}
+
+// RepositoryReference identifies a repository
+type RepositoryReference struct {
+	Owner string
+	Name  string
 }
 
 // CloneInfo contains URLs that can be used to clone the repository.
@@ -278,6 +1016,95 @@ type LabelInfo struct {
 	Color string
 }
 
+// FileChange contains the path and the change status of a file changed between two VCS references
+type FileChange struct {
+	// The file path. For a removed file, this is the path before the removal
+	Path string
+	// The path before the file was renamed. Empty unless the file is renamed
+	PreviousPath string
+	Status       vcsutils.FileStatus
+}
+
+// getFileChangesPaths returns the sorted paths of the changed files, including the previous paths of renamed files
+func getFileChangesPaths(fileChanges []FileChange) []string {
+	fileNamesSet := datastructures.MakeSet[string]()
+	for _, fileChange := range fileChanges {
+		fileNamesSet.Add(fileChange.Path)
+		fileNamesSet.Add(fileChange.PreviousPath)
+	}
+	_ = fileNamesSet.Remove("") // Make sure there are no blank filepath.
+	fileNamesList := fileNamesSet.ToSlice()
+	sort.Strings(fileNamesList)
+	return fileNamesList
+}
+
+// sortFileChanges sorts the file changes by their paths
+func sortFileChanges(fileChanges []FileChange) {
+	sort.Slice(fileChanges, func(i, j int) bool {
+		return fileChanges[i].Path < fileChanges[j].Path
+	})
+}
+
+// isCommitSHA returns true if the reference is a full SHA-1 or SHA-256 commit hash, rather than a branch or a tag name
+func isCommitSHA(ref string) bool {
+	return commitSHARegexp.MatchString(ref)
+}
+
+// encodeFileContent encodes the file content in base64, so binary files can be committed through JSON APIs
+func encodeFileContent(content []byte) string {
+	return base64.StdEncoding.EncodeToString(content)
+}
+
+// getInitialBranch returns the branch of the README file of a new repository
+func getInitialBranch(options CreateRepositoryOptions) string {
+	if options.DefaultBranch == "" {
+		return defaultInitialBranch
+	}
+	return options.DefaultBranch
+}
+
+// createReadmeFileChange returns the README file added to a new repository, when the VCS provider can't add it by itself
+func createReadmeFileChange(options CreateRepositoryOptions) CommitFileChange {
+	content := "# " + options.Name + "\n"
+	if options.Description != "" {
+		content += "\n" + options.Description + "\n"
+	}
+	return CommitFileChange{Action: CreateFile, Path: "README.md", Content: []byte(content)}
+}
+
+func validateCommitFileChanges(changes []CommitFileChange) error {
+	if len(changes) == 0 {
+		return fmt.Errorf("validation failed: at least one file change is required")
+	}
+	for _, change := range changes {
+		if err := validateParametersNotBlank(map[string]string{"path": change.Path}); err != nil {
+			return err
+		}
+		switch change.Action {
+		case CreateFile, UpdateFile, DeleteFile:
+		case MoveFile:
+			if err := validateParametersNotBlank(map[string]string{"previous path": change.PreviousPath}); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("validation failed: unknown action %d for the file '%s'", change.Action, change.Path)
+		}
+	}
+	return nil
+}
+
+func validateReviewComments(comments []ReviewComment) error {
+	for _, comment := range comments {
+		if err := validateParametersNotBlank(map[string]string{"path": comment.Path, "content": comment.Content}); err != nil {
+			return err
+		}
+		if comment.Line <= 0 {
+			return fmt.Errorf("validation failed: the line of the review comment on '%s' must be positive", comment.Path)
+		}
+	}
+	return nil
+}
+
 func validateParametersNotBlank(paramNameValueMap map[string]string) error {
 	var errorMessages []string
 	for k, v := range paramNameValueMap {
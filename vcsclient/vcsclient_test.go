@@ -0,0 +1,121 @@
+package vcsclient
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jfrog/froggit-go/vcsutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListRepositoriesOptions_Matches(t *testing.T) {
+	pushedAt := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	repository := RepositoryListItem{
+		Owner:          "JFrog",
+		Name:           "Froggit-Go",
+		RepositoryInfo: RepositoryInfo{RepositoryVisibility: Internal, Archived: true, LastPushedAt: pushedAt},
+	}
+	tests := []struct {
+		name    string
+		options ListRepositoriesOptions
+		matches bool
+	}{
+		{name: "empty", options: ListRepositoriesOptions{}, matches: true},
+		{name: "owner", options: ListRepositoriesOptions{Owner: "jfrog"}, matches: true},
+		{name: "other owner", options: ListRepositoriesOptions{Owner: "frogger"}, matches: false},
+		{name: "visibility", options: ListRepositoriesOptions{Visibility: vcsutils.PointerOf(Internal)}, matches: true},
+		{name: "other visibility", options: ListRepositoriesOptions{Visibility: vcsutils.PointerOf(Private)}, matches: false},
+		{name: "archived", options: ListRepositoriesOptions{Archived: vcsutils.PointerOf(true)}, matches: true},
+		{name: "not archived", options: ListRepositoriesOptions{Archived: vcsutils.PointerOf(false)}, matches: false},
+		{name: "updated since", options: ListRepositoriesOptions{UpdatedSince: pushedAt}, matches: true},
+		{name: "not updated since", options: ListRepositoriesOptions{UpdatedSince: pushedAt.Add(time.Second)}, matches: false},
+		{name: "search", options: ListRepositoriesOptions{Search: "git-go"}, matches: true},
+		{name: "other search", options: ListRepositoriesOptions{Search: "gitlab"}, matches: false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.matches, test.options.matches(repository))
+		})
+	}
+}
+
+func TestListCommitsOptions_MatchesCommit(t *testing.T) {
+	committedAt := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		options ListCommitsOptions
+		matches bool
+	}{
+		{name: "empty", options: ListCommitsOptions{}, matches: true},
+		{name: "author name", options: ListCommitsOptions{Author: "frogger"}, matches: true},
+		{name: "author email", options: ListCommitsOptions{Author: "Frogger@Example.com"}, matches: true},
+		{name: "other author", options: ListCommitsOptions{Author: "toad"}, matches: false},
+		{name: "since", options: ListCommitsOptions{Since: committedAt}, matches: true},
+		{name: "not since", options: ListCommitsOptions{Since: committedAt.Add(time.Second)}, matches: false},
+		{name: "until", options: ListCommitsOptions{Until: committedAt}, matches: true},
+		{name: "not until", options: ListCommitsOptions{Until: committedAt.Add(-time.Second)}, matches: false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.matches, test.options.matchesCommit("Frogger", "frogger@example.com", committedAt))
+		})
+	}
+}
+
+func TestStreamRepositories(t *testing.T) {
+	newRepositoriesPager := func() *Pager[RepositoryListItem] {
+		return newNumberedPager(1, func(_ context.Context, page int) ([]RepositoryListItem, int, error) {
+			if page == 1 {
+				return []RepositoryListItem{{Owner: owner, Name: repo1}, {Owner: owner, Name: "other"}}, 2, nil
+			}
+			if page == 2 {
+				return []RepositoryListItem{{Owner: owner, Name: repo2}}, 3, nil
+			}
+			return nil, 0, errors.New("listing failed")
+		})
+	}
+	repositories, err := collectRepositories(streamRepositories(context.Background(), ListRepositoriesOptions{Search: "repo"}, newRepositoriesPager()))
+	assert.EqualError(t, err, "listing failed")
+	assert.Equal(t, []RepositoryListItem{{Owner: owner, Name: repo1}, {Owner: owner, Name: repo2}}, repositories)
+
+	// The listing stops when the context is canceled
+	ctx, cancel := context.WithCancel(context.Background())
+	results := streamRepositories(ctx, ListRepositoriesOptions{}, newRepositoriesPager())
+	assert.Equal(t, repo1, (<-results).Repository.Name)
+	cancel()
+	for range results {
+	}
+}
+
+func TestNewCombinedCommitStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		states   []CommitStatus
+		expected CommitStatus
+	}{
+		{name: "no statuses", states: nil, expected: InProgress},
+		{name: "passed", states: []CommitStatus{Pass, Pass}, expected: Pass},
+		{name: "skipped", states: []CommitStatus{Pass, Skipped}, expected: Pass},
+		{name: "in progress", states: []CommitStatus{Pass, InProgress}, expected: InProgress},
+		{name: "cancelled", states: []CommitStatus{InProgress, Cancelled}, expected: Cancelled},
+		{name: "error", states: []CommitStatus{InProgress, Error, Pass}, expected: Error},
+		{name: "failed", states: []CommitStatus{Error, Fail, InProgress}, expected: Fail},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var statuses []CommitStatusInfo
+			for _, state := range test.states {
+				statuses = append(statuses, CommitStatusInfo{State: state})
+			}
+			assert.Equal(t, test.expected, newCombinedCommitStatus(statuses).State)
+		})
+	}
+}
+
+func TestSplitToBatches(t *testing.T) {
+	assert.Equal(t, [][]int{{}}, splitToBatches([]int{}, 2))
+	assert.Equal(t, [][]int{{1, 2}}, splitToBatches([]int{1, 2}, 2))
+	assert.Equal(t, [][]int{{1, 2}, {3, 4}, {5}}, splitToBatches([]int{1, 2, 3, 4, 5}, 2))
+}
@@ -50,4 +50,20 @@ const (
 	PrOpened WebhookEvent = "PrOpened"
 	// Push a commit is pushed to the source branch
 	Push WebhookEvent = "Push"
+	// TagPushed a tag is pushed
+	TagPushed WebhookEvent = "TagPushed"
+	// TagDeleted a tag is deleted
+	TagDeleted WebhookEvent = "TagDeleted"
+	// PrCommentAdded a comment is added to a pull request
+	PrCommentAdded WebhookEvent = "PrCommentAdded"
+	// PrReviewSubmitted a review which doesn't approve the pull request is submitted
+	PrReviewSubmitted WebhookEvent = "PrReviewSubmitted"
+	// PrApproved a reviewer approved the pull request
+	PrApproved WebhookEvent = "PrApproved"
+	// BranchCreated a branch is created by a push
+	BranchCreated WebhookEvent = "BranchCreated"
+	// BranchDeleted a branch is deleted by a push
+	BranchDeleted WebhookEvent = "BranchDeleted"
+	// ReleasePublished a release is published
+	ReleasePublished WebhookEvent = "ReleasePublished"
 )
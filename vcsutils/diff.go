@@ -0,0 +1,298 @@
+package vcsutils
+
+import (
+	"bufio"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// FileStatus the status of a file changed between two versions of a repository
+type FileStatus string
+
+const (
+	// FileAdded the file was added
+	FileAdded FileStatus = "added"
+	// FileModified the file content was modified
+	FileModified FileStatus = "modified"
+	// FileRemoved the file was removed
+	FileRemoved FileStatus = "removed"
+	// FileRenamed the file was renamed or moved, and possibly modified
+	FileRenamed FileStatus = "renamed"
+)
+
+// DiffLineType the type of line in a diff hunk
+type DiffLineType int
+
+const (
+	// ContextLine is an unchanged line
+	ContextLine DiffLineType = iota
+	// AddedLine is a line which exists only in the new version of the file
+	AddedLine
+	// RemovedLine is a line which exists only in the previous version of the file
+	RemovedLine
+)
+
+const devNull = "/dev/null"
+
+var hunkHeaderRegexp = regexp.MustCompile(`^@@ -(\d+)(?:,(\d+))? \+(\d+)(?:,(\d+))? @@ ?(.*)$`)
+
+// FileDiff contains the changes of a single file
+type FileDiff struct {
+	// The file path. For a removed file, this is the path before the removal
+	Path string
+	// The path before the file was renamed. Empty unless the file is renamed
+	PreviousPath string
+	Status       FileStatus
+	// The number of added lines
+	Additions int
+	// The number of removed lines
+	Deletions int
+	// Binary files have no hunks
+	Binary bool
+	Hunks  []DiffHunk
+}
+
+// DiffHunk is a continuous block of changes in a file
+type DiffHunk struct {
+	// The first line of the hunk in the previous version of the file
+	OldStart int
+	// The number of lines the hunk covers in the previous version of the file
+	OldLines int
+	// The first line of the hunk in the new version of the file
+	NewStart int
+	// The number of lines the hunk covers in the new version of the file
+	NewLines int
+	// The text following the hunk range, usually the enclosing function
+	Section string
+	// The lines of the hunk. Empty if the VCS provider doesn't return the lines content
+	Lines []DiffLine
+}
+
+// DiffLine is a single line of a diff hunk
+type DiffLine struct {
+	Type DiffLineType
+	// The line content, without the leading diff marker
+	Content string
+	// The line number in the previous version of the file. Zero for added lines
+	OldLine int
+	// The line number in the new version of the file. Zero for removed lines
+	NewLine int
+}
+
+// ParseUnifiedDiff parses a unified diff of multiple files, as produced by 'git diff'
+func ParseUnifiedDiff(diff string) ([]FileDiff, error) {
+	var fileDiffs []FileDiff
+	var current *FileDiff
+	scanner := newDiffScanner(diff)
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case strings.HasPrefix(line, "diff "):
+			fileDiffs = appendFileDiff(fileDiffs, current)
+			current = &FileDiff{Status: FileModified}
+			current.Path, current.PreviousPath = parseDiffGitHeader(line)
+		case current == nil:
+			// Skip any preamble before the first file, such as a commit message
+			if !strings.HasPrefix(line, "--- ") {
+				continue
+			}
+			// A unified diff without the 'diff --git' header
+			current = &FileDiff{Status: FileModified}
+			parseFileDiffHeaderLine(current, line)
+		case strings.HasPrefix(line, "--- ") && len(current.Hunks) > 0:
+			// The next file of a unified diff without the 'diff --git' headers
+			fileDiffs = appendFileDiff(fileDiffs, current)
+			current = &FileDiff{Status: FileModified}
+			parseFileDiffHeaderLine(current, line)
+		case strings.HasPrefix(line, "@@"):
+			hunk, err := parseHunk(line, scanner)
+			if err != nil {
+				return nil, err
+			}
+			current.Hunks = append(current.Hunks, hunk)
+		default:
+			parseFileDiffHeaderLine(current, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return appendFileDiff(fileDiffs, current), nil
+}
+
+// ParseDiffHunks parses the hunks of a single file diff. Any line before the first hunk is ignored.
+func ParseDiffHunks(patch string) ([]DiffHunk, error) {
+	var hunks []DiffHunk
+	scanner := newDiffScanner(patch)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.HasPrefix(line, "@@") {
+			continue
+		}
+		hunk, err := parseHunk(line, scanner)
+		if err != nil {
+			return nil, err
+		}
+		hunks = append(hunks, hunk)
+	}
+	return hunks, scanner.Err()
+}
+
+// CountDiffChanges returns the number of added and removed lines in the hunks
+func CountDiffChanges(hunks []DiffHunk) (additions, deletions int) {
+	for _, hunk := range hunks {
+		for _, line := range hunk.Lines {
+			switch line.Type {
+			case AddedLine:
+				additions++
+			case RemovedLine:
+				deletions++
+			}
+		}
+	}
+	return
+}
+
+func newDiffScanner(diff string) *bufio.Scanner {
+	scanner := bufio.NewScanner(strings.NewReader(diff))
+	// Lines of minified files may exceed the default token size
+	scanner.Buffer(make([]byte, 0, 64*1024), 10*1024*1024)
+	return scanner
+}
+
+func appendFileDiff(fileDiffs []FileDiff, fileDiff *FileDiff) []FileDiff {
+	if fileDiff == nil {
+		return fileDiffs
+	}
+	fileDiff.Additions, fileDiff.Deletions = CountDiffChanges(fileDiff.Hunks)
+	if fileDiff.Status != FileRenamed {
+		fileDiff.PreviousPath = ""
+	}
+	return append(fileDiffs, *fileDiff)
+}
+
+// parseDiffGitHeader extracts the paths from a 'diff --git a/<previous path> b/<path>' line
+func parseDiffGitHeader(line string) (path, previousPath string) {
+	fields := strings.TrimPrefix(strings.TrimPrefix(line, "diff --git "), "diff ")
+	// When the paths are identical, the header can be split in the middle even if the path contains spaces
+	if len(fields)%2 == 1 {
+		middle := len(fields) / 2
+		previous, current := trimDiffPathPrefix(fields[:middle]), trimDiffPathPrefix(fields[middle+1:])
+		if fields[middle] == ' ' && previous == current {
+			return current, previous
+		}
+	}
+	for _, separator := range []string{" b/", " dst://"} {
+		if index := strings.LastIndex(fields, separator); index >= 0 {
+			return trimDiffPathPrefix(fields[index+1:]), trimDiffPathPrefix(fields[:index])
+		}
+	}
+	return "", ""
+}
+
+func parseFileDiffHeaderLine(fileDiff *FileDiff, line string) {
+	switch {
+	case strings.HasPrefix(line, "new file mode"):
+		fileDiff.Status = FileAdded
+	case strings.HasPrefix(line, "deleted file mode"):
+		fileDiff.Status = FileRemoved
+	case strings.HasPrefix(line, "rename from "):
+		fileDiff.Status = FileRenamed
+		fileDiff.PreviousPath = strings.TrimPrefix(line, "rename from ")
+	case strings.HasPrefix(line, "rename to "):
+		fileDiff.Status = FileRenamed
+		fileDiff.Path = strings.TrimPrefix(line, "rename to ")
+	case strings.HasPrefix(line, "Binary files ") || strings.HasPrefix(line, "GIT binary patch"):
+		fileDiff.Binary = true
+	case strings.HasPrefix(line, "--- "):
+		if previousPath := parseDiffFilePath(strings.TrimPrefix(line, "--- ")); previousPath == devNull {
+			fileDiff.Status = FileAdded
+		} else {
+			fileDiff.PreviousPath = previousPath
+		}
+	case strings.HasPrefix(line, "+++ "):
+		if path := parseDiffFilePath(strings.TrimPrefix(line, "+++ ")); path == devNull {
+			fileDiff.Status = FileRemoved
+			fileDiff.Path = fileDiff.PreviousPath
+		} else {
+			fileDiff.Path = path
+		}
+	}
+}
+
+func parseDiffFilePath(path string) string {
+	// Some tools append a tab separated timestamp to the path
+	path, _, _ = strings.Cut(path, "\t")
+	if path == devNull {
+		return path
+	}
+	return trimDiffPathPrefix(path)
+}
+
+func trimDiffPathPrefix(path string) string {
+	for _, prefix := range []string{"a/", "b/", "src://", "dst://"} {
+		if strings.HasPrefix(path, prefix) {
+			return strings.TrimPrefix(path, prefix)
+		}
+	}
+	return path
+}
+
+// parseHunk parses the hunk header, and consumes the hunk lines from the scanner
+func parseHunk(header string, scanner *bufio.Scanner) (DiffHunk, error) {
+	matches := hunkHeaderRegexp.FindStringSubmatch(header)
+	if matches == nil {
+		return DiffHunk{}, fmt.Errorf("invalid diff hunk header: '%s'", header)
+	}
+	hunk := DiffHunk{
+		OldStart: parseHunkRangeNumber(matches[1], 0),
+		OldLines: parseHunkRangeNumber(matches[2], 1),
+		NewStart: parseHunkRangeNumber(matches[3], 0),
+		NewLines: parseHunkRangeNumber(matches[4], 1),
+		Section:  matches[5],
+	}
+	oldLine, newLine := hunk.OldStart, hunk.NewStart
+	oldRemaining, newRemaining := hunk.OldLines, hunk.NewLines
+	for (oldRemaining > 0 || newRemaining > 0) && scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			// Some tools trim the whitespace of empty context lines
+			line = " "
+		}
+		diffLine := DiffLine{Content: line[1:]}
+		switch line[0] {
+		case ' ':
+			diffLine.Type, diffLine.OldLine, diffLine.NewLine = ContextLine, oldLine, newLine
+			oldLine++
+			newLine++
+			oldRemaining--
+			newRemaining--
+		case '-':
+			diffLine.Type, diffLine.OldLine = RemovedLine, oldLine
+			oldLine++
+			oldRemaining--
+		case '+':
+			diffLine.Type, diffLine.NewLine = AddedLine, newLine
+			newLine++
+			newRemaining--
+		case '\\':
+			// "\ No newline at end of file"
+			continue
+		default:
+			return DiffHunk{}, fmt.Errorf("invalid line in diff hunk '%s': '%s'", header, line)
+		}
+		hunk.Lines = append(hunk.Lines, diffLine)
+	}
+	return hunk, nil
+}
+
+func parseHunkRangeNumber(value string, defaultValue int) int {
+	if value == "" {
+		return defaultValue
+	}
+	// The regular expression makes sure the value is a number
+	number, _ := strconv.Atoi(value)
+	return number
+}
@@ -0,0 +1,105 @@
+package vcsutils
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseUnifiedDiff(t *testing.T) {
+	diff, err := os.ReadFile(filepath.Join("testdata", "pull_request.diff"))
+	require.NoError(t, err)
+
+	fileDiffs, err := ParseUnifiedDiff(string(diff))
+	require.NoError(t, err)
+	assert.Equal(t, []FileDiff{
+		{
+			Path:      "src/main.go",
+			Status:    FileModified,
+			Additions: 2,
+			Deletions: 1,
+			Hunks: []DiffHunk{{
+				OldStart: 1, OldLines: 5, NewStart: 1, NewLines: 6, Section: "package main",
+				Lines: []DiffLine{
+					{Type: ContextLine, Content: `import "fmt"`, OldLine: 1, NewLine: 1},
+					{Type: ContextLine, Content: "", OldLine: 2, NewLine: 2},
+					{Type: ContextLine, Content: "func main() {", OldLine: 3, NewLine: 3},
+					{Type: RemovedLine, Content: `	fmt.Println("hello")`, OldLine: 4},
+					{Type: AddedLine, Content: `	fmt.Println("hello, world")`, NewLine: 4},
+					{Type: AddedLine, Content: `	fmt.Println("bye")`, NewLine: 5},
+					{Type: ContextLine, Content: "}", OldLine: 5, NewLine: 6},
+				},
+			}},
+		},
+		{
+			Path:      "docs/new file.md",
+			Status:    FileAdded,
+			Additions: 1,
+			Hunks: []DiffHunk{{
+				OldStart: 0, OldLines: 0, NewStart: 1, NewLines: 1,
+				Lines: []DiffLine{{Type: AddedLine, Content: "# New file", NewLine: 1}},
+			}},
+		},
+		{
+			Path:      "old.txt",
+			Status:    FileRemoved,
+			Deletions: 2,
+			Hunks: []DiffHunk{{
+				OldStart: 1, OldLines: 2, NewStart: 0, NewLines: 0,
+				Lines: []DiffLine{
+					{Type: RemovedLine, Content: "first", OldLine: 1},
+					{Type: RemovedLine, Content: "second", OldLine: 2},
+				},
+			}},
+		},
+		{Path: "after.txt", PreviousPath: "before.txt", Status: FileRenamed},
+		{Path: "image.png", Status: FileModified, Binary: true},
+	}, fileDiffs)
+}
+
+func TestParseUnifiedDiffWithoutGitHeaders(t *testing.T) {
+	diff := "--- a.txt\t2023-01-01 10:00:00\n+++ a.txt\t2023-01-02 10:00:00\n@@ -1 +1 @@\n-a\n+b\n" +
+		"--- b.txt\n+++ b.txt\n@@ -2,0 +3 @@\n+c\n"
+
+	fileDiffs, err := ParseUnifiedDiff(diff)
+	require.NoError(t, err)
+	require.Len(t, fileDiffs, 2)
+	assert.Equal(t, "a.txt", fileDiffs[0].Path)
+	assert.Equal(t, 1, fileDiffs[0].Additions)
+	assert.Equal(t, 1, fileDiffs[0].Deletions)
+	assert.Equal(t, "b.txt", fileDiffs[1].Path)
+	assert.Equal(t, []DiffLine{{Type: AddedLine, Content: "c", NewLine: 3}}, fileDiffs[1].Hunks[0].Lines)
+}
+
+func TestParseDiffHunks(t *testing.T) {
+	patch := "@@ -10,3 +10,3 @@ func main() {\n a\n-b\n+c\n d\n@@ -20 +20,2 @@\n e\n+f\n"
+
+	hunks, err := ParseDiffHunks(patch)
+	require.NoError(t, err)
+	require.Len(t, hunks, 2)
+	assert.Equal(t, "func main() {", hunks[0].Section)
+	assert.Equal(t, DiffLine{Type: RemovedLine, Content: "b", OldLine: 11}, hunks[0].Lines[1])
+	assert.Equal(t, DiffLine{Type: AddedLine, Content: "c", NewLine: 11}, hunks[0].Lines[2])
+	assert.Equal(t, DiffHunk{
+		OldStart: 20, OldLines: 1, NewStart: 20, NewLines: 2,
+		Lines: []DiffLine{
+			{Type: ContextLine, Content: "e", OldLine: 20, NewLine: 20},
+			{Type: AddedLine, Content: "f", NewLine: 21},
+		},
+	}, hunks[1])
+
+	additions, deletions := CountDiffChanges(hunks)
+	assert.Equal(t, 2, additions)
+	assert.Equal(t, 1, deletions)
+}
+
+func TestParseDiffHunksInvalid(t *testing.T) {
+	_, err := ParseDiffHunks("@@ -1,2 +1,2 @@\n a\n*b\n")
+	assert.Error(t, err)
+
+	_, err = ParseUnifiedDiff("--- a.txt\n+++ a.txt\n@@ -a +b @@\n")
+	assert.Error(t, err)
+}
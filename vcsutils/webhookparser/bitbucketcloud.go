@@ -31,7 +31,7 @@ func (webhook *bitbucketCloudWebhookParser) validatePayload(_ context.Context, r
 	keys, tokenParamsExist := request.URL.Query()["token"]
 	if len(token) > 0 || tokenParamsExist {
 		if keys[0] != string(token) {
-			return nil, errors.New("token mismatch")
+			return nil, &PayloadValidationError{err: errors.New("token mismatch")}
 		}
 	}
 	payload := new(bytes.Buffer)
@@ -60,6 +60,12 @@ func (webhook *bitbucketCloudWebhookParser) parseIncomingWebhook(_ context.Conte
 		return webhook.parsePrEvents(bitbucketCloudWebHook, vcsutils.PrMerged), nil
 	case "pullrequest:rejected":
 		return webhook.parsePrEvents(bitbucketCloudWebHook, vcsutils.PrRejected), nil
+	case "pullrequest:comment_created":
+		return webhook.parsePrCommentEvent(bitbucketCloudWebHook), nil
+	case "pullrequest:approved":
+		return webhook.parsePrReviewEvent(bitbucketCloudWebHook, vcsutils.PrApproved, bitbucketCloudWebHook.Approval, "approved"), nil
+	case "pullrequest:changes_request_created":
+		return webhook.parsePrReviewEvent(bitbucketCloudWebHook, vcsutils.PrReviewSubmitted, bitbucketCloudWebHook.ChangesRequest, "changes_requested"), nil
 	}
 	return nil, nil
 }
@@ -68,8 +74,11 @@ func (webhook *bitbucketCloudWebhookParser) parsePushEvent(bitbucketCloudWebHook
 	// In Push events, the hook provides a list of changes. Only the first one is relevant in our point of view.
 	firstChange := bitbucketCloudWebHook.Push.Changes[0]
 	lastCommit := firstChange.New.Target
-	beforeCommitHash := webhook.parentOfLastCommit(lastCommit)
-	return &WebhookInfo{
+	beforeCommitHash := firstChange.Old.Target.Hash
+	if beforeCommitHash == "" {
+		beforeCommitHash = webhook.parentOfLastCommit(lastCommit)
+	}
+	webhookInfo := &WebhookInfo{
 		TargetRepositoryDetails: webhook.parseRepoFullName(bitbucketCloudWebHook.Repository.FullName),
 		TargetBranch:            webhook.branchName(firstChange),
 		Timestamp:               lastCommit.Date.UTC().Unix(),
@@ -95,6 +104,28 @@ func (webhook *bitbucketCloudWebhookParser) parsePushEvent(bitbucketCloudWebHook
 		},
 		CompareUrl: webhook.compareURL(bitbucketCloudWebHook, lastCommit, beforeCommitHash),
 	}
+	webhookInfo.setPushEvent(webhook.refType(firstChange) == "tag", webhook.branchName(firstChange))
+	// The commits are listed from the newest to the oldest, without their changed files
+	for i := len(firstChange.Commits) - 1; i >= 0; i-- {
+		commit := firstChange.Commits[i]
+		webhookInfo.Commits = append(webhookInfo.Commits, WebHookInfoCommit{
+			Hash:      commit.Hash,
+			Message:   commit.Message,
+			Url:       commit.Links.Html.Ref,
+			Timestamp: commit.Date.UTC().Unix(),
+			Author:    WebHookInfoUser{Login: commit.Author.User.Nickname, Email: webhook.email(commit)},
+		})
+	}
+	webhookInfo.ChangedFilesIncomplete = webhookInfo.BranchStatus != WebhookInfoBranchStatusDeleted
+	return webhookInfo
+}
+
+// refType returns the type of the pushed ref, branch or tag
+func (webhook *bitbucketCloudWebhookParser) refType(change bitbucketChange) string {
+	if change.New.Type != "" {
+		return change.New.Type
+	}
+	return change.Old.Type
 }
 
 // compareURL generates the HTML URL for the comparison between commits before and after push
@@ -135,7 +166,50 @@ func (webhook *bitbucketCloudWebhookParser) parsePrEvents(bitbucketCloudWebHook
 		SourceRepositoryDetails: webhook.parseRepoFullName(bitbucketCloudWebHook.PullRequest.Source.Repository.FullName),
 		SourceBranch:            bitbucketCloudWebHook.PullRequest.Source.Branch.Name,
 		Timestamp:               bitbucketCloudWebHook.PullRequest.UpdatedOn.UTC().Unix(),
+		DeliveryTimestamp:       deliveryTimestamp(bitbucketCloudWebHook.PullRequest.UpdatedOn),
 		Event:                   event,
+		PullRequest: WebHookInfoPullRequest{
+			Title:       bitbucketCloudWebHook.PullRequest.Title,
+			Description: bitbucketCloudWebHook.PullRequest.Description,
+			Author:      webhook.user(bitbucketCloudWebHook.PullRequest.Author),
+			HeadSha:     bitbucketCloudWebHook.PullRequest.Source.Commit.Hash,
+			BaseSha:     bitbucketCloudWebHook.PullRequest.Destination.Commit.Hash,
+			Url:         bitbucketCloudWebHook.PullRequest.Links.Html.Href,
+			Draft:       bitbucketCloudWebHook.PullRequest.Draft,
+		},
+	}
+}
+
+func (webhook *bitbucketCloudWebhookParser) parsePrCommentEvent(bitbucketCloudWebHook *bitbucketCloudWebHook) *WebhookInfo {
+	webhookInfo := webhook.parsePrEvents(bitbucketCloudWebHook, vcsutils.PrCommentAdded)
+	comment := bitbucketCloudWebHook.Comment
+	webhookInfo.Timestamp = comment.CreatedOn.UTC().Unix()
+	webhookInfo.DeliveryTimestamp = deliveryTimestamp(comment.CreatedOn)
+	webhookInfo.Comment = WebHookInfoComment{
+		ID:     comment.ID,
+		Body:   comment.Content.Raw,
+		Author: webhook.user(comment.User),
+	}
+	return webhookInfo
+}
+
+// Bitbucket cloud has no reviews. A review is an approval or a changes request of a reviewer.
+func (webhook *bitbucketCloudWebhookParser) parsePrReviewEvent(bitbucketCloudWebHook *bitbucketCloudWebHook, event vcsutils.WebhookEvent,
+	review bitbucketCloudReview, state string) *WebhookInfo {
+	webhookInfo := webhook.parsePrEvents(bitbucketCloudWebHook, event)
+	webhookInfo.Timestamp = review.Date.UTC().Unix()
+	webhookInfo.DeliveryTimestamp = deliveryTimestamp(review.Date)
+	webhookInfo.Review = WebHookInfoReview{
+		Reviewer: webhook.user(review.User),
+		State:    state,
+	}
+	return webhookInfo
+}
+
+func (webhook *bitbucketCloudWebhookParser) user(user bitbucketCloudUser) WebHookInfoUser {
+	return WebHookInfoUser{
+		Login:       user.Nickname,
+		DisplayName: user.DisplayName,
 	}
 }
 
@@ -177,13 +251,44 @@ type bitbucketCloudWebHook struct {
 	Actor       struct {
 		Nickname string `json:"nickname,omitempty"`
 	} `json:"actor,omitempty"`
+	Comment        bitbucketCloudComment `json:"comment,omitempty"`
+	Approval       bitbucketCloudReview  `json:"approval,omitempty"`
+	ChangesRequest bitbucketCloudReview  `json:"changes_request,omitempty"`
+}
+
+type bitbucketCloudUser struct {
+	Nickname    string `json:"nickname,omitempty"`
+	DisplayName string `json:"display_name,omitempty"`
+}
+
+type bitbucketCloudComment struct {
+	ID      int64 `json:"id,omitempty"`
+	Content struct {
+		Raw string `json:"raw,omitempty"`
+	} `json:"content,omitempty"`
+	User      bitbucketCloudUser `json:"user,omitempty"`
+	CreatedOn time.Time          `json:"created_on,omitempty"`
+}
+
+type bitbucketCloudReview struct {
+	User bitbucketCloudUser `json:"user,omitempty"`
+	Date time.Time          `json:"date,omitempty"`
 }
 
 type bitbucketPullRequest struct {
 	ID          int                        `json:"id,omitempty"`
+	Title       string                     `json:"title,omitempty"`
+	Description string                     `json:"description,omitempty"`
+	Author      bitbucketCloudUser         `json:"author,omitempty"`
 	Source      bitbucketCloudPrRepository `json:"source,omitempty"`
 	Destination bitbucketCloudPrRepository `json:"destination,omitempty"`
 	UpdatedOn   time.Time                  `json:"updated_on,omitempty"`
+	Draft       bool                       `json:"draft,omitempty"`
+	Links       struct {
+		Html struct {
+			Href string `json:"href,omitempty"` // Pull request URL
+		} `json:"html,omitempty"`
+	} `json:"links,omitempty"`
 }
 
 type bitbucketPush struct {
@@ -192,14 +297,22 @@ type bitbucketPush struct {
 
 type bitbucketChange struct {
 	New struct {
-		// Name is the new branch name
-		Name   string          `json:"name,omitempty"`
+		// Name is the new branch or tag name
+		Name string `json:"name,omitempty"`
+		// Type is branch or tag
+		Type   string          `json:"type,omitempty"`
 		Target bitbucketCommit `json:"target,omitempty"`
 	} `json:"new,omitempty"`
 	Old struct {
-		// Name is the old branch name
-		Name string `json:"name,omitempty"`
+		// Name is the old branch or tag name
+		Name   string `json:"name,omitempty"`
+		Type   string `json:"type,omitempty"`
+		Target struct {
+			Hash string `json:"hash,omitempty"`
+		} `json:"target,omitempty"`
 	} `json:"old,omitempty"`
+	// Commits are the pushed commits, from the newest to the oldest. The list is truncated to 5 commits.
+	Commits []bitbucketCommit `json:"commits,omitempty"`
 }
 
 type bitbucketCommit struct {
@@ -231,4 +344,7 @@ type bitbucketCloudPrRepository struct {
 	Branch     struct {
 		Name string `json:"name,omitempty"` // Branch name
 	} `json:"branch,omitempty"`
+	Commit struct {
+		Hash string `json:"hash,omitempty"` // Last commit hash
+	} `json:"commit,omitempty"`
 }
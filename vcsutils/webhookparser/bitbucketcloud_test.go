@@ -28,7 +28,7 @@ const (
 func TestBitbucketCloudParseIncomingPushWebhook(t *testing.T) {
 	reader, err := os.Open(filepath.Join("testdata", "bitbucketcloud", "pushpayload.json"))
 	require.NoError(t, err)
-	defer close(reader)
+	defer closeReader(reader)
 
 	// Create request
 	request := httptest.NewRequest("POST", "https://127.0.0.1?token="+string(token), reader)
@@ -57,6 +57,10 @@ func TestBitbucketCloudParseIncomingPushWebhook(t *testing.T) {
 		Hash:    "fa8c303777d0006fa99b843b830ad1ed18a6928e",
 		Message: "README.md edited online with Bitbucket",
 	}, actual.Commit)
+	require.Len(t, actual.Commits, 1)
+	assert.Equal(t, actual.Commit.Hash, actual.Commits[0].Hash)
+	assert.Empty(t, actual.ChangedFiles)
+	assert.True(t, actual.ChangedFilesIncomplete)
 	assert.Equal(t, WebHookInfoCommit{
 		Hash: "a2b4032ae25e08844b894e413d80ee75b4c1995b",
 	}, actual.BeforeCommit)
@@ -105,7 +109,7 @@ func TestBitbucketCloudParseIncomingPrWebhook(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			reader, err := os.Open(filepath.Join("testdata", "bitbucketcloud", tt.payloadFilename))
 			require.NoError(t, err)
-			defer close(reader)
+			defer closeReader(reader)
 
 			// Create request
 			request := httptest.NewRequest("POST", "https://127.0.0.1?token="+string(token), reader)
@@ -127,6 +131,7 @@ func TestBitbucketCloudParseIncomingPrWebhook(t *testing.T) {
 			assert.Equal(t, expectedOwner, actual.TargetRepositoryDetails.Owner)
 			assert.Equal(t, expectedBranch, actual.TargetBranch)
 			assert.Equal(t, tt.expectedTime, actual.Timestamp)
+			assert.Equal(t, tt.expectedTime, actual.DeliveryTimestamp)
 			assert.Equal(t, expectedRepoName, actual.SourceRepositoryDetails.Name)
 			assert.Equal(t, expectedOwner, actual.SourceRepositoryDetails.Owner)
 			assert.Equal(t, expectedSourceBranch, actual.SourceBranch)
@@ -154,7 +159,7 @@ func TestBitbucketCloudParseIncomingWebhookError(t *testing.T) {
 func TestBitbucketCloudPayloadMismatchToken(t *testing.T) {
 	reader, err := os.Open(filepath.Join("testdata", "bitbucketcloud", "pushpayload.json"))
 	require.NoError(t, err)
-	defer close(reader)
+	defer closeReader(reader)
 
 	// Create request
 	request := httptest.NewRequest("POST", "https://127.0.0.1?token=wrong-token", reader)
@@ -170,3 +175,114 @@ func TestBitbucketCloudPayloadMismatchToken(t *testing.T) {
 		request)
 	assert.EqualError(t, err, "token mismatch")
 }
+
+func TestBitbucketCloudParseIncomingWebhookEvents(t *testing.T) {
+	pullRequest := `"pullrequest": {"id": 2, "updated_on": "2022-01-01T09:00:00Z",
+		"source": {"branch": {"name": "dev"}, "repository": {"full_name": "yahavi/hello-world"}},
+		"destination": {"branch": {"name": "main"}, "repository": {"full_name": "yahavi/hello-world"}}}`
+	expectedPr := WebhookInfo{
+		PullRequestId:           2,
+		TargetRepositoryDetails: WebHookInfoRepoDetails{Name: "hello-world", Owner: "yahavi"},
+		TargetBranch:            "main",
+		SourceRepositoryDetails: WebHookInfoRepoDetails{Name: "hello-world", Owner: "yahavi"},
+		SourceBranch:            "dev",
+		Timestamp:               1641031200,
+		DeliveryTimestamp:       1641031200,
+	}
+	froggy := WebHookInfoUser{Login: "froggy", DisplayName: "Froggy"}
+	tests := []struct {
+		name            string
+		event           string
+		payload         string
+		expectedEvent   vcsutils.WebhookEvent
+		expectedComment WebHookInfoComment
+		expectedReview  WebHookInfoReview
+	}{
+		{
+			name:  "comment created",
+			event: "pullrequest:comment_created",
+			payload: `{"comment": {"id": 10, "content": {"raw": "LGTM"}, "created_on": "2022-01-01T10:00:00Z",
+				"user": {"nickname": "froggy", "display_name": "Froggy"}}, ` + pullRequest + `}`,
+			expectedEvent:   vcsutils.PrCommentAdded,
+			expectedComment: WebHookInfoComment{ID: 10, Body: "LGTM", Author: froggy},
+		},
+		{
+			name:  "approved",
+			event: "pullrequest:approved",
+			payload: `{"approval": {"date": "2022-01-01T10:00:00Z",
+				"user": {"nickname": "froggy", "display_name": "Froggy"}}, ` + pullRequest + `}`,
+			expectedEvent:  vcsutils.PrApproved,
+			expectedReview: WebHookInfoReview{Reviewer: froggy, State: "approved"},
+		},
+		{
+			name:  "changes requested",
+			event: "pullrequest:changes_request_created",
+			payload: `{"changes_request": {"date": "2022-01-01T10:00:00Z",
+				"user": {"nickname": "froggy", "display_name": "Froggy"}}, ` + pullRequest + `}`,
+			expectedEvent:  vcsutils.PrReviewSubmitted,
+			expectedReview: WebHookInfoReview{Reviewer: froggy, State: "changes_requested"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := httptest.NewRequest("POST", "https://127.0.0.1", nil)
+			request.Header.Add(EventHeaderKey, tt.event)
+			webhook := bitbucketCloudWebhookParser{logger: vcsclient.EmptyLogger{}}
+			actual, err := webhook.parseIncomingWebhook(context.Background(), request, []byte(tt.payload))
+			require.NoError(t, err)
+			expected := expectedPr
+			expected.Event = tt.expectedEvent
+			expected.Comment = tt.expectedComment
+			expected.Review = tt.expectedReview
+			assert.Equal(t, &expected, actual)
+		})
+	}
+}
+
+func TestBitbucketCloudParseIncomingRefWebhook(t *testing.T) {
+	tests := []struct {
+		name           string
+		change         string
+		expectedEvent  vcsutils.WebhookEvent
+		expectedBranch string
+		expectedTag    string
+	}{
+		{name: "branch created", change: `"new": {"type": "branch", "name": "dev"}, "created": true`, expectedEvent: vcsutils.Push, expectedBranch: "dev"},
+		{name: "branch deleted", change: `"old": {"type": "branch", "name": "dev"}, "closed": true`, expectedEvent: vcsutils.Push, expectedBranch: "dev"},
+		{name: "tag pushed", change: `"new": {"type": "tag", "name": "v1.0.0"}, "created": true`, expectedEvent: vcsutils.TagPushed, expectedTag: "v1.0.0"},
+		{name: "tag deleted", change: `"old": {"type": "tag", "name": "v1.0.0"}, "closed": true`, expectedEvent: vcsutils.TagDeleted, expectedTag: "v1.0.0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := httptest.NewRequest("POST", "https://127.0.0.1", nil)
+			request.Header.Add(EventHeaderKey, "repo:push")
+			payload := `{"repository": {"full_name": "yahavi/hello-world"}, "push": {"changes": [{` + tt.change + `}]}}`
+			webhook := bitbucketCloudWebhookParser{logger: vcsclient.EmptyLogger{}}
+			actual, err := webhook.parseIncomingWebhook(context.Background(), request, []byte(payload))
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedEvent, actual.Event)
+			assert.Equal(t, tt.expectedBranch, actual.TargetBranch)
+			assert.Equal(t, tt.expectedTag, actual.TagName)
+		})
+	}
+}
+
+func TestBitbucketCloudParseIncomingPrWebhookDetails(t *testing.T) {
+	payload, err := os.ReadFile(filepath.Join("testdata", "bitbucketcloud", "prcreatepayload.json"))
+	require.NoError(t, err)
+
+	request := httptest.NewRequest("POST", "https://127.0.0.1", nil)
+	request.Header.Add(EventHeaderKey, "pullrequest:created")
+	webhook := bitbucketCloudWebhookParser{logger: vcsclient.EmptyLogger{}}
+	actual, err := webhook.parseIncomingWebhook(context.Background(), request, payload)
+	require.NoError(t, err)
+
+	assert.Equal(t, WebHookInfoPullRequest{
+		Title:       "Dev",
+		Description: "* README.md edited online with Bitbucket\r\n* README.md edited online with Bitbucket\r\n\r\n\u200c",
+		Author:      WebHookInfoUser{Login: "yahavi", DisplayName: "Yahav Itzhak"},
+		HeadSha:     "363994ee7c2e",
+		BaseSha:     "fa8c303777d0",
+		Url:         "https://bitbucket.org/yahavi/hello-world/pull-requests/2",
+	}, actual.PullRequest)
+}
@@ -46,7 +46,7 @@ func (webhook *bitbucketServerWebhookParser) validatePayload(_ context.Context,
 	if len(token) > 0 || len(expectedSignature) > 0 {
 		actualSignature := calculatePayloadSignature(payload.Bytes(), token)
 		if expectedSignature != "sha256="+actualSignature {
-			return nil, errors.New("payload signature mismatch")
+			return nil, &PayloadValidationError{err: errors.New("payload signature mismatch")}
 		}
 	}
 	return payload.Bytes(), nil
@@ -71,6 +71,12 @@ func (webhook *bitbucketServerWebhookParser) parseIncomingWebhook(_ context.Cont
 		return webhook.parsePrEvents(bitbucketServerWebHook, vcsutils.PrMerged)
 	case "pr:declined", "pr:deleted":
 		return webhook.parsePrEvents(bitbucketServerWebHook, vcsutils.PrRejected)
+	case "pr:comment:added":
+		return webhook.parsePrCommentEvent(bitbucketServerWebHook)
+	case "pr:reviewer:approved":
+		return webhook.parsePrReviewEvent(bitbucketServerWebHook, vcsutils.PrApproved)
+	case "pr:reviewer:needs_work":
+		return webhook.parsePrReviewEvent(bitbucketServerWebHook, vcsutils.PrReviewSubmitted)
 	}
 	return nil, nil
 }
@@ -93,10 +99,11 @@ func (webhook *bitbucketServerWebhookParser) parsePushEvent(bitbucketCloudWebHoo
 		commitURL = fmt.Sprintf("%s/projects/%s/repos/%s/commits/%s", webhook.endpoint,
 			repositoryDetails.Owner, repositoryDetails.Name, bitbucketCloudWebHook.Changes[0].ToHash)
 	}
-	return &WebhookInfo{
+	webhookInfo := &WebhookInfo{
 		TargetRepositoryDetails: repositoryDetails,
 		TargetBranch:            strings.TrimPrefix(bitbucketCloudWebHook.Changes[0].RefID, "refs/heads/"),
 		Timestamp:               eventTime.UTC().Unix(),
+		DeliveryTimestamp:       eventTime.UTC().Unix(),
 		Event:                   vcsutils.Push,
 		Commit: WebHookInfoCommit{
 			Hash: bitbucketCloudWebHook.Changes[0].ToHash,
@@ -118,7 +125,12 @@ func (webhook *bitbucketServerWebhookParser) parsePushEvent(bitbucketCloudWebHoo
 			Email:       bitbucketCloudWebHook.Actor.EmailAddress,
 			DisplayName: bitbucketCloudWebHook.Actor.DisplayName,
 		},
-	}, nil
+	}
+	refID := bitbucketCloudWebHook.Changes[0].RefID
+	webhookInfo.setPushEvent(strings.HasPrefix(refID, tagRefPrefix), strings.TrimPrefix(refID, tagRefPrefix))
+	// The payload contains no commits
+	webhookInfo.ChangedFilesIncomplete = webhookInfo.BranchStatus != WebhookInfoBranchStatusDeleted
+	return webhookInfo, nil
 }
 
 func (webhook *bitbucketServerWebhookParser) getRepositoryDetails(repository bitbucketv1.Repository) WebHookInfoRepoDetails {
@@ -140,10 +152,67 @@ func (webhook *bitbucketServerWebhookParser) parsePrEvents(bitbucketCloudWebHook
 		SourceRepositoryDetails: webhook.getRepositoryDetails(bitbucketCloudWebHook.PullRequest.FromRef.Repository),
 		SourceBranch:            strings.TrimPrefix(bitbucketCloudWebHook.PullRequest.FromRef.ID, "refs/heads/"),
 		Timestamp:               eventTime.UTC().Unix(),
+		DeliveryTimestamp:       eventTime.UTC().Unix(),
 		Event:                   event,
+		PullRequest:             webhook.pullRequest(bitbucketCloudWebHook.PullRequest),
 	}, nil
 }
 
+func (webhook *bitbucketServerWebhookParser) pullRequest(pullRequest bitbucketServerPullRequest) WebHookInfoPullRequest {
+	webhookPullRequest := WebHookInfoPullRequest{
+		Title:       pullRequest.Title,
+		Description: pullRequest.Description,
+		HeadSha:     pullRequest.FromRef.LatestCommit,
+		BaseSha:     pullRequest.ToRef.LatestCommit,
+		Draft:       pullRequest.Draft,
+	}
+	if pullRequest.Author != nil {
+		webhookPullRequest.Author = WebHookInfoUser{
+			Login:       pullRequest.Author.User.Name,
+			DisplayName: pullRequest.Author.User.DisplayName,
+			Email:       pullRequest.Author.User.EmailAddress,
+		}
+	}
+	if len(pullRequest.Links.Self) > 0 {
+		webhookPullRequest.Url = pullRequest.Links.Self[0].Href
+	}
+	return webhookPullRequest
+}
+
+func (webhook *bitbucketServerWebhookParser) parsePrCommentEvent(bitbucketServerWebHook *bitbucketServerWebHook) (*WebhookInfo, error) {
+	webhookInfo, err := webhook.parsePrEvents(bitbucketServerWebHook, vcsutils.PrCommentAdded)
+	if err != nil {
+		return nil, err
+	}
+	webhookInfo.Comment = WebHookInfoComment{
+		ID:     bitbucketServerWebHook.Comment.ID,
+		Body:   bitbucketServerWebHook.Comment.Text,
+		Author: webhook.user(bitbucketServerWebHook.Comment.Author),
+	}
+	return webhookInfo, nil
+}
+
+// Bitbucket server has no reviews. A review is a change of the status of a reviewer of the pull request.
+func (webhook *bitbucketServerWebhookParser) parsePrReviewEvent(bitbucketServerWebHook *bitbucketServerWebHook, event vcsutils.WebhookEvent) (*WebhookInfo, error) {
+	webhookInfo, err := webhook.parsePrEvents(bitbucketServerWebHook, event)
+	if err != nil {
+		return nil, err
+	}
+	webhookInfo.Review = WebHookInfoReview{
+		Reviewer: webhook.user(bitbucketServerWebHook.Participant.User),
+		State:    bitbucketServerWebHook.Participant.Status,
+	}
+	return webhookInfo, nil
+}
+
+func (webhook *bitbucketServerWebhookParser) user(actor bitbucketServerWebHookActor) WebHookInfoUser {
+	return WebHookInfoUser{
+		Login:       actor.Name,
+		DisplayName: actor.DisplayName,
+		Email:       actor.EmailAddress,
+	}
+}
+
 func (webhook *bitbucketServerWebhookParser) branchStatus(to string, from string) WebHookInfoBranchStatus {
 	existsAfter := to != gitNilHash
 	existedBefore := from != gitNilHash
@@ -154,9 +223,26 @@ type bitbucketServerWebHook struct {
 	EventKey    string                          `json:"eventKey,omitempty"`
 	Date        string                          `json:"date,omitempty"` // Timestamp
 	Repository  bitbucketv1.Repository          `json:"repository,omitempty"`
-	PullRequest bitbucketv1.PullRequest         `json:"pullRequest,omitempty"`
+	PullRequest bitbucketServerPullRequest      `json:"pullRequest,omitempty"`
 	Changes     []bitbucketServerWebHookChanges `json:"changes,omitempty"`
 	Actor       bitbucketServerWebHookActor     `json:"actor,omitempty"`
+	Comment     bitbucketServerWebHookComment   `json:"comment,omitempty"`
+	Participant struct {
+		User   bitbucketServerWebHookActor `json:"user,omitempty"`
+		Status string                      `json:"status,omitempty"`
+	} `json:"participant,omitempty"`
+}
+
+type bitbucketServerPullRequest struct {
+	bitbucketv1.PullRequest
+	// Draft pull requests are supported since Bitbucket server 8.18
+	Draft bool `json:"draft,omitempty"`
+}
+
+type bitbucketServerWebHookComment struct {
+	ID     int64                       `json:"id,omitempty"`
+	Text   string                      `json:"text,omitempty"`
+	Author bitbucketServerWebHookActor `json:"author,omitempty"`
 }
 
 type bitbucketServerWebHookChanges struct {
@@ -44,7 +44,7 @@ const (
 func TestBitbucketServerParseIncomingPushWebhook(t *testing.T) {
 	reader, err := os.Open(filepath.Join("testdata", "bitbucketserver", "pushpayload.json"))
 	require.NoError(t, err)
-	defer close(reader)
+	defer closeReader(reader)
 
 	// Create request
 	request := httptest.NewRequest("POST", "https://127.0.0.1", reader)
@@ -61,6 +61,7 @@ func TestBitbucketServerParseIncomingPushWebhook(t *testing.T) {
 	assert.Equal(t, formatOwnerForBitbucketServer(expectedOwner), actual.TargetRepositoryDetails.Owner)
 	assert.Equal(t, expectedBranch, actual.TargetBranch)
 	assert.Equal(t, bitbucketServerPushExpectedTime, actual.Timestamp)
+	assert.Equal(t, bitbucketServerPushExpectedTime, actual.DeliveryTimestamp)
 	assert.Equal(t, vcsutils.Push, actual.Event)
 	assert.Equal(t, WebHookInfoUser{DisplayName: "Yahav Itzhak", Email: "yahavi@jfrog.com"}, actual.Author)
 	assert.Equal(t, WebHookInfoUser{DisplayName: "Yahav Itzhak", Email: "yahavi@jfrog.com"}, actual.Committer)
@@ -75,6 +76,7 @@ func TestBitbucketServerParseIncomingPushWebhook(t *testing.T) {
 	}, actual.BeforeCommit)
 	assert.Equal(t, WebhookInfoBranchStatusCreated, actual.BranchStatus)
 	assert.Equal(t, "", actual.CompareUrl)
+	assert.True(t, actual.ChangedFilesIncomplete)
 }
 
 func TestBitbucketServerParseIncomingPrWebhook(t *testing.T) {
@@ -131,7 +133,7 @@ func TestBitbucketServerParseIncomingPrWebhook(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			reader, err := os.Open(filepath.Join("testdata", "bitbucketserver", tt.payloadFilename))
 			require.NoError(t, err)
-			defer close(reader)
+			defer closeReader(reader)
 
 			// Create request
 			request := httptest.NewRequest("POST", "https://127.0.0.1", reader)
@@ -187,7 +189,7 @@ func TestBitbucketServerParsePrEventsError(t *testing.T) {
 func TestBitbucketServerPayloadMismatchSignature(t *testing.T) {
 	reader, err := os.Open(filepath.Join("testdata", "bitbucketserver", "pushpayload.json"))
 	require.NoError(t, err)
-	defer close(reader)
+	defer closeReader(reader)
 
 	// Create request
 	request := httptest.NewRequest("POST", "https://127.0.0.1", reader)
@@ -208,3 +210,116 @@ func TestBitbucketServerPayloadMismatchSignature(t *testing.T) {
 func formatOwnerForBitbucketServer(owner string) string {
 	return fmt.Sprintf("~%s", strings.ToUpper(owner))
 }
+
+func TestBitbucketServerParseIncomingWebhookEvents(t *testing.T) {
+	pullRequest := `"date": "2022-01-01T10:00:00+0000", "pullRequest": {"id": 2,
+		"fromRef": {"id": "refs/heads/dev", "repository": {"slug": "hello-world", "project": {"key": "~YAHAVI"}}},
+		"toRef": {"id": "refs/heads/main", "repository": {"slug": "hello-world", "project": {"key": "~YAHAVI"}}}}`
+	expectedPr := WebhookInfo{
+		PullRequestId:           2,
+		TargetRepositoryDetails: WebHookInfoRepoDetails{Name: "hello-world", Owner: "~YAHAVI"},
+		TargetBranch:            "main",
+		SourceRepositoryDetails: WebHookInfoRepoDetails{Name: "hello-world", Owner: "~YAHAVI"},
+		SourceBranch:            "dev",
+		Timestamp:               1641031200,
+		DeliveryTimestamp:       1641031200,
+	}
+	froggy := WebHookInfoUser{Login: "froggy", DisplayName: "Froggy", Email: "froggy@jfrog.com"}
+	tests := []struct {
+		name            string
+		event           string
+		payload         string
+		expectedEvent   vcsutils.WebhookEvent
+		expectedComment WebHookInfoComment
+		expectedReview  WebHookInfoReview
+	}{
+		{
+			name:  "comment added",
+			event: "pr:comment:added",
+			payload: `{"comment": {"id": 10, "text": "LGTM",
+				"author": {"name": "froggy", "displayName": "Froggy", "emailAddress": "froggy@jfrog.com"}}, ` + pullRequest + `}`,
+			expectedEvent:   vcsutils.PrCommentAdded,
+			expectedComment: WebHookInfoComment{ID: 10, Body: "LGTM", Author: froggy},
+		},
+		{
+			name:  "approved",
+			event: "pr:reviewer:approved",
+			payload: `{"participant": {"status": "APPROVED",
+				"user": {"name": "froggy", "displayName": "Froggy", "emailAddress": "froggy@jfrog.com"}}, ` + pullRequest + `}`,
+			expectedEvent:  vcsutils.PrApproved,
+			expectedReview: WebHookInfoReview{Reviewer: froggy, State: "APPROVED"},
+		},
+		{
+			name:  "needs work",
+			event: "pr:reviewer:needs_work",
+			payload: `{"participant": {"status": "NEEDS_WORK",
+				"user": {"name": "froggy", "displayName": "Froggy", "emailAddress": "froggy@jfrog.com"}}, ` + pullRequest + `}`,
+			expectedEvent:  vcsutils.PrReviewSubmitted,
+			expectedReview: WebHookInfoReview{Reviewer: froggy, State: "NEEDS_WORK"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := httptest.NewRequest("POST", "https://127.0.0.1", nil)
+			request.Header.Add(EventHeaderKey, tt.event)
+			webhook := bitbucketServerWebhookParser{logger: vcsclient.EmptyLogger{}}
+			actual, err := webhook.parseIncomingWebhook(context.Background(), request, []byte(tt.payload))
+			require.NoError(t, err)
+			expected := expectedPr
+			expected.Event = tt.expectedEvent
+			expected.Comment = tt.expectedComment
+			expected.Review = tt.expectedReview
+			assert.Equal(t, &expected, actual)
+		})
+	}
+}
+
+func TestBitbucketServerParseIncomingRefWebhook(t *testing.T) {
+	tests := []struct {
+		name           string
+		refID          string
+		fromHash       string
+		toHash         string
+		expectedEvent  vcsutils.WebhookEvent
+		expectedBranch string
+		expectedTag    string
+	}{
+		{name: "branch deleted", refID: "refs/heads/dev", fromHash: "929d3054", toHash: gitNilHash, expectedEvent: vcsutils.Push, expectedBranch: "dev"},
+		{name: "pushed", refID: "refs/heads/dev", fromHash: "929d3054", toHash: "0c3bb2b8", expectedEvent: vcsutils.Push, expectedBranch: "dev"},
+		{name: "tag pushed", refID: "refs/tags/v1.0.0", fromHash: gitNilHash, toHash: "929d3054", expectedEvent: vcsutils.TagPushed, expectedTag: "v1.0.0"},
+		{name: "tag deleted", refID: "refs/tags/v1.0.0", fromHash: "929d3054", toHash: gitNilHash, expectedEvent: vcsutils.TagDeleted, expectedTag: "v1.0.0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := httptest.NewRequest("POST", "https://127.0.0.1", nil)
+			request.Header.Add(EventHeaderKey, "repo:refs_changed")
+			payload := `{"date": "2022-01-01T10:00:00+0000", "changes": [{"refId": "` + tt.refID + `", "fromHash": "` + tt.fromHash + `", "toHash": "` + tt.toHash + `"}],
+				"repository": {"slug": "hello-world", "project": {"key": "~YAHAVI"}}}`
+			webhook := bitbucketServerWebhookParser{logger: vcsclient.EmptyLogger{}}
+			actual, err := webhook.parseIncomingWebhook(context.Background(), request, []byte(payload))
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedEvent, actual.Event)
+			assert.Equal(t, tt.expectedBranch, actual.TargetBranch)
+			assert.Equal(t, tt.expectedTag, actual.TagName)
+		})
+	}
+}
+
+func TestBitbucketServerParseIncomingPrWebhookDetails(t *testing.T) {
+	payload, err := os.ReadFile(filepath.Join("testdata", "bitbucketserver", "prcreatepayload.json"))
+	require.NoError(t, err)
+
+	request := httptest.NewRequest("POST", "https://127.0.0.1", nil)
+	request.Header.Add(EventHeaderKey, "pr:opened")
+	webhook := bitbucketServerWebhookParser{logger: vcsclient.EmptyLogger{}}
+	actual, err := webhook.parseIncomingWebhook(context.Background(), request, payload)
+	require.NoError(t, err)
+
+	assert.Equal(t, WebHookInfoPullRequest{
+		Title:   "Update README.md",
+		Author:  WebHookInfoUser{Login: "yahavi", DisplayName: "Yahav Itzhak", Email: "yahavi@jfrog.com"},
+		HeadSha: "b3fc2f0a02761b443fca72022a2ac897cc2ceb3a",
+		BaseSha: "929d3054cf60e11a38672966f948bb5d95f48f0e",
+		Url:     "https://git.acme.info/users/yahavi/repos/hello-world/pull-requests/3",
+	}, actual.PullRequest)
+}
@@ -13,6 +13,6 @@ const (
 
 var token = []byte("abc123")
 
-func close(closer io.Closer) {
+func closeReader(closer io.Closer) {
 	_ = closer.Close()
 }
@@ -0,0 +1,59 @@
+package webhookparser
+
+import (
+	"container/list"
+	"sync"
+)
+
+const defaultDeliveryStoreSize = 10000
+
+// DeliveryStore stores the IDs of the handled webhook deliveries, to detect redeliveries and replays.
+// A store can be shared by several handlers, so its implementations must be safe for concurrent use.
+type DeliveryStore interface {
+	// Add adds a delivery ID, and returns false if the ID is already stored
+	Add(deliveryID string) bool
+	// Remove removes a delivery ID, so that the delivery can be handled again
+	Remove(deliveryID string)
+}
+
+// memoryDeliveryStore is an in-memory DeliveryStore, which keeps the most recently added delivery IDs
+type memoryDeliveryStore struct {
+	size         int
+	mutex        sync.Mutex
+	order        *list.List
+	elementsByID map[string]*list.Element
+}
+
+// NewMemoryDeliveryStore creates an in-memory DeliveryStore. When the store is full, the least recently seen delivery ID is evicted.
+// size - The maximal number of stored delivery IDs. If not positive, 10000 IDs are stored
+func NewMemoryDeliveryStore(size int) DeliveryStore {
+	if size <= 0 {
+		size = defaultDeliveryStoreSize
+	}
+	return &memoryDeliveryStore{size: size, order: list.New(), elementsByID: map[string]*list.Element{}}
+}
+
+func (store *memoryDeliveryStore) Add(deliveryID string) bool {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	if element, ok := store.elementsByID[deliveryID]; ok {
+		store.order.MoveToFront(element)
+		return false
+	}
+	store.elementsByID[deliveryID] = store.order.PushFront(deliveryID)
+	if store.order.Len() > store.size {
+		oldest := store.order.Back()
+		store.order.Remove(oldest)
+		delete(store.elementsByID, oldest.Value.(string))
+	}
+	return true
+}
+
+func (store *memoryDeliveryStore) Remove(deliveryID string) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	if element, ok := store.elementsByID[deliveryID]; ok {
+		store.order.Remove(element)
+		delete(store.elementsByID, deliveryID)
+	}
+}
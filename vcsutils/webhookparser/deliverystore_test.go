@@ -0,0 +1,30 @@
+package webhookparser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryDeliveryStore(t *testing.T) {
+	store := NewMemoryDeliveryStore(2)
+	assert.True(t, store.Add("1"))
+	assert.True(t, store.Add("2"))
+	assert.False(t, store.Add("1"))
+
+	// 2 is the least recently seen delivery, so it is evicted
+	assert.True(t, store.Add("3"))
+	assert.False(t, store.Add("1"))
+	assert.False(t, store.Add("3"))
+	assert.True(t, store.Add("2"))
+
+	store.Remove("2")
+	assert.True(t, store.Add("2"))
+	// Removing a missing delivery does nothing
+	store.Remove("4")
+}
+
+func TestMemoryDeliveryStoreDefaultSize(t *testing.T) {
+	store := NewMemoryDeliveryStore(0)
+	assert.Equal(t, defaultDeliveryStoreSize, store.(*memoryDeliveryStore).size)
+}
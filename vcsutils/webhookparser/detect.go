@@ -0,0 +1,84 @@
+package webhookparser
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/jfrog/froggit-go/vcsutils"
+)
+
+const (
+	gitHubEventHeaderKey         = "X-GitHub-Event"
+	gitLabEventHeaderKey         = "X-Gitlab-Event"
+	bitbucketCloudRequestIDKey   = "X-Request-UUID"
+	bitbucketServerRequestIDKey  = "X-Request-Id"
+	azureReposEventTypeFieldName = "eventType"
+)
+
+// DetectVcsProvider detects the VCS provider which sent an incoming webhook HTTP request.
+// The provider is detected by the event headers of GitHub, GitLab and Bitbucket.
+// Azure Repos sends no event header, so a request without them is detected as Azure Repos if its payload has an event type.
+// request - Received HTTP request. The body of the request is read only if the headers don't match, and is restored after reading.
+func DetectVcsProvider(request *http.Request) (vcsutils.VcsProvider, error) {
+	switch {
+	case request.Header.Get(gitHubEventHeaderKey) != "":
+		return vcsutils.GitHub, nil
+	case request.Header.Get(gitLabEventHeaderKey) != "":
+		return vcsutils.GitLab, nil
+	case request.Header.Get(EventHeaderKey) != "":
+		// Both Bitbucket Cloud and Bitbucket Server send the event key header, but with different request ID headers
+		if request.Header.Get(bitbucketCloudRequestIDKey) != "" {
+			return vcsutils.BitbucketCloud, nil
+		}
+		if request.Header.Get(bitbucketServerRequestIDKey) != "" {
+			return vcsutils.BitbucketServer, nil
+		}
+		return 0, errors.New("couldn't detect the VCS provider of the incoming webhook: a Bitbucket event without a request ID header")
+	}
+	if isAzureReposWebhook(request) {
+		return vcsutils.AzureRepos, nil
+	}
+	return 0, errors.New("couldn't detect the VCS provider of the incoming webhook: no known event header or payload")
+}
+
+// DetectOrigin returns a WebhookOriginResolver which detects the VCS provider of incoming webhooks by DetectVcsProvider.
+// Webhooks of providers without an origin in the origins map are rejected.
+// origins - The origin of every accepted VCS provider, with its token and URL. An empty token disables the signature verification of the provider.
+func DetectOrigin(origins map[vcsutils.VcsProvider]WebhookOrigin) WebhookOriginResolver {
+	return func(request *http.Request) (WebhookOrigin, error) {
+		provider, err := DetectVcsProvider(request)
+		if err != nil {
+			return WebhookOrigin{}, err
+		}
+		origin, ok := origins[provider]
+		if !ok {
+			return WebhookOrigin{}, fmt.Errorf("incoming webhooks of %s are not accepted, since no origin is configured for them", provider)
+		}
+		origin.VcsProvider = provider
+		return origin, nil
+	}
+}
+
+// isAzureReposWebhook checks whether the payload of the request is an Azure Repos service hook, which has an event type field
+func isAzureReposWebhook(request *http.Request) bool {
+	if request.Body == nil {
+		return false
+	}
+	payload, err := io.ReadAll(request.Body)
+	_ = request.Body.Close()
+	// The payload is parsed again after the detection
+	request.Body = io.NopCloser(bytes.NewReader(payload))
+	if err != nil {
+		return false
+	}
+	azureReposWebhook := map[string]any{}
+	if err = json.Unmarshal(payload, &azureReposWebhook); err != nil {
+		return false
+	}
+	eventType, ok := azureReposWebhook[azureReposEventTypeFieldName].(string)
+	return ok && eventType != ""
+}
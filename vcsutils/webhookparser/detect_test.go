@@ -0,0 +1,113 @@
+package webhookparser
+
+import (
+	"context"
+	"io"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jfrog/froggit-go/vcsclient"
+	"github.com/jfrog/froggit-go/vcsutils"
+)
+
+func TestDetectVcsProvider(t *testing.T) {
+	tests := []struct {
+		name             string
+		headers          map[string]string
+		body             string
+		expectedProvider vcsutils.VcsProvider
+		expectedError    string
+	}{
+		{name: "GitHub", headers: map[string]string{"X-GitHub-Event": "push"}, expectedProvider: vcsutils.GitHub},
+		{name: "GitLab", headers: map[string]string{"X-Gitlab-Event": "Push Hook"}, expectedProvider: vcsutils.GitLab},
+		{
+			name:             "Bitbucket Cloud",
+			headers:          map[string]string{"X-Event-Key": "repo:push", "X-Request-UUID": "d6b5c0c4-5f5a-4b1a-9c3b-2c4b5d8f1e3a"},
+			expectedProvider: vcsutils.BitbucketCloud,
+		},
+		{
+			name:             "Bitbucket Server",
+			headers:          map[string]string{"X-Event-Key": "repo:refs_changed", "X-Request-Id": "b4a5e0d4-3c2f-4a7e-8a6d-6c2e9f0a1b7c"},
+			expectedProvider: vcsutils.BitbucketServer,
+		},
+		{name: "Azure Repos", body: `{"eventType": "git.push", "publisherId": "tfs"}`, expectedProvider: vcsutils.AzureRepos},
+		{
+			name:          "Bitbucket without request ID",
+			headers:       map[string]string{"X-Event-Key": "repo:push"},
+			expectedError: "couldn't detect the VCS provider of the incoming webhook: a Bitbucket event without a request ID header",
+		},
+		{
+			name:          "unknown",
+			body:          `{"event": "push"}`,
+			expectedError: "couldn't detect the VCS provider of the incoming webhook: no known event header or payload",
+		},
+		{
+			name:          "not JSON",
+			body:          "payload=abc",
+			expectedError: "couldn't detect the VCS provider of the incoming webhook: no known event header or payload",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := httptest.NewRequest("POST", "https://127.0.0.1", strings.NewReader(tt.body))
+			for key, value := range tt.headers {
+				request.Header.Add(key, value)
+			}
+			provider, err := DetectVcsProvider(request)
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedProvider, provider)
+			}
+
+			// The body must be left for the parsing
+			body, err := io.ReadAll(request.Body)
+			require.NoError(t, err)
+			assert.Equal(t, tt.body, string(body))
+		})
+	}
+}
+
+func TestParseIncomingWebhookAuto(t *testing.T) {
+	origins := map[vcsutils.VcsProvider]WebhookOrigin{vcsutils.GitLab: {Token: token}, vcsutils.AzureRepos: {}}
+	reader, err := os.Open(filepath.Join("testdata", "gitlab", "pushpayload.json"))
+	require.NoError(t, err)
+	defer closeReader(reader)
+	request := httptest.NewRequest("POST", "https://127.0.0.1", reader)
+	request.Header.Add(gitLabKeyHeader, string(token))
+	request.Header.Add("X-Gitlab-Event", "Push Hook")
+	actual, err := ParseIncomingWebhookAuto(context.Background(), vcsclient.EmptyLogger{}, origins, request)
+	require.NoError(t, err)
+	assert.Equal(t, vcsutils.Push, actual.Event)
+	assert.Equal(t, expectedRepoName, actual.TargetRepositoryDetails.Name)
+
+	// A provider without a token isn't accepted
+	request = httptest.NewRequest("POST", "https://127.0.0.1", strings.NewReader("{}"))
+	request.Header.Add("X-GitHub-Event", "push")
+	_, err = ParseIncomingWebhookAuto(context.Background(), vcsclient.EmptyLogger{}, origins, request)
+	assert.EqualError(t, err, "incoming webhooks of GitHub are not accepted, since no origin is configured for them")
+
+	// Azure Repos webhooks are detected, but can't be parsed
+	request = httptest.NewRequest("POST", "https://127.0.0.1", strings.NewReader(`{"eventType": "git.push"}`))
+	_, err = ParseIncomingWebhookAuto(context.Background(), vcsclient.EmptyLogger{}, origins, request)
+	assert.EqualError(t, err, "incoming webhooks are not supported on Azure Repos")
+}
+
+func TestDetectOriginURL(t *testing.T) {
+	origins := map[vcsutils.VcsProvider]WebhookOrigin{
+		vcsutils.BitbucketServer: {Token: token, OriginURL: "https://bitbucket.test/rest"},
+	}
+	request := httptest.NewRequest("POST", "https://127.0.0.1", strings.NewReader("{}"))
+	request.Header.Add(EventHeaderKey, "repo:refs_changed")
+	request.Header.Add("X-Request-Id", "b4a5e0d4-3c2f-4a7e-8a6d-6c2e9f0a1b7c")
+	origin, err := DetectOrigin(origins)(request)
+	require.NoError(t, err)
+	assert.Equal(t, WebhookOrigin{VcsProvider: vcsutils.BitbucketServer, Token: token, OriginURL: "https://bitbucket.test/rest"}, origin)
+}
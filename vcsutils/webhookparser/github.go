@@ -1,9 +1,12 @@
 package webhookparser
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"mime"
 	"net/http"
 	"strings"
 
@@ -20,6 +23,9 @@ type gitHubWebhookParser struct {
 	endpoint string
 }
 
+// gitHubMaxPushCommits is the maximal number of commits in the payload of a push event
+const gitHubMaxPushCommits = 20
+
 // newGitHubWebhookParser create a new gitHubWebhookParser instance
 func newGitHubWebhookParser(logger vcsclient.Log, endpoint string) *gitHubWebhookParser {
 	if endpoint == "" {
@@ -40,14 +46,29 @@ func newGitHubWebhookParser(logger vcsclient.Log, endpoint string) *gitHubWebhoo
 
 func (webhook *gitHubWebhookParser) validatePayload(_ context.Context, request *http.Request, token []byte) ([]byte, error) {
 	// Make sure X-Hub-Signature-256 header exist
-	if len(token) > 0 && len(request.Header.Get(github.SHA256SignatureHeader)) == 0 {
-		return nil, errors.New(github.SHA256SignatureHeader + " header is missing")
+	signature := request.Header.Get(github.SHA256SignatureHeader)
+	if len(token) > 0 && len(signature) == 0 {
+		return nil, &PayloadValidationError{err: errors.New(github.SHA256SignatureHeader + " header is missing")}
 	}
 
-	payload, err := github.ValidatePayload(request, token)
+	contentType, _, err := mime.ParseMediaType(request.Header.Get("Content-Type"))
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(request.Body)
 	if err != nil {
 		return nil, err
 	}
+	// Extracts the payload from the body, whose signature is validated separately
+	payload, err := github.ValidatePayloadFromBody(contentType, bytes.NewReader(body), "", nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(token) > 0 {
+		if err = github.ValidateSignature(signature, body, token); err != nil {
+			return nil, &PayloadValidationError{err: err}
+		}
+	}
 	return payload, nil
 }
 
@@ -61,6 +82,14 @@ func (webhook *gitHubWebhookParser) parseIncomingWebhook(_ context.Context, requ
 		return webhook.parsePushEvent(event), nil
 	case *github.PullRequestEvent:
 		return webhook.parsePrEvents(event), nil
+	case *github.IssueCommentEvent:
+		return webhook.parseIssueCommentEvent(event), nil
+	case *github.PullRequestReviewCommentEvent:
+		return webhook.parsePrReviewCommentEvent(event), nil
+	case *github.PullRequestReviewEvent:
+		return webhook.parsePrReviewEvent(event), nil
+	case *github.ReleaseEvent:
+		return webhook.parseReleaseEvent(event), nil
 	}
 	return nil, nil
 }
@@ -75,7 +104,7 @@ func (webhook *gitHubWebhookParser) parsePushEvent(event *github.PushEvent) *Web
 		compareURL = fmt.Sprintf("%s/%s/%s/compare/%s...%s", webhook.endpoint, repoDetails.Owner, repoDetails.Name,
 			event.GetBefore(), event.GetAfter())
 	}
-	return &WebhookInfo{
+	webhookInfo := &WebhookInfo{
 		TargetRepositoryDetails: repoDetails,
 		TargetBranch:            webhook.trimRefPrefix(event.GetRef()),
 		Timestamp:               event.GetHeadCommit().GetTimestamp().UTC().Unix(),
@@ -94,6 +123,23 @@ func (webhook *gitHubWebhookParser) parsePushEvent(event *github.PushEvent) *Web
 		Author:       webhook.commitAuthor(vcsutils.DefaultIfNotNil(event.HeadCommit).Author),
 		CompareUrl:   compareURL,
 	}
+	// The repository is pushed just before the webhook is fired
+	webhookInfo.DeliveryTimestamp = deliveryTimestamp(event.GetRepo().GetPushedAt().Time)
+	webhookInfo.setPushEvent(strings.HasPrefix(event.GetRef(), tagRefPrefix), strings.TrimPrefix(event.GetRef(), tagRefPrefix))
+	for _, commit := range event.Commits {
+		webhookInfo.Commits = append(webhookInfo.Commits, WebHookInfoCommit{
+			Hash:      commit.GetID(),
+			Message:   commit.GetMessage(),
+			Url:       commit.GetURL(),
+			Timestamp: commit.GetTimestamp().UTC().Unix(),
+			Author:    webhook.commitAuthor(commit.Author),
+			Files:     commitFiles(commit.Added, commit.Modified, commit.Removed),
+		})
+	}
+	webhookInfo.ChangedFiles = changedFiles(webhookInfo.Commits)
+	// The payload doesn't tell the number of pushed commits, so a full list of commits might be truncated
+	webhookInfo.ChangedFilesIncomplete = len(event.Commits) >= gitHubMaxPushCommits
+	return webhookInfo
 }
 
 func (webhook *gitHubWebhookParser) trimRefPrefix(ref string) string {
@@ -137,20 +183,114 @@ func (webhook *gitHubWebhookParser) parsePrEvents(event *github.PullRequestEvent
 		// Action is not supported
 		return nil
 	}
+	return webhook.pullRequestInfo(event.GetPullRequest(), webhookEvent)
+}
+
+func (webhook *gitHubWebhookParser) pullRequestInfo(pullRequest *github.PullRequest, webhookEvent vcsutils.WebhookEvent) *WebhookInfo {
+	return &WebhookInfo{
+		PullRequestId:           pullRequest.GetNumber(),
+		TargetRepositoryDetails: webhook.repoDetails(pullRequest.GetBase().GetRepo()),
+		TargetBranch:            pullRequest.GetBase().GetRef(),
+		SourceRepositoryDetails: webhook.repoDetails(pullRequest.GetHead().GetRepo()),
+		SourceBranch:            pullRequest.GetHead().GetRef(),
+		Timestamp:               pullRequest.GetUpdatedAt().UTC().Unix(),
+		DeliveryTimestamp:       deliveryTimestamp(pullRequest.GetUpdatedAt()),
+		Event:                   webhookEvent,
+		PullRequest: WebHookInfoPullRequest{
+			Title:       pullRequest.GetTitle(),
+			Description: pullRequest.GetBody(),
+			Author:      webhook.user(pullRequest.GetUser()),
+			HeadSha:     pullRequest.GetHead().GetSHA(),
+			BaseSha:     pullRequest.GetBase().GetSHA(),
+			Url:         pullRequest.GetHTMLURL(),
+			Labels:      webhook.labels(pullRequest.Labels),
+			Draft:       pullRequest.GetDraft(),
+		},
+	}
+}
+
+func (webhook *gitHubWebhookParser) labels(labels []*github.Label) []string {
+	var names []string
+	for _, label := range labels {
+		names = append(names, label.GetName())
+	}
+	return names
+}
+
+func (webhook *gitHubWebhookParser) repoDetails(repository *github.Repository) WebHookInfoRepoDetails {
+	return WebHookInfoRepoDetails{
+		Name:  repository.GetName(),
+		Owner: repository.GetOwner().GetLogin(),
+	}
+}
+
+// Comments on the conversation of a pull request are sent as issue comments
+func (webhook *gitHubWebhookParser) parseIssueCommentEvent(event *github.IssueCommentEvent) *WebhookInfo {
+	if event.GetAction() != "created" || !event.GetIssue().IsPullRequest() {
+		return nil
+	}
 	return &WebhookInfo{
-		PullRequestId: event.GetPullRequest().GetNumber(),
-		TargetRepositoryDetails: WebHookInfoRepoDetails{
-			Name:  *event.GetPullRequest().GetBase().GetRepo().Name,
-			Owner: *event.GetPullRequest().GetBase().GetRepo().Owner.Login,
+		PullRequestId:           event.GetIssue().GetNumber(),
+		TargetRepositoryDetails: webhook.repoDetails(event.GetRepo()),
+		Timestamp:               event.GetComment().GetCreatedAt().UTC().Unix(),
+		DeliveryTimestamp:       deliveryTimestamp(event.GetComment().GetUpdatedAt()),
+		Event:                   vcsutils.PrCommentAdded,
+		Comment: WebHookInfoComment{
+			ID:     event.GetComment().GetID(),
+			Body:   event.GetComment().GetBody(),
+			Author: webhook.user(event.GetComment().GetUser()),
 		},
-		TargetBranch: event.GetPullRequest().GetBase().GetRef(),
-		SourceRepositoryDetails: WebHookInfoRepoDetails{
-			Name:  *event.GetPullRequest().GetHead().GetRepo().Name,
-			Owner: *event.GetPullRequest().GetHead().GetRepo().Owner.Login,
+	}
+}
+
+func (webhook *gitHubWebhookParser) parsePrReviewCommentEvent(event *github.PullRequestReviewCommentEvent) *WebhookInfo {
+	if event.GetAction() != "created" {
+		return nil
+	}
+	webhookInfo := webhook.pullRequestInfo(event.GetPullRequest(), vcsutils.PrCommentAdded)
+	webhookInfo.Timestamp = event.GetComment().GetCreatedAt().UTC().Unix()
+	webhookInfo.DeliveryTimestamp = deliveryTimestamp(event.GetComment().GetUpdatedAt())
+	webhookInfo.Comment = WebHookInfoComment{
+		ID:     event.GetComment().GetID(),
+		Body:   event.GetComment().GetBody(),
+		Author: webhook.user(event.GetComment().GetUser()),
+	}
+	return webhookInfo
+}
+
+func (webhook *gitHubWebhookParser) parsePrReviewEvent(event *github.PullRequestReviewEvent) *WebhookInfo {
+	if event.GetAction() != "submitted" {
+		return nil
+	}
+	webhookEvent := vcsutils.PrReviewSubmitted
+	if strings.EqualFold(event.GetReview().GetState(), "approved") {
+		webhookEvent = vcsutils.PrApproved
+	}
+	webhookInfo := webhook.pullRequestInfo(event.GetPullRequest(), webhookEvent)
+	webhookInfo.Timestamp = event.GetReview().GetSubmittedAt().UTC().Unix()
+	webhookInfo.DeliveryTimestamp = deliveryTimestamp(event.GetReview().GetSubmittedAt())
+	webhookInfo.Review = WebHookInfoReview{
+		Reviewer: webhook.user(event.GetReview().GetUser()),
+		State:    event.GetReview().GetState(),
+		Body:     event.GetReview().GetBody(),
+	}
+	return webhookInfo
+}
+
+func (webhook *gitHubWebhookParser) parseReleaseEvent(event *github.ReleaseEvent) *WebhookInfo {
+	if event.GetAction() != "published" {
+		return nil
+	}
+	return &WebhookInfo{
+		TargetRepositoryDetails: webhook.repoDetails(event.GetRepo()),
+		Timestamp:               event.GetRelease().GetPublishedAt().UTC().Unix(),
+		DeliveryTimestamp:       deliveryTimestamp(event.GetRelease().GetPublishedAt().Time),
+		Event:                   vcsutils.ReleasePublished,
+		TagName:                 event.GetRelease().GetTagName(),
+		Release: WebHookInfoRelease{
+			Name: event.GetRelease().GetName(),
+			Url:  event.GetRelease().GetHTMLURL(),
 		},
-		SourceBranch: event.GetPullRequest().GetHead().GetRef(),
-		Timestamp:    event.GetPullRequest().GetUpdatedAt().UTC().Unix(),
-		Event:        webhookEvent,
 	}
 }
 
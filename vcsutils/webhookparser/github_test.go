@@ -46,7 +46,7 @@ const (
 func TestGitHubParseIncomingPushWebhook(t *testing.T) {
 	reader, err := os.Open(filepath.Join("testdata", "github", "pushpayload"))
 	require.NoError(t, err)
-	defer close(reader)
+	defer closeReader(reader)
 
 	// Create request
 	request := httptest.NewRequest("POST", "https://127.0.0.1", reader)
@@ -68,6 +68,7 @@ func TestGitHubParseIncomingPushWebhook(t *testing.T) {
 	assert.Equal(t, expectedOwner, actual.TargetRepositoryDetails.Owner)
 	assert.Equal(t, expectedBranch, actual.TargetBranch)
 	assert.Equal(t, githubPushExpectedTime, actual.Timestamp)
+	assert.Equal(t, githubPushExpectedTime, actual.DeliveryTimestamp)
 	assert.Equal(t, vcsutils.Push, actual.Event)
 	assert.Equal(t, WebHookInfoUser{Login: "yahavi", DisplayName: "Yahav Itzhak", Email: "yahavi@users.noreply.github.com"}, actual.Author)
 	assert.Equal(t, WebHookInfoUser{Login: "web-flow", DisplayName: "GitHub", Email: "noreply@github.com"}, actual.Committer)
@@ -77,6 +78,12 @@ func TestGitHubParseIncomingPushWebhook(t *testing.T) {
 		Message: "Update README.md",
 		Url:     "https://github.com/yahavi/hello-world/commit/9d497bd67a395a8063774f200338769ccbcee916",
 	}, actual.Commit)
+	require.Len(t, actual.Commits, 1)
+	assert.Equal(t, actual.Commit.Hash, actual.Commits[0].Hash)
+	assert.Equal(t, githubPushExpectedTime, actual.Commits[0].Timestamp)
+	assert.Equal(t, []WebHookInfoFile{{Path: "README.md", Status: vcsutils.FileModified}}, actual.Commits[0].Files)
+	assert.Equal(t, []WebHookInfoFile{{Path: "README.md", Status: vcsutils.FileModified}}, actual.ChangedFiles)
+	assert.False(t, actual.ChangedFilesIncomplete)
 	assert.Equal(t, WebHookInfoCommit{
 		Hash: "a82aa1b065b4fa17db4b7a055109044be377ddf7",
 	}, actual.BeforeCommit)
@@ -139,7 +146,7 @@ func TestGithubParseIncomingPrWebhook(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			reader, err := os.Open(filepath.Join("testdata", "github", tt.payloadFilename))
 			require.NoError(t, err)
-			defer close(reader)
+			defer closeReader(reader)
 
 			// Create request
 			request := httptest.NewRequest("POST", "https://127.0.0.1", reader)
@@ -162,6 +169,7 @@ func TestGithubParseIncomingPrWebhook(t *testing.T) {
 			assert.Equal(t, expectedOwner, actual.TargetRepositoryDetails.Owner)
 			assert.Equal(t, expectedBranch, actual.TargetBranch)
 			assert.Equal(t, tt.expectedTime, actual.Timestamp)
+			assert.Equal(t, tt.expectedTime, actual.DeliveryTimestamp)
 			assert.Equal(t, expectedRepoName, actual.SourceRepositoryDetails.Name)
 			assert.Equal(t, expectedOwner, actual.SourceRepositoryDetails.Owner)
 			assert.Equal(t, expectedSourceBranch, actual.SourceBranch)
@@ -194,7 +202,7 @@ func TestGitHubParsePrEventsError(t *testing.T) {
 func TestGitHubPayloadMismatchSignature(t *testing.T) {
 	reader, err := os.Open(filepath.Join("testdata", "github", "pushpayload"))
 	require.NoError(t, err)
-	defer close(reader)
+	defer closeReader(reader)
 
 	// Create request
 	request := httptest.NewRequest("POST", "https://127.0.0.1", reader)
@@ -210,4 +218,167 @@ func TestGitHubPayloadMismatchSignature(t *testing.T) {
 			Token:       token,
 		}, request)
 	assert.True(t, strings.HasPrefix(err.Error(), "error decoding signature"), "error was: "+err.Error())
+	var validationError *PayloadValidationError
+	assert.ErrorAs(t, err, &validationError)
+}
+
+func TestGitHubParseIncomingRefWebhook(t *testing.T) {
+	tests := []struct {
+		name           string
+		ref            string
+		before         string
+		after          string
+		expectedEvent  vcsutils.WebhookEvent
+		expectedBranch string
+		expectedTag    string
+	}{
+		{name: "branch created", ref: "refs/heads/dev", before: gitNilHash, after: "929d3054", expectedEvent: vcsutils.Push, expectedBranch: "dev"},
+		{name: "branch deleted", ref: "refs/heads/dev", before: "929d3054", after: gitNilHash, expectedEvent: vcsutils.Push, expectedBranch: "dev"},
+		{name: "tag pushed", ref: "refs/tags/v1.0.0", before: gitNilHash, after: "929d3054", expectedEvent: vcsutils.TagPushed, expectedTag: "v1.0.0"},
+		{name: "tag deleted", ref: "refs/tags/v1.0.0", before: "929d3054", after: gitNilHash, expectedEvent: vcsutils.TagDeleted, expectedTag: "v1.0.0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := httptest.NewRequest("POST", "https://127.0.0.1", nil)
+			request.Header.Add(githubEventHeader, "push")
+			payload := `{"ref": "` + tt.ref + `", "before": "` + tt.before + `", "after": "` + tt.after + `"}`
+			webhook := gitHubWebhookParser{logger: vcsclient.EmptyLogger{}}
+			actual, err := webhook.parseIncomingWebhook(context.Background(), request, []byte(payload))
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedEvent, actual.Event)
+			assert.Equal(t, tt.expectedBranch, actual.TargetBranch)
+			assert.Equal(t, tt.expectedTag, actual.TagName)
+		})
+	}
+}
+
+func TestGitHubParseIncomingWebhookEvents(t *testing.T) {
+	pullRequest := `"pull_request": {"number": 2, "updated_at": "2022-01-01T10:00:00Z",
+		"base": {"ref": "main", "repo": {"name": "hello-world", "owner": {"login": "yahavi"}}},
+		"head": {"ref": "dev", "repo": {"name": "hello-world", "owner": {"login": "yahavi"}}}}`
+	repository := `"repository": {"name": "hello-world", "owner": {"login": "yahavi"}}`
+	tests := []struct {
+		name     string
+		event    string
+		payload  string
+		expected *WebhookInfo
+	}{
+		{
+			name:  "issue comment",
+			event: "issue_comment",
+			payload: `{"action": "created", "issue": {"number": 2, "pull_request": {"url": "https://api.github.com/pulls/2"}},
+				"comment": {"id": 10, "body": "LGTM", "created_at": "2022-01-01T10:00:00Z", "updated_at": "2022-01-01T10:00:00Z", "user": {"login": "froggy"}}, ` + repository + `}`,
+			expected: &WebhookInfo{
+				PullRequestId:           2,
+				TargetRepositoryDetails: WebHookInfoRepoDetails{Name: "hello-world", Owner: "yahavi"},
+				Timestamp:               1641031200,
+				DeliveryTimestamp:       1641031200,
+				Event:                   vcsutils.PrCommentAdded,
+				Comment:                 WebHookInfoComment{ID: 10, Body: "LGTM", Author: WebHookInfoUser{Login: "froggy"}},
+			},
+		},
+		{
+			name:     "issue comment not on a pull request",
+			event:    "issue_comment",
+			payload:  `{"action": "created", "issue": {"number": 2}, "comment": {"id": 10}, ` + repository + `}`,
+			expected: nil,
+		},
+		{
+			name:  "review comment",
+			event: "pull_request_review_comment",
+			payload: `{"action": "created", "comment": {"id": 11, "body": "Typo", "created_at": "2022-01-01T10:00:00Z", "updated_at": "2022-01-01T10:00:00Z",
+				"user": {"login": "froggy"}}, ` + pullRequest + `}`,
+			expected: &WebhookInfo{
+				PullRequestId:           2,
+				TargetRepositoryDetails: WebHookInfoRepoDetails{Name: "hello-world", Owner: "yahavi"},
+				TargetBranch:            "main",
+				SourceRepositoryDetails: WebHookInfoRepoDetails{Name: "hello-world", Owner: "yahavi"},
+				SourceBranch:            "dev",
+				Timestamp:               1641031200,
+				DeliveryTimestamp:       1641031200,
+				Event:                   vcsutils.PrCommentAdded,
+				Comment:                 WebHookInfoComment{ID: 11, Body: "Typo", Author: WebHookInfoUser{Login: "froggy"}},
+			},
+		},
+		{
+			name:  "review approved",
+			event: "pull_request_review",
+			payload: `{"action": "submitted", "review": {"state": "approved", "body": "Ship it", "submitted_at": "2022-01-01T10:00:00Z",
+				"user": {"login": "froggy"}}, ` + pullRequest + `}`,
+			expected: &WebhookInfo{
+				PullRequestId:           2,
+				TargetRepositoryDetails: WebHookInfoRepoDetails{Name: "hello-world", Owner: "yahavi"},
+				TargetBranch:            "main",
+				SourceRepositoryDetails: WebHookInfoRepoDetails{Name: "hello-world", Owner: "yahavi"},
+				SourceBranch:            "dev",
+				Timestamp:               1641031200,
+				DeliveryTimestamp:       1641031200,
+				Event:                   vcsutils.PrApproved,
+				Review:                  WebHookInfoReview{Reviewer: WebHookInfoUser{Login: "froggy"}, State: "approved", Body: "Ship it"},
+			},
+		},
+		{
+			name:  "review submitted",
+			event: "pull_request_review",
+			payload: `{"action": "submitted", "review": {"state": "changes_requested", "submitted_at": "2022-01-01T10:00:00Z",
+				"user": {"login": "froggy"}}, ` + pullRequest + `}`,
+			expected: &WebhookInfo{
+				PullRequestId:           2,
+				TargetRepositoryDetails: WebHookInfoRepoDetails{Name: "hello-world", Owner: "yahavi"},
+				TargetBranch:            "main",
+				SourceRepositoryDetails: WebHookInfoRepoDetails{Name: "hello-world", Owner: "yahavi"},
+				SourceBranch:            "dev",
+				Timestamp:               1641031200,
+				DeliveryTimestamp:       1641031200,
+				Event:                   vcsutils.PrReviewSubmitted,
+				Review:                  WebHookInfoReview{Reviewer: WebHookInfoUser{Login: "froggy"}, State: "changes_requested"},
+			},
+		},
+		{
+			name:  "release published",
+			event: "release",
+			payload: `{"action": "published", "release": {"tag_name": "v1.0.0", "name": "First release", "published_at": "2022-01-01T10:00:00Z",
+				"html_url": "https://github.com/yahavi/hello-world/releases/tag/v1.0.0"}, ` + repository + `}`,
+			expected: &WebhookInfo{
+				TargetRepositoryDetails: WebHookInfoRepoDetails{Name: "hello-world", Owner: "yahavi"},
+				Timestamp:               1641031200,
+				DeliveryTimestamp:       1641031200,
+				Event:                   vcsutils.ReleasePublished,
+				TagName:                 "v1.0.0",
+				Release:                 WebHookInfoRelease{Name: "First release", Url: "https://github.com/yahavi/hello-world/releases/tag/v1.0.0"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := httptest.NewRequest("POST", "https://127.0.0.1", nil)
+			request.Header.Add(githubEventHeader, tt.event)
+			webhook := gitHubWebhookParser{logger: vcsclient.EmptyLogger{}}
+			actual, err := webhook.parseIncomingWebhook(context.Background(), request, []byte(tt.payload))
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestGitHubParseIncomingPrWebhookDetails(t *testing.T) {
+	reader, err := os.Open(filepath.Join("testdata", "github", "propenpayload"))
+	require.NoError(t, err)
+	defer closeReader(reader)
+
+	request := httptest.NewRequest("POST", "https://127.0.0.1", reader)
+	request.Header.Add("content-type", "application/x-www-form-urlencoded")
+	request.Header.Add(githubSha256Header, "sha256="+githubPrOpenSha256)
+	request.Header.Add(githubEventHeader, "pull_request")
+	actual, err := ParseIncomingWebhook(context.Background(), vcsclient.EmptyLogger{},
+		WebhookOrigin{VcsProvider: vcsutils.GitHub, Token: token}, request)
+	require.NoError(t, err)
+
+	assert.Equal(t, WebHookInfoPullRequest{
+		Title:   "Update README.md",
+		Author:  WebHookInfoUser{Login: "yahavi"},
+		HeadSha: "c0e22e5ac1277cc24575882e4ca2407f739ae886",
+		BaseSha: "9d497bd67a395a8063774f200338769ccbcee916",
+		Url:     "https://github.com/yahavi/hello-world/pull/2",
+	}, actual.PullRequest)
 }
@@ -14,7 +14,11 @@ import (
 	"github.com/jfrog/froggit-go/vcsutils"
 )
 
-const gitLabKeyHeader = "X-GitLab-Token"
+const (
+	gitLabKeyHeader = "X-GitLab-Token"
+	// gitLabTimeLayout is the layout of the times in the GitLab webhook payloads
+	gitLabTimeLayout = "2006-01-02 15:04:05 MST"
+)
 
 // gitLabWebhookParser represents an incoming webhook on GitLab
 type gitLabWebhookParser struct {
@@ -32,7 +36,7 @@ func (webhook *gitLabWebhookParser) validatePayload(_ context.Context, request *
 	actualToken := request.Header.Get(gitLabKeyHeader)
 	if len(token) != 0 || len(actualToken) > 0 {
 		if actualToken != string(token) {
-			return nil, errors.New("token mismatch")
+			return nil, &PayloadValidationError{err: errors.New("token mismatch")}
 		}
 	}
 
@@ -43,15 +47,29 @@ func (webhook *gitLabWebhookParser) validatePayload(_ context.Context, request *
 	return payload.Bytes(), nil
 }
 func (webhook *gitLabWebhookParser) parseIncomingWebhook(_ context.Context, request *http.Request, payload []byte) (*WebhookInfo, error) {
-	event, err := gitlab.ParseWebhook(gitlab.WebhookEventType(request), payload)
+	eventType := gitlab.WebhookEventType(request)
+	switch eventType {
+	case gitlab.EventTypePush, gitlab.EventTypeTagPush, gitlab.EventTypeMergeRequest, gitlab.EventTypeNote, gitlab.EventTypeRelease, "":
+	default:
+		// Group hooks also deliver group events, such as member and subgroup events, which aren't parsed
+		webhook.logger.Debug("Ignoring an unsupported GitLab event: ", eventType)
+		return nil, nil
+	}
+	event, err := gitlab.ParseWebhook(eventType, payload)
 	if err != nil {
 		return nil, err
 	}
 	switch event := event.(type) {
 	case *gitlab.PushEvent:
 		return webhook.parsePushEvent(event), nil
+	case *gitlab.TagEvent:
+		return webhook.parseTagEvent(event), nil
 	case *gitlab.MergeEvent:
 		return webhook.parsePrEvents(event)
+	case *gitlab.MergeCommentEvent:
+		return webhook.parsePrCommentEvent(event)
+	case *gitlab.ReleaseEvent:
+		return webhook.parseReleaseEvent(event)
 	}
 	return nil, nil
 }
@@ -62,7 +80,7 @@ func (webhook *gitLabWebhookParser) parsePushEvent(event *gitlab.PushEvent) *Web
 		localTimestamp = event.Commits[0].Timestamp.Local().Unix()
 	}
 	lastCommit := vcsutils.DefaultIfNotNil(webhook.getLastCommit(event))
-	return &WebhookInfo{
+	webhookInfo := &WebhookInfo{
 		TargetRepositoryDetails: webhook.parseRepoDetails(event.Project.PathWithNamespace),
 		TargetBranch:            strings.TrimPrefix(event.Ref, "refs/heads/"),
 		Timestamp:               localTimestamp,
@@ -91,6 +109,44 @@ func (webhook *gitLabWebhookParser) parsePushEvent(event *gitlab.PushEvent) *Web
 			Email:       lastCommit.Author.Email,
 		},
 	}
+	webhookInfo.setPushEvent(false, "")
+	for _, commit := range event.Commits {
+		webhookInfo.Commits = append(webhookInfo.Commits, WebHookInfoCommit{
+			Hash:      commit.ID,
+			Message:   commit.Message,
+			Url:       commit.URL,
+			Timestamp: vcsutils.DefaultIfNotNil(commit.Timestamp).UTC().Unix(),
+			Author:    WebHookInfoUser{DisplayName: commit.Author.Name, Email: commit.Author.Email},
+			Files:     commitFiles(commit.Added, commit.Modified, commit.Removed),
+		})
+	}
+	webhookInfo.ChangedFiles = changedFiles(webhookInfo.Commits)
+	// GitLab sends up to 20 commits in the payload
+	webhookInfo.ChangedFilesIncomplete = len(event.Commits) < event.TotalCommitsCount
+	return webhookInfo
+}
+
+// Tags are pushed in tag push events, which don't contain the commits of the tag
+func (webhook *gitLabWebhookParser) parseTagEvent(event *gitlab.TagEvent) *WebhookInfo {
+	webhookInfo := &WebhookInfo{
+		TargetRepositoryDetails: webhook.parseRepoDetails(event.Project.PathWithNamespace),
+		Commit: WebHookInfoCommit{
+			Hash:    event.After,
+			Message: event.Message,
+		},
+		BeforeCommit: WebHookInfoCommit{
+			Hash: event.Before,
+		},
+		BranchStatus: branchStatus(event.Before != gitNilHash, event.After != gitNilHash),
+		TriggeredBy: WebHookInfoUser{
+			Login:       event.UserUsername,
+			Email:       event.UserEmail,
+			DisplayName: event.UserName,
+			AvatarUrl:   event.UserAvatar,
+		},
+	}
+	webhookInfo.setPushEvent(true, strings.TrimPrefix(event.Ref, tagRefPrefix))
+	return webhookInfo
 }
 
 func (webhook *gitLabWebhookParser) getLastCommit(event *gitlab.PushEvent) *struct {
@@ -113,12 +169,14 @@ func (webhook *gitLabWebhookParser) getLastCommit(event *gitlab.PushEvent) *stru
 	return event.Commits[len(event.Commits)-1]
 }
 
+// The namespace of a project in a subgroup contains the parent groups, so the owner is everything before the project path
 func (webhook *gitLabWebhookParser) parseRepoDetails(pathWithNamespace string) WebHookInfoRepoDetails {
-	split := strings.Split(pathWithNamespace, "/")
-	return WebHookInfoRepoDetails{
-		Name:  split[1],
-		Owner: split[0],
+	repoDetails := WebHookInfoRepoDetails{Name: pathWithNamespace}
+	if lastSeparator := strings.LastIndex(pathWithNamespace, "/"); lastSeparator >= 0 {
+		repoDetails.Owner = pathWithNamespace[:lastSeparator]
+		repoDetails.Name = pathWithNamespace[lastSeparator+1:]
 	}
+	return repoDetails
 }
 
 func (webhook *gitLabWebhookParser) parsePrEvents(event *gitlab.MergeEvent) (*WebhookInfo, error) {
@@ -132,25 +190,128 @@ func (webhook *gitLabWebhookParser) parsePrEvents(event *gitlab.MergeEvent) (*We
 		webhookEvent = vcsutils.PrMerged
 	case "close":
 		webhookEvent = vcsutils.PrRejected
+	case "approved":
+		webhookEvent = vcsutils.PrApproved
 	default:
 		//Action is not supported
 		return nil, nil
 	}
-	eventTime, err := time.Parse("2006-01-02 15:04:05 MST", event.ObjectAttributes.UpdatedAt)
+	eventTime, err := time.Parse(gitLabTimeLayout, event.ObjectAttributes.UpdatedAt)
 	if err != nil {
 		return nil, err
 	}
-	return &WebhookInfo{
+	webhookInfo := &WebhookInfo{
 		PullRequestId:           event.ObjectAttributes.IID,
 		SourceRepositoryDetails: webhook.parseRepoDetails(event.ObjectAttributes.Source.PathWithNamespace),
 		SourceBranch:            event.ObjectAttributes.SourceBranch,
 		TargetRepositoryDetails: webhook.parseRepoDetails(event.ObjectAttributes.Target.PathWithNamespace),
 		TargetBranch:            event.ObjectAttributes.TargetBranch,
 		Timestamp:               eventTime.UTC().Unix(),
+		DeliveryTimestamp:       deliveryTimestamp(eventTime),
 		Event:                   webhookEvent,
+		PullRequest: WebHookInfoPullRequest{
+			Title:       event.ObjectAttributes.Title,
+			Description: event.ObjectAttributes.Description,
+			Author:      webhook.author(event.ObjectAttributes.AuthorID, event.User),
+			HeadSha:     event.ObjectAttributes.LastCommit.ID,
+			Url:         event.ObjectAttributes.URL,
+			Labels:      webhook.labels(event.Labels),
+			Draft:       event.ObjectAttributes.WorkInProgress,
+		},
+	}
+	if webhookEvent == vcsutils.PrApproved {
+		// GitLab has no reviews, so the approving user is the reviewer
+		webhookInfo.Review = WebHookInfoReview{Reviewer: webhook.user(event.User), State: event.ObjectAttributes.Action}
+	}
+	return webhookInfo, nil
+}
+
+// GitLab has no pull request reviews, so the comments of a review are delivered as comment events
+func (webhook *gitLabWebhookParser) parsePrCommentEvent(event *gitlab.MergeCommentEvent) (*WebhookInfo, error) {
+	if event.ObjectAttributes.System {
+		// System notes are added by GitLab, for example when new commits are pushed
+		return nil, nil
+	}
+	eventTime, err := time.Parse(gitLabTimeLayout, event.ObjectAttributes.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	webhookInfo := &WebhookInfo{
+		PullRequestId:           event.MergeRequest.IID,
+		TargetRepositoryDetails: webhook.parseRepoDetails(event.Project.PathWithNamespace),
+		TargetBranch:            event.MergeRequest.TargetBranch,
+		SourceBranch:            event.MergeRequest.SourceBranch,
+		Timestamp:               eventTime.UTC().Unix(),
+		DeliveryTimestamp:       deliveryTimestamp(eventTime),
+		Event:                   vcsutils.PrCommentAdded,
+		Comment: WebHookInfoComment{
+			ID:     int64(event.ObjectAttributes.ID),
+			Body:   event.ObjectAttributes.Note,
+			Author: webhook.user(event.User),
+		},
+		PullRequest: WebHookInfoPullRequest{
+			Title:       event.MergeRequest.Title,
+			Description: event.MergeRequest.Description,
+			Author:      webhook.author(event.MergeRequest.AuthorID, event.User),
+			HeadSha:     event.MergeRequest.LastCommit.ID,
+			Draft:       event.MergeRequest.WorkInProgress,
+		},
+	}
+	if event.MergeRequest.Source != nil {
+		webhookInfo.SourceRepositoryDetails = webhook.parseRepoDetails(event.MergeRequest.Source.PathWithNamespace)
+	}
+	return webhookInfo, nil
+}
+
+func (webhook *gitLabWebhookParser) parseReleaseEvent(event *gitlab.ReleaseEvent) (*WebhookInfo, error) {
+	if event.Action != "create" {
+		return nil, nil
+	}
+	eventTime, err := time.Parse(gitLabTimeLayout, event.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &WebhookInfo{
+		TargetRepositoryDetails: webhook.parseRepoDetails(event.Project.PathWithNamespace),
+		Timestamp:               eventTime.UTC().Unix(),
+		DeliveryTimestamp:       deliveryTimestamp(eventTime),
+		Event:                   vcsutils.ReleasePublished,
+		TagName:                 event.Tag,
+		Release: WebHookInfoRelease{
+			Name: event.Name,
+			Url:  event.URL,
+		},
 	}, nil
 }
 
+func (webhook *gitLabWebhookParser) user(user *gitlab.EventUser) WebHookInfoUser {
+	if user == nil {
+		return WebHookInfoUser{}
+	}
+	return WebHookInfoUser{
+		Login:       user.Username,
+		DisplayName: user.Name,
+		Email:       user.Email,
+		AvatarUrl:   user.AvatarURL,
+	}
+}
+
+// GitLab sends only the ID of the merge request author, so the author is known only if the author triggered the event
+func (webhook *gitLabWebhookParser) author(authorID int, user *gitlab.EventUser) WebHookInfoUser {
+	if user == nil || user.ID != authorID {
+		return WebHookInfoUser{}
+	}
+	return webhook.user(user)
+}
+
+func (webhook *gitLabWebhookParser) labels(labels []*gitlab.Label) []string {
+	var names []string
+	for _, label := range labels {
+		names = append(names, label.Name)
+	}
+	return names
+}
+
 func (webhook *gitLabWebhookParser) branchStatus(event *gitlab.PushEvent) WebHookInfoBranchStatus {
 	existsAfter := event.After != gitNilHash
 	existedBefore := event.Before != gitNilHash
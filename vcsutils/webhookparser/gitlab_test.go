@@ -6,6 +6,7 @@ import (
 	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -31,7 +32,7 @@ const (
 func TestGitLabParseIncomingPushWebhook(t *testing.T) {
 	reader, err := os.Open(filepath.Join("testdata", "gitlab", "pushpayload.json"))
 	require.NoError(t, err)
-	defer close(reader)
+	defer closeReader(reader)
 
 	// Create request
 	request := httptest.NewRequest("POST", "https://127.0.0.1", reader)
@@ -61,6 +62,11 @@ func TestGitLabParseIncomingPushWebhook(t *testing.T) {
 		Message: "Initial commit",
 		Url:     "https://gitlab.com/yahavi/hello-world/-/commit/450cd4687e3644d544ca4cb3a7a355fea9e6f0dc",
 	}, actual.Commit)
+	require.Len(t, actual.Commits, 1)
+	assert.Equal(t, WebHookInfoUser{DisplayName: "Yahav Itzhak", Email: "yahavitz@gmail.com"}, actual.Commits[0].Author)
+	assert.Equal(t, []WebHookInfoFile{{Path: "README.md", Status: vcsutils.FileAdded}}, actual.Commits[0].Files)
+	assert.Equal(t, []WebHookInfoFile{{Path: "README.md", Status: vcsutils.FileAdded}}, actual.ChangedFiles)
+	assert.False(t, actual.ChangedFilesIncomplete)
 	assert.Equal(t, WebHookInfoCommit{
 		Hash: "450cd4687e3644d544ca4cb3a7a355fea9e6f0dc",
 	}, actual.BeforeCommit)
@@ -110,7 +116,7 @@ func TestGitLabParseIncomingPrWebhook(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			reader, err := os.Open(filepath.Join("testdata", "gitlab", tt.payloadFilename))
 			require.NoError(t, err)
-			defer close(reader)
+			defer closeReader(reader)
 
 			// Create request
 			request := httptest.NewRequest("POST", "https://127.0.0.1", reader)
@@ -132,6 +138,7 @@ func TestGitLabParseIncomingPrWebhook(t *testing.T) {
 			assert.Equal(t, expectedOwner, actual.TargetRepositoryDetails.Owner)
 			assert.Equal(t, expectedBranch, actual.TargetBranch)
 			assert.Equal(t, tt.expectedTime, actual.Timestamp)
+			assert.Equal(t, tt.expectedTime, actual.DeliveryTimestamp)
 			assert.Equal(t, expectedRepoName, actual.SourceRepositoryDetails.Name)
 			assert.Equal(t, expectedOwner, actual.SourceRepositoryDetails.Owner)
 			assert.Equal(t, expectedSourceBranch, actual.SourceBranch)
@@ -140,6 +147,42 @@ func TestGitLabParseIncomingPrWebhook(t *testing.T) {
 	}
 }
 
+func TestGitLabParseIncomingGroupHookWebhook(t *testing.T) {
+	reader, err := os.Open(filepath.Join("testdata", "gitlab", "grouppushpayload.json"))
+	require.NoError(t, err)
+	defer closeReader(reader)
+
+	// A group hook delivers the events of the projects in the subgroups of the group
+	request := httptest.NewRequest("POST", "https://127.0.0.1", reader)
+	request.Header.Add(gitLabKeyHeader, string(token))
+	request.Header.Add(gitLabEventHeader, "Push Hook")
+
+	actual, err := ParseIncomingWebhook(context.Background(),
+		vcsclient.EmptyLogger{},
+		WebhookOrigin{
+			VcsProvider: vcsutils.GitLab,
+			Token:       token,
+		}, request)
+	require.NoError(t, err)
+	assert.Equal(t, WebHookInfoRepoDetails{Name: "hello-world", Owner: "jfrog-group/frogbot"}, actual.TargetRepositoryDetails)
+	assert.Equal(t, vcsutils.Push, actual.Event)
+}
+
+func TestGitLabParseIncomingGroupEvent(t *testing.T) {
+	request := httptest.NewRequest("POST", "https://127.0.0.1", strings.NewReader(`{"event_name":"user_add_to_group"}`))
+	request.Header.Add(gitLabKeyHeader, string(token))
+	request.Header.Add(gitLabEventHeader, "Member Hook")
+
+	actual, err := ParseIncomingWebhook(context.Background(),
+		vcsclient.EmptyLogger{},
+		WebhookOrigin{
+			VcsProvider: vcsutils.GitLab,
+			Token:       token,
+		}, request)
+	require.NoError(t, err)
+	assert.Nil(t, actual)
+}
+
 func TestGitLabParseIncomingWebhookError(t *testing.T) {
 	request := &http.Request{}
 	_, err := ParseIncomingWebhook(context.Background(),
@@ -165,7 +208,7 @@ func TestGitLabParsePrEventsError(t *testing.T) {
 func TestGitLabPayloadMismatchSignature(t *testing.T) {
 	reader, err := os.Open(filepath.Join("testdata", "gitlab", "pushpayload.json"))
 	require.NoError(t, err)
-	defer close(reader)
+	defer closeReader(reader)
 
 	// Create request
 	request := httptest.NewRequest("POST", "https://127.0.0.1", reader)
@@ -181,3 +224,134 @@ func TestGitLabPayloadMismatchSignature(t *testing.T) {
 		}, request)
 	assert.EqualError(t, err, "token mismatch")
 }
+
+func TestGitLabParseIncomingWebhookEvents(t *testing.T) {
+	project := `"project": {"path_with_namespace": "yahavi/hello-world"}`
+	tests := []struct {
+		name     string
+		event    string
+		payload  string
+		expected *WebhookInfo
+	}{
+		{
+			name:  "tag pushed",
+			event: "Tag Push Hook",
+			payload: `{"object_kind": "tag_push", "ref": "refs/tags/v1.0.0", "before": "` + gitNilHash + `", "after": "929d3054",
+				"user_username": "yahavi", ` + project + `}`,
+			expected: &WebhookInfo{
+				TargetRepositoryDetails: WebHookInfoRepoDetails{Name: "hello-world", Owner: "yahavi"},
+				Event:                   vcsutils.TagPushed,
+				TagName:                 "v1.0.0",
+				Commit:                  WebHookInfoCommit{Hash: "929d3054"},
+				BeforeCommit:            WebHookInfoCommit{Hash: gitNilHash},
+				BranchStatus:            WebhookInfoBranchStatusCreated,
+				TriggeredBy:             WebHookInfoUser{Login: "yahavi"},
+			},
+		},
+		{
+			name:  "tag deleted",
+			event: "Tag Push Hook",
+			payload: `{"object_kind": "tag_push", "ref": "refs/tags/v1.0.0", "before": "929d3054", "after": "` + gitNilHash + `",
+				"user_username": "yahavi", ` + project + `}`,
+			expected: &WebhookInfo{
+				TargetRepositoryDetails: WebHookInfoRepoDetails{Name: "hello-world", Owner: "yahavi"},
+				Event:                   vcsutils.TagDeleted,
+				TagName:                 "v1.0.0",
+				Commit:                  WebHookInfoCommit{Hash: gitNilHash},
+				BeforeCommit:            WebHookInfoCommit{Hash: "929d3054"},
+				BranchStatus:            WebhookInfoBranchStatusDeleted,
+				TriggeredBy:             WebHookInfoUser{Login: "yahavi"},
+			},
+		},
+		{
+			name:  "merge request approved",
+			event: "Merge Request Hook",
+			payload: `{"object_kind": "merge_request", "user": {"id": 7, "username": "froggy"}, "object_attributes": {"iid": 2, "action": "approved", "author_id": 3,
+				"updated_at": "2022-01-01 10:00:00 UTC", "source_branch": "dev", "target_branch": "main",
+				"source": {"path_with_namespace": "yahavi/hello-world"}, "target": {"path_with_namespace": "yahavi/hello-world"}}}`,
+			expected: &WebhookInfo{
+				PullRequestId:           2,
+				TargetRepositoryDetails: WebHookInfoRepoDetails{Name: "hello-world", Owner: "yahavi"},
+				TargetBranch:            "main",
+				SourceRepositoryDetails: WebHookInfoRepoDetails{Name: "hello-world", Owner: "yahavi"},
+				SourceBranch:            "dev",
+				Timestamp:               1641031200,
+				DeliveryTimestamp:       1641031200,
+				Event:                   vcsutils.PrApproved,
+				Review:                  WebHookInfoReview{Reviewer: WebHookInfoUser{Login: "froggy"}, State: "approved"},
+			},
+		},
+		{
+			name:  "merge request comment",
+			event: "Note Hook",
+			payload: `{"object_kind": "note", "user": {"id": 7, "username": "froggy"}, ` + project + `,
+				"object_attributes": {"id": 10, "note": "LGTM", "noteable_type": "MergeRequest", "created_at": "2022-01-01 10:00:00 UTC"},
+				"merge_request": {"iid": 2, "author_id": 3, "source_branch": "dev", "target_branch": "main", "source": {"path_with_namespace": "yahavi/hello-world"}}}`,
+			expected: &WebhookInfo{
+				PullRequestId:           2,
+				TargetRepositoryDetails: WebHookInfoRepoDetails{Name: "hello-world", Owner: "yahavi"},
+				TargetBranch:            "main",
+				SourceRepositoryDetails: WebHookInfoRepoDetails{Name: "hello-world", Owner: "yahavi"},
+				SourceBranch:            "dev",
+				Timestamp:               1641031200,
+				DeliveryTimestamp:       1641031200,
+				Event:                   vcsutils.PrCommentAdded,
+				Comment:                 WebHookInfoComment{ID: 10, Body: "LGTM", Author: WebHookInfoUser{Login: "froggy"}},
+			},
+		},
+		{
+			name:  "system note",
+			event: "Note Hook",
+			payload: `{"object_kind": "note", ` + project + `,
+				"object_attributes": {"id": 10, "note": "added 1 commit", "system": true, "noteable_type": "MergeRequest"}, "merge_request": {"iid": 2}}`,
+			expected: nil,
+		},
+		{
+			name:  "release published",
+			event: "Release Hook",
+			payload: `{"object_kind": "release", "action": "create", "name": "First release", "tag": "v1.0.0", "created_at": "2022-01-01 10:00:00 UTC",
+				"url": "https://gitlab.com/yahavi/hello-world/-/releases/v1.0.0", ` + project + `}`,
+			expected: &WebhookInfo{
+				TargetRepositoryDetails: WebHookInfoRepoDetails{Name: "hello-world", Owner: "yahavi"},
+				Timestamp:               1641031200,
+				DeliveryTimestamp:       1641031200,
+				Event:                   vcsutils.ReleasePublished,
+				TagName:                 "v1.0.0",
+				Release:                 WebHookInfoRelease{Name: "First release", Url: "https://gitlab.com/yahavi/hello-world/-/releases/v1.0.0"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := httptest.NewRequest("POST", "https://127.0.0.1", nil)
+			request.Header.Add(gitLabEventHeader, tt.event)
+			webhook := gitLabWebhookParser{logger: vcsclient.EmptyLogger{}}
+			actual, err := webhook.parseIncomingWebhook(context.Background(), request, []byte(tt.payload))
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestGitLabParseIncomingPrWebhookDetails(t *testing.T) {
+	payload, err := os.ReadFile(filepath.Join("testdata", "gitlab", "propenpayload.json"))
+	require.NoError(t, err)
+
+	request := httptest.NewRequest("POST", "https://127.0.0.1", nil)
+	request.Header.Add(gitLabEventHeader, "Merge Request Hook")
+	webhook := gitLabWebhookParser{logger: vcsclient.EmptyLogger{}}
+	actual, err := webhook.parseIncomingWebhook(context.Background(), request, payload)
+	require.NoError(t, err)
+
+	assert.Equal(t, WebHookInfoPullRequest{
+		Title: "Update README.md",
+		Author: WebHookInfoUser{
+			Login:       "yahavi",
+			DisplayName: "Yahav Itzhak",
+			Email:       "yahavitz@gmail.com",
+			AvatarUrl:   "https://secure.gravatar.com/avatar/9680da1674e22a1de17acb19bb233ebf?s=80&d=identicon",
+		},
+		HeadSha: "72108853aa0eac9d1b72fe34710aeed256d193d5",
+		Url:     "https://gitlab.com/yahavi/hello-world/-/merge_requests/1",
+	}, actual.PullRequest)
+}
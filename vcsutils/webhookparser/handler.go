@@ -0,0 +1,278 @@
+package webhookparser
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/jfrog/froggit-go/vcsclient"
+	"github.com/jfrog/froggit-go/vcsutils"
+)
+
+// WebhookCallback handles a parsed incoming webhook
+type WebhookCallback func(ctx context.Context, webhookInfo *WebhookInfo) error
+
+// WebhookOriginResolver resolves the origin of an incoming webhook HTTP request, for example by its URL path or by DetectOrigin
+type WebhookOriginResolver func(request *http.Request) (WebhookOrigin, error)
+
+// WebhookHandler is an http.Handler of incoming webhooks. It parses the webhooks, and calls the callbacks registered to their events.
+// The handler responds with:
+// 200 - The callback of the event succeeded
+// 202 - The webhook is queued for an asynchronous callback
+// 204 - The event is ignored, since it isn't supported or has no callback, or the delivery is already handled
+// 400 - The origin of the webhook can't be resolved, or the payload is malformed
+// 401 - The payload can't be validated with the token, or its timestamp is out of the allowed window
+// 409 - The callback of the same delivery is still in progress
+// 500 - The callback of the event failed or panicked
+// 503 - The queue of the asynchronous callbacks is full, or the handler is closed
+type WebhookHandler struct {
+	logger        vcsclient.Log
+	resolveOrigin WebhookOriginResolver
+	callbacks     map[vcsutils.WebhookEvent]WebhookCallback
+	deliveries    DeliveryStore
+	deliveryAge   time.Duration
+	now           func() time.Time
+	// The delivery IDs whose callbacks are in progress
+	pending      map[string]bool
+	pendingMutex sync.Mutex
+	// Asynchronous callbacks only
+	jobs    chan webhookJob
+	workers sync.WaitGroup
+	mutex   sync.RWMutex
+	closed  bool
+}
+
+type webhookJob struct {
+	callback    WebhookCallback
+	webhookInfo *WebhookInfo
+}
+
+type deliveryState int
+
+const (
+	deliveryNew deliveryState = iota
+	deliveryPending
+	deliveryHandled
+)
+
+// NewWebhookHandler creates a WebhookHandler of incoming webhooks from a single origin
+func NewWebhookHandler(logger vcsclient.Log, origin WebhookOrigin) *WebhookHandler {
+	return NewWebhookHandlerWithResolver(logger, func(*http.Request) (WebhookOrigin, error) {
+		return origin, nil
+	})
+}
+
+// NewWebhookHandlerWithResolver creates a WebhookHandler, which resolves the origin of every incoming webhook by the resolver
+func NewWebhookHandlerWithResolver(logger vcsclient.Log, resolver WebhookOriginResolver) *WebhookHandler {
+	return &WebhookHandler{
+		logger:        logger,
+		resolveOrigin: resolver,
+		callbacks:     map[vcsutils.WebhookEvent]WebhookCallback{},
+		now:           time.Now,
+		pending:       map[string]bool{},
+	}
+}
+
+// On registers the callback of an event, replacing any previously registered callback of the event.
+// Callbacks should be registered before the handler starts serving.
+func (handler *WebhookHandler) On(event vcsutils.WebhookEvent, callback WebhookCallback) *WebhookHandler {
+	handler.callbacks[event] = callback
+	return handler
+}
+
+// Deduplicate ignores redeliveries and replays of handled webhooks, by storing their delivery IDs in the store.
+// Webhooks without a delivery ID are never ignored. Redeliveries received while the callback of the delivery is in progress
+// are rejected with 409, so that the VCS provider retries them. If a callback fails, the delivery ID is removed,
+// so that the webhook can be redelivered.
+// store - The store of the handled delivery IDs, for example NewMemoryDeliveryStore
+func (handler *WebhookHandler) Deduplicate(store DeliveryStore) *WebhookHandler {
+	handler.deliveries = store
+	return handler
+}
+
+// MaxDeliveryAge rejects webhooks which were fired more than maxAge before or after the current time.
+// The fire time of a webhook is its WebhookInfo.DeliveryTimestamp, which is the time of the event in the payload,
+// for example the update time of a pull request. The payloads of the pushes of GitLab and Bitbucket Cloud don't tell
+// the push time, and therefore these webhooks are never rejected.
+func (handler *WebhookHandler) MaxDeliveryAge(maxAge time.Duration) *WebhookHandler {
+	handler.deliveryAge = maxAge
+	return handler
+}
+
+// Async makes the callbacks asynchronous. The webhooks are queued, and the callbacks are called by a pool of workers.
+// Should be called once, before the handler starts serving. The workers run until Close is called.
+// workers   - The number of workers
+// queueSize - The maximal number of queued webhooks. Webhooks received when the queue is full are rejected
+func (handler *WebhookHandler) Async(workers, queueSize int) *WebhookHandler {
+	if workers < 1 {
+		workers = 1
+	}
+	handler.jobs = make(chan webhookJob, queueSize)
+	handler.workers.Add(workers)
+	for i := 0; i < workers; i++ {
+		go handler.work()
+	}
+	return handler
+}
+
+// Close stops accepting webhooks, and waits for the queued asynchronous callbacks to finish
+func (handler *WebhookHandler) Close() {
+	handler.mutex.Lock()
+	if handler.closed {
+		handler.mutex.Unlock()
+		return
+	}
+	handler.closed = true
+	if handler.jobs != nil {
+		close(handler.jobs)
+	}
+	handler.mutex.Unlock()
+	handler.workers.Wait()
+}
+
+func (handler *WebhookHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	origin, err := handler.resolveOrigin(request)
+	if err != nil {
+		handler.logger.Warn("Couldn't resolve the origin of an incoming webhook: ", err)
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+	webhookInfo, err := ParseIncomingWebhook(request.Context(), handler.logger, origin, request)
+	if err != nil {
+		var validationError *PayloadValidationError
+		if errors.As(err, &validationError) {
+			handler.logger.Warn("Rejected an incoming webhook of ", origin.VcsProvider, ": ", err)
+			http.Error(writer, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		handler.logger.Warn("Couldn't parse an incoming webhook of ", origin.VcsProvider, ": ", err)
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if webhookInfo == nil {
+		handler.logger.Debug("Ignoring an unsupported event of ", origin.VcsProvider)
+		writer.WriteHeader(http.StatusNoContent)
+		return
+	}
+	callback, ok := handler.callbacks[webhookInfo.Event]
+	if !ok {
+		handler.logger.Debug("Ignoring the ", webhookInfo.Event, " event, which has no callback")
+		writer.WriteHeader(http.StatusNoContent)
+		return
+	}
+	if err = handler.validateDeliveryTime(webhookInfo); err != nil {
+		handler.logger.Warn("Rejected the ", webhookInfo.Event, " event of ", origin.VcsProvider, ": ", err)
+		http.Error(writer, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	switch handler.addDelivery(webhookInfo) {
+	case deliveryPending:
+		handler.logger.Debug("Rejected the delivery ", webhookInfo.DeliveryID, ", since its callback is in progress")
+		http.Error(writer, "the callback of the delivery is in progress", http.StatusConflict)
+		return
+	case deliveryHandled:
+		handler.logger.Debug("Ignoring the already handled delivery ", webhookInfo.DeliveryID)
+		writer.WriteHeader(http.StatusNoContent)
+		return
+	}
+	if handler.jobs != nil {
+		handler.enqueue(writer, webhookJob{callback: callback, webhookInfo: webhookInfo})
+		return
+	}
+	err = callCallback(request.Context(), callback, webhookInfo)
+	handler.finishDelivery(webhookInfo, err == nil)
+	if err != nil {
+		handler.logger.Error("The callback of the ", webhookInfo.Event, " event failed: ", err)
+		// The error may contain internal details, so it isn't sent to the VCS provider
+		http.Error(writer, "the callback of the event failed", http.StatusInternalServerError)
+		return
+	}
+	writer.WriteHeader(http.StatusOK)
+}
+
+// callCallback calls the callback, and returns an error if the callback panics
+func callCallback(ctx context.Context, callback WebhookCallback, webhookInfo *WebhookInfo) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("the callback panicked: %v", r)
+		}
+	}()
+	return callback(ctx, webhookInfo)
+}
+
+func (handler *WebhookHandler) validateDeliveryTime(webhookInfo *WebhookInfo) error {
+	if handler.deliveryAge <= 0 || webhookInfo.DeliveryTimestamp == 0 {
+		return nil
+	}
+	deliveryTime := time.Unix(webhookInfo.DeliveryTimestamp, 0)
+	age := handler.now().Sub(deliveryTime)
+	if age > handler.deliveryAge || age < -handler.deliveryAge {
+		return fmt.Errorf("the webhook delivery time %s is out of the allowed window of %s", deliveryTime.UTC().Format(time.RFC3339), handler.deliveryAge)
+	}
+	return nil
+}
+
+// addDelivery stores the delivery ID of the webhook, and marks it as pending until finishDelivery is called
+func (handler *WebhookHandler) addDelivery(webhookInfo *WebhookInfo) deliveryState {
+	if handler.deliveries == nil || webhookInfo.DeliveryID == "" {
+		return deliveryNew
+	}
+	handler.pendingMutex.Lock()
+	defer handler.pendingMutex.Unlock()
+	if handler.pending[webhookInfo.DeliveryID] {
+		return deliveryPending
+	}
+	if !handler.deliveries.Add(webhookInfo.DeliveryID) {
+		return deliveryHandled
+	}
+	handler.pending[webhookInfo.DeliveryID] = true
+	return deliveryNew
+}
+
+// finishDelivery unmarks the pending delivery ID of the webhook. If the webhook wasn't handled, its delivery ID is removed,
+// so that the webhook can be redelivered.
+func (handler *WebhookHandler) finishDelivery(webhookInfo *WebhookInfo, handled bool) {
+	if handler.deliveries == nil || webhookInfo.DeliveryID == "" {
+		return
+	}
+	handler.pendingMutex.Lock()
+	defer handler.pendingMutex.Unlock()
+	delete(handler.pending, webhookInfo.DeliveryID)
+	if !handled {
+		handler.deliveries.Remove(webhookInfo.DeliveryID)
+	}
+}
+
+func (handler *WebhookHandler) enqueue(writer http.ResponseWriter, job webhookJob) {
+	handler.mutex.RLock()
+	defer handler.mutex.RUnlock()
+	if handler.closed {
+		handler.finishDelivery(job.webhookInfo, false)
+		http.Error(writer, "the webhook handler is closed", http.StatusServiceUnavailable)
+		return
+	}
+	select {
+	case handler.jobs <- job:
+		writer.WriteHeader(http.StatusAccepted)
+	default:
+		handler.logger.Warn("Rejected the ", job.webhookInfo.Event, " event, since the webhooks queue is full")
+		// The VCS provider may redeliver the webhook
+		handler.finishDelivery(job.webhookInfo, false)
+		http.Error(writer, "the webhooks queue is full", http.StatusServiceUnavailable)
+	}
+}
+
+func (handler *WebhookHandler) work() {
+	defer handler.workers.Done()
+	for job := range handler.jobs {
+		// The request is already answered, so its context is done
+		err := callCallback(context.Background(), job.callback, job.webhookInfo)
+		handler.finishDelivery(job.webhookInfo, err == nil)
+		if err != nil {
+			handler.logger.Error("The callback of the ", job.webhookInfo.Event, " event failed: ", err)
+		}
+	}
+}
@@ -0,0 +1,291 @@
+package webhookparser
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jfrog/froggit-go/vcsclient"
+	"github.com/jfrog/froggit-go/vcsutils"
+)
+
+var gitLabOrigin = WebhookOrigin{VcsProvider: vcsutils.GitLab, Token: token}
+
+func TestWebhookHandler(t *testing.T) {
+	var received *WebhookInfo
+	handler := NewWebhookHandler(vcsclient.EmptyLogger{}, gitLabOrigin).
+		On(vcsutils.Push, func(_ context.Context, webhookInfo *WebhookInfo) error {
+			received = webhookInfo
+			return nil
+		}).
+		On(vcsutils.PrOpened, func(context.Context, *WebhookInfo) error {
+			return errors.New("connection to the internal database failed")
+		}).
+		On(vcsutils.TagPushed, func(context.Context, *WebhookInfo) error {
+			panic("callback panicked")
+		})
+
+	tests := []struct {
+		name           string
+		event          string
+		token          string
+		payload        string
+		expectedStatus int
+	}{
+		{name: "handled", event: "Push Hook", token: string(token), payload: readGitLabPayload(t, "pushpayload.json"), expectedStatus: http.StatusOK},
+		{name: "callback failed", event: "Merge Request Hook", token: string(token), payload: readGitLabPayload(t, "propenpayload.json"), expectedStatus: http.StatusInternalServerError},
+		{name: "callback panicked", event: "Tag Push Hook", token: string(token), payload: `{"object_kind": "tag_push", "ref": "refs/tags/v1.0.0"}`, expectedStatus: http.StatusInternalServerError},
+		{name: "no callback", event: "Merge Request Hook", token: string(token), payload: readGitLabPayload(t, "prmergepayload.json"), expectedStatus: http.StatusNoContent},
+		{name: "unsupported event", event: "Issue Hook", token: string(token), payload: "{}", expectedStatus: http.StatusNoContent},
+		{name: "token mismatch", event: "Push Hook", token: "wrong-token", payload: readGitLabPayload(t, "pushpayload.json"), expectedStatus: http.StatusUnauthorized},
+		{name: "malformed payload", event: "Push Hook", token: string(token), payload: "{", expectedStatus: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			received = nil
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, newGitLabWebhookRequest(tt.event, tt.token, tt.payload))
+			assert.Equal(t, tt.expectedStatus, recorder.Code)
+			// The errors of the callbacks aren't sent to the VCS provider
+			assert.NotContains(t, recorder.Body.String(), "database")
+			assert.NotContains(t, recorder.Body.String(), "panicked")
+			if tt.expectedStatus == http.StatusOK {
+				require.NotNil(t, received)
+				assert.Equal(t, vcsutils.Push, received.Event)
+			} else {
+				assert.Nil(t, received)
+			}
+		})
+	}
+}
+
+func TestWebhookHandlerAsyncPanic(t *testing.T) {
+	handled := make(chan *WebhookInfo, 1)
+	panicked := false
+	handler := NewWebhookHandler(vcsclient.EmptyLogger{}, gitLabOrigin).
+		On(vcsutils.Push, func(_ context.Context, webhookInfo *WebhookInfo) error {
+			if !panicked {
+				panicked = true
+				panic("callback panicked")
+			}
+			handled <- webhookInfo
+			return nil
+		}).
+		Async(1, 2)
+	payload := readGitLabPayload(t, "pushpayload.json")
+	for i := 0; i < 2; i++ {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, newGitLabWebhookRequest("Push Hook", string(token), payload))
+		assert.Equal(t, http.StatusAccepted, recorder.Code)
+	}
+	// The worker survives the panic, and handles the next webhook
+	handler.Close()
+	assert.Len(t, handled, 1)
+}
+
+func TestWebhookHandlerResolverError(t *testing.T) {
+	handler := NewWebhookHandlerWithResolver(vcsclient.EmptyLogger{}, DetectOrigin(map[vcsutils.VcsProvider]WebhookOrigin{vcsutils.GitHub: {Token: token}}))
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, newGitLabWebhookRequest("Push Hook", string(token), "{}"))
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "no origin is configured")
+}
+
+func TestWebhookHandlerAsync(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	handled := make(chan *WebhookInfo, 3)
+	handler := NewWebhookHandler(vcsclient.EmptyLogger{}, gitLabOrigin).
+		On(vcsutils.Push, func(_ context.Context, webhookInfo *WebhookInfo) error {
+			started <- struct{}{}
+			<-release
+			handled <- webhookInfo
+			return nil
+		}).
+		Async(1, 1)
+	payload := readGitLabPayload(t, "pushpayload.json")
+	serve := func() int {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, newGitLabWebhookRequest("Push Hook", string(token), payload))
+		return recorder.Code
+	}
+
+	// The first webhook is handled by the worker, and the second waits in the queue
+	assert.Equal(t, http.StatusAccepted, serve())
+	<-started
+	assert.Equal(t, http.StatusAccepted, serve())
+	// The queue is full
+	assert.Equal(t, http.StatusServiceUnavailable, serve())
+
+	release <- struct{}{}
+	<-started
+	close(release)
+	handler.Close()
+	assert.Len(t, handled, 2)
+
+	// The handler is closed
+	assert.Equal(t, http.StatusServiceUnavailable, serve())
+}
+
+func TestWebhookHandlerDeduplicate(t *testing.T) {
+	calls := 0
+	var callbackErr error
+	handler := NewWebhookHandler(vcsclient.EmptyLogger{}, gitLabOrigin).
+		On(vcsutils.Push, func(context.Context, *WebhookInfo) error {
+			calls++
+			return callbackErr
+		}).
+		Deduplicate(NewMemoryDeliveryStore(10))
+	payload := readGitLabPayload(t, "pushpayload.json")
+	serve := func(deliveryID string) int {
+		recorder := httptest.NewRecorder()
+		request := newGitLabWebhookRequest("Push Hook", string(token), payload)
+		request.Header.Add("X-Gitlab-Event-UUID", deliveryID)
+		handler.ServeHTTP(recorder, request)
+		return recorder.Code
+	}
+
+	assert.Equal(t, http.StatusOK, serve("1"))
+	assert.Equal(t, http.StatusNoContent, serve("1"))
+	assert.Equal(t, http.StatusOK, serve("2"))
+	// Webhooks without a delivery ID are always handled
+	assert.Equal(t, http.StatusOK, serve(""))
+	assert.Equal(t, http.StatusOK, serve(""))
+	assert.Equal(t, 4, calls)
+
+	// A failed delivery can be redelivered
+	callbackErr = errors.New("callback failed")
+	assert.Equal(t, http.StatusInternalServerError, serve("3"))
+	callbackErr = nil
+	assert.Equal(t, http.StatusOK, serve("3"))
+	assert.Equal(t, 6, calls)
+}
+
+func TestWebhookHandlerDeduplicateInProgress(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan error)
+	handler := NewWebhookHandler(vcsclient.EmptyLogger{}, gitLabOrigin).
+		On(vcsutils.Push, func(context.Context, *WebhookInfo) error {
+			started <- struct{}{}
+			return <-release
+		}).
+		Deduplicate(NewMemoryDeliveryStore(10))
+	payload := readGitLabPayload(t, "pushpayload.json")
+	serve := func() int {
+		recorder := httptest.NewRecorder()
+		request := newGitLabWebhookRequest("Push Hook", string(token), payload)
+		request.Header.Add("X-Gitlab-Event-UUID", "1")
+		handler.ServeHTTP(recorder, request)
+		return recorder.Code
+	}
+
+	firstStatus := make(chan int)
+	go func() {
+		firstStatus <- serve()
+	}()
+	<-started
+	// A redelivery during the callback must be retried, since the callback may fail
+	assert.Equal(t, http.StatusConflict, serve())
+	release <- errors.New("callback failed")
+	assert.Equal(t, http.StatusInternalServerError, <-firstStatus)
+
+	go func() {
+		<-started
+		release <- nil
+	}()
+	assert.Equal(t, http.StatusOK, serve())
+	assert.Equal(t, http.StatusNoContent, serve())
+}
+
+func TestWebhookHandlerMaxDeliveryAge(t *testing.T) {
+	origin := WebhookOrigin{VcsProvider: vcsutils.BitbucketServer, Token: token}
+	handler := NewWebhookHandler(vcsclient.EmptyLogger{}, origin).
+		On(vcsutils.Push, func(context.Context, *WebhookInfo) error {
+			return nil
+		}).
+		MaxDeliveryAge(time.Hour)
+	payload, err := os.ReadFile(filepath.Join("testdata", "bitbucketserver", "pushpayload.json"))
+	require.NoError(t, err)
+	tests := []struct {
+		name           string
+		now            time.Time
+		expectedStatus int
+	}{
+		{name: "in the window", now: time.Unix(bitbucketServerPushExpectedTime, 0).Add(59 * time.Minute), expectedStatus: http.StatusOK},
+		{name: "too old", now: time.Unix(bitbucketServerPushExpectedTime, 0).Add(61 * time.Minute), expectedStatus: http.StatusUnauthorized},
+		{name: "in the future", now: time.Unix(bitbucketServerPushExpectedTime, 0).Add(-61 * time.Minute), expectedStatus: http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler.now = func() time.Time {
+				return tt.now
+			}
+			request := httptest.NewRequest("POST", "https://127.0.0.1", strings.NewReader(string(payload)))
+			request.Header.Add(EventHeaderKey, "repo:refs_changed")
+			request.Header.Add(sha256Signature, "sha256="+bitbucketServerPushSha256)
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, request)
+			assert.Equal(t, tt.expectedStatus, recorder.Code)
+		})
+	}
+}
+
+func TestWebhookHandlerMaxDeliveryAgeWithoutDeliveryTime(t *testing.T) {
+	handler := NewWebhookHandler(vcsclient.EmptyLogger{}, gitLabOrigin).
+		On(vcsutils.Push, func(context.Context, *WebhookInfo) error {
+			return nil
+		}).
+		MaxDeliveryAge(time.Hour)
+	// GitLab doesn't tell the delivery time, so the time of the pushed commit must not be checked
+	handler.now = func() time.Time {
+		return time.Unix(gitlabPushExpectedTime, 0).Add(24 * time.Hour)
+	}
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, newGitLabWebhookRequest("Push Hook", string(token), readGitLabPayload(t, "pushpayload.json")))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+}
+
+func TestWebhookHandlerMaxDeliveryAgeOfPullRequest(t *testing.T) {
+	handler := NewWebhookHandler(vcsclient.EmptyLogger{}, gitLabOrigin).
+		On(vcsutils.PrOpened, func(context.Context, *WebhookInfo) error {
+			return nil
+		}).
+		MaxDeliveryAge(time.Hour)
+	// The update time of the merge request is the delivery time, so a replayed payload is rejected
+	handler.now = func() time.Time {
+		return time.Unix(gitlabPrOpenExpectedTime, 0).Add(24 * time.Hour)
+	}
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, newGitLabWebhookRequest("Merge Request Hook", string(token), readGitLabPayload(t, "propenpayload.json")))
+	assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+}
+
+func TestParseIncomingWebhookDeliveryID(t *testing.T) {
+	request := newGitLabWebhookRequest("Push Hook", string(token), readGitLabPayload(t, "pushpayload.json"))
+	request.Header.Add("X-Gitlab-Event-UUID", "62b8f5c9-6cd9-4d1e-8a1b-1f6e8d8e2a3c")
+	actual, err := ParseIncomingWebhook(context.Background(), vcsclient.EmptyLogger{}, gitLabOrigin, request)
+	require.NoError(t, err)
+	assert.Equal(t, "62b8f5c9-6cd9-4d1e-8a1b-1f6e8d8e2a3c", actual.DeliveryID)
+}
+
+func readGitLabPayload(t *testing.T, filename string) string {
+	payload, err := os.ReadFile(filepath.Join("testdata", "gitlab", filename))
+	require.NoError(t, err)
+	return string(payload)
+}
+
+func newGitLabWebhookRequest(event, token, payload string) *http.Request {
+	request := httptest.NewRequest("POST", "https://127.0.0.1", strings.NewReader(payload))
+	request.Header.Add(gitLabKeyHeader, token)
+	request.Header.Add(gitLabEventHeader, event)
+	return request
+}
@@ -3,6 +3,8 @@ package webhookparser
 import (
 	"context"
 	"net/http"
+	"sort"
+	"time"
 
 	"github.com/jfrog/froggit-go/vcsclient"
 	"github.com/jfrog/froggit-go/vcsutils"
@@ -13,6 +15,8 @@ const (
 	EventHeaderKey = "X-Event-Key"
 	// gitNilHash is the hash value used by Git to indicate a non-existent commit.
 	gitNilHash = "0000000000000000000000000000000000000000"
+	// tagRefPrefix is the prefix of the full name of a tag ref
+	tagRefPrefix = "refs/tags/"
 )
 
 // WebhookInfo used for parsing an incoming webhook request from the VCS provider.
@@ -23,6 +27,8 @@ type WebhookInfo struct {
 	TargetBranch string `json:"branch,omitempty"`
 	// Pull request id
 	PullRequestId int `json:"pull_request_id,omitempty"`
+	// Pull request details (Pull request events only)
+	PullRequest WebHookInfoPullRequest `json:"pull_request,omitempty"`
 	// The source repository for pull requests
 	SourceRepositoryDetails WebHookInfoRepoDetails `json:"source_repository_details,omitempty"`
 	// The source branch for pull requests
@@ -45,6 +51,27 @@ type WebhookInfo struct {
 	Author WebHookInfoUser `json:"author,omitempty"`
 	// CompareUrl is HTML URL to see git comparison between commits (Push event only)
 	CompareUrl string `json:"compare_url,omitempty"`
+	// The name of the tag (Tag and release events only)
+	TagName string `json:"tag_name,omitempty"`
+	// The added comment (PrCommentAdded event only)
+	Comment WebHookInfoComment `json:"comment,omitempty"`
+	// The submitted review (PrReviewSubmitted and PrApproved events only)
+	Review WebHookInfoReview `json:"review,omitempty"`
+	// The published release (ReleasePublished event only)
+	Release WebHookInfoRelease `json:"release,omitempty"`
+	// The pushed commits, from the oldest to the newest (Push event only)
+	Commits []WebHookInfoCommit `json:"commits,omitempty"`
+	// The files changed by the pushed commits (Push event only)
+	ChangedFiles []WebHookInfoFile `json:"changed_files,omitempty"`
+	// True if the payload doesn't contain all the changed files, for example on Bitbucket.
+	// The changed files can be fetched by FillChangedFiles (Push event only).
+	ChangedFilesIncomplete bool `json:"changed_files_incomplete,omitempty"`
+	// The unique ID of the delivery, which is kept when the VCS provider redelivers the webhook
+	DeliveryID string `json:"delivery_id,omitempty"`
+	// Seconds from epoch of the event which fired the webhook, as told by the signed payload.
+	// For example, the update time of a pull request or the creation time of a comment, but never the time of a commit.
+	// Zero for the pushes of GitLab and Bitbucket Cloud, whose payloads don't tell when the push happened.
+	DeliveryTimestamp int64 `json:"delivery_timestamp,omitempty"`
 }
 
 // WebHookInfoRepoDetails represents repository info of an incoming webhook
@@ -58,6 +85,12 @@ type WebHookInfoCommit struct {
 	Hash    string `json:"hash,omitempty"`
 	Message string `json:"message,omitempty"`
 	Url     string `json:"url,omitempty"`
+	// Seconds from epoch (Commits only)
+	Timestamp int64 `json:"timestamp,omitempty"`
+	// Commit author (Commits only)
+	Author WebHookInfoUser `json:"author,omitempty"`
+	// The files changed by the commit (Commits only)
+	Files []WebHookInfoFile `json:"files,omitempty"`
 }
 
 type WebHookInfoBranchStatus string
@@ -79,6 +112,45 @@ func branchStatus(existedBefore, existsAfter bool) WebHookInfoBranchStatus {
 	}
 }
 
+// setPushEvent sets the event of a push to a branch or a tag by the status of the pushed ref.
+// A push of a branch is reported as Push, with the creation or deletion of the branch in BranchStatus,
+// and a push of a tag as TagPushed or TagDeleted.
+// deliveryTimestamp returns the seconds from epoch of the event which fired a webhook, or 0 if the event time is missing
+func deliveryTimestamp(eventTime time.Time) int64 {
+	if eventTime.IsZero() {
+		return 0
+	}
+	return eventTime.UTC().Unix()
+}
+
+func (info *WebhookInfo) setPushEvent(isTag bool, refName string) {
+	switch {
+	case isTag && info.BranchStatus == WebhookInfoBranchStatusDeleted:
+		info.Event = vcsutils.TagDeleted
+	case isTag:
+		info.Event = vcsutils.TagPushed
+	default:
+		info.Event = vcsutils.Push
+	}
+	if isTag {
+		info.TagName = refName
+		info.TargetBranch = ""
+	}
+}
+
+// setBranchEvent reports a push which creates or deletes a branch as BranchCreated or BranchDeleted, instead of Push
+func (info *WebhookInfo) setBranchEvent() {
+	if info.Event != vcsutils.Push {
+		return
+	}
+	switch info.BranchStatus {
+	case WebhookInfoBranchStatusCreated:
+		info.Event = vcsutils.BranchCreated
+	case WebhookInfoBranchStatusDeleted:
+		info.Event = vcsutils.BranchDeleted
+	}
+}
+
 type WebHookInfoUser struct {
 	Login       string `json:"login,omitempty"`
 	DisplayName string `json:"display_name,omitempty"`
@@ -86,13 +158,127 @@ type WebHookInfoUser struct {
 	AvatarUrl   string `json:"avatar_url,omitempty"`
 }
 
+// WebHookInfoPullRequest represents the pull request of an incoming webhook
+type WebHookInfoPullRequest struct {
+	Title       string          `json:"title,omitempty"`
+	Description string          `json:"description,omitempty"`
+	Author      WebHookInfoUser `json:"author,omitempty"`
+	// The last commit of the source branch
+	HeadSha string `json:"head_sha,omitempty"`
+	// The last commit of the target branch. Not sent by GitLab
+	BaseSha string `json:"base_sha,omitempty"`
+	// The URL of the pull request page in the VCS provider UI
+	Url    string   `json:"url,omitempty"`
+	Labels []string `json:"labels,omitempty"`
+	Draft  bool     `json:"draft,omitempty"`
+}
+
+// WebHookInfoComment represents a pull request comment of an incoming webhook
+type WebHookInfoComment struct {
+	ID     int64           `json:"id,omitempty"`
+	Body   string          `json:"body,omitempty"`
+	Author WebHookInfoUser `json:"author,omitempty"`
+}
+
+// WebHookInfoReview represents a pull request review of an incoming webhook
+type WebHookInfoReview struct {
+	Reviewer WebHookInfoUser `json:"reviewer,omitempty"`
+	// The review state as sent by the VCS provider, for example "changes_requested" on GitHub or "NEEDS_WORK" on Bitbucket server
+	State string `json:"state,omitempty"`
+	Body  string `json:"body,omitempty"`
+}
+
+// WebHookInfoRelease represents a release of an incoming webhook
+type WebHookInfoRelease struct {
+	Name string `json:"name,omitempty"`
+	Url  string `json:"url,omitempty"`
+}
+
+// WebHookInfoFile represents a file changed by a push of an incoming webhook
 type WebHookInfoFile struct {
 	Path string `json:"path,omitempty"`
+	// The path before the file was renamed. Empty unless the file is renamed
+	PreviousPath string              `json:"previous_path,omitempty"`
+	Status       vcsutils.FileStatus `json:"status,omitempty"`
+}
+
+// commitFiles returns the files changed by a commit, as listed in the push payloads of GitHub and GitLab
+func commitFiles(added, modified, removed []string) []WebHookInfoFile {
+	var files []WebHookInfoFile
+	for _, path := range added {
+		files = append(files, WebHookInfoFile{Path: path, Status: vcsutils.FileAdded})
+	}
+	for _, path := range modified {
+		files = append(files, WebHookInfoFile{Path: path, Status: vcsutils.FileModified})
+	}
+	for _, path := range removed {
+		files = append(files, WebHookInfoFile{Path: path, Status: vcsutils.FileRemoved})
+	}
+	return files
+}
+
+// changedFiles aggregates the files changed by the commits, ordered from the oldest to the newest, into the files changed by the whole push.
+// For example, a file added by one commit and modified by a later commit is added, and a file added and then removed isn't changed at all.
+func changedFiles(commits []WebHookInfoCommit) []WebHookInfoFile {
+	statuses := map[string]vcsutils.FileStatus{}
+	for _, commit := range commits {
+		for _, file := range commit.Files {
+			previousStatus, changedBefore := statuses[file.Path]
+			switch {
+			case !changedBefore:
+				statuses[file.Path] = file.Status
+			case previousStatus == vcsutils.FileAdded && file.Status == vcsutils.FileRemoved:
+				delete(statuses, file.Path)
+			case previousStatus == vcsutils.FileAdded:
+				// The file is still new, no matter how many times it is modified
+			case previousStatus == vcsutils.FileRemoved && file.Status == vcsutils.FileAdded:
+				statuses[file.Path] = vcsutils.FileModified
+			default:
+				statuses[file.Path] = file.Status
+			}
+		}
+	}
+	var files []WebHookInfoFile
+	for path, status := range statuses {
+		files = append(files, WebHookInfoFile{Path: path, Status: status})
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Path < files[j].Path
+	})
+	return files
+}
+
+// FillChangedFiles fetches the changed files of a push from the VCS provider, if the payload doesn't contain all of them.
+// The files are fetched by comparing the commits before and after the push. Pushes which create or delete a branch or a tag
+// have nothing to compare with, so their changed files are left incomplete.
+// ctx         - Go context
+// client      - A VCS client of the provider which sent the webhook
+// webhookInfo - The parsed webhook
+func FillChangedFiles(ctx context.Context, client vcsclient.VcsClient, webhookInfo *WebhookInfo) error {
+	if !webhookInfo.ChangedFilesIncomplete {
+		return nil
+	}
+	before, after := webhookInfo.BeforeCommit.Hash, webhookInfo.Commit.Hash
+	if before == "" || before == gitNilHash || after == "" || after == gitNilHash {
+		return nil
+	}
+	fileChanges, err := client.GetFileChanges(ctx, webhookInfo.TargetRepositoryDetails.Owner, webhookInfo.TargetRepositoryDetails.Name, before, after)
+	if err != nil {
+		return err
+	}
+	files := make([]WebHookInfoFile, 0, len(fileChanges))
+	for _, fileChange := range fileChanges {
+		files = append(files, WebHookInfoFile{Path: fileChange.Path, PreviousPath: fileChange.PreviousPath, Status: fileChange.Status})
+	}
+	webhookInfo.ChangedFiles = files
+	webhookInfo.ChangedFilesIncomplete = false
+	return nil
 }
 
 // webhookParser is a webhook parser of an incoming webhook from a VCS server
 type webhookParser interface {
-	// Validate the webhook payload with the expected token and return the payload
+	// Validate the webhook payload with the expected token and return the payload.
+	// Returns PayloadValidationError if the token or the signature doesn't match.
 	validatePayload(ctx context.Context, request *http.Request, token []byte) ([]byte, error)
 	// Parse the webhook payload and return WebhookInfo
 	parseIncomingWebhook(ctx context.Context, request *http.Request, payload []byte) (*WebhookInfo, error)
@@ -105,7 +291,40 @@ type webhookParser interface {
 // request - Received HTTP request
 func ParseIncomingWebhook(ctx context.Context, logger vcsclient.Log, origin WebhookOrigin, request *http.Request) (*WebhookInfo, error) {
 	parser := createWebhookParser(logger, origin)
-	return validateAndParseHttpRequest(ctx, logger, parser, origin.Token, request)
+	if parser == nil {
+		return nil, &vcsclient.UnsupportedFeatureError{Provider: origin.VcsProvider, Feature: "incoming webhooks"}
+	}
+	webhookInfo, err := validateAndParseHttpRequest(ctx, logger, parser, origin.Token, request)
+	if webhookInfo != nil {
+		webhookInfo.DeliveryID = request.Header.Get(deliveryIDHeaderKeys[origin.VcsProvider])
+		if origin.BranchEvents {
+			webhookInfo.setBranchEvent()
+		}
+	}
+	return webhookInfo, err
+}
+
+// deliveryIDHeaderKeys are the headers of the delivery IDs of every VCS provider
+var deliveryIDHeaderKeys = map[vcsutils.VcsProvider]string{
+	vcsutils.GitHub:          "X-GitHub-Delivery",
+	vcsutils.GitLab:          "X-Gitlab-Event-UUID",
+	vcsutils.BitbucketCloud:  bitbucketCloudRequestIDKey,
+	vcsutils.BitbucketServer: bitbucketServerRequestIDKey,
+}
+
+// ParseIncomingWebhookAuto parses incoming webhook HTTP request of any VCS provider into WebhookInfo struct.
+// The VCS provider is detected by DetectVcsProvider. Webhooks of providers without an origin in the origins map are rejected.
+// ctx - Go context
+// logger - Used to log any trace about the parsing
+// origins - The origin of every accepted VCS provider, with its token and URL
+// request - Received HTTP request
+func ParseIncomingWebhookAuto(ctx context.Context, logger vcsclient.Log, origins map[vcsutils.VcsProvider]WebhookOrigin, request *http.Request) (*WebhookInfo, error) {
+	origin, err := DetectOrigin(origins)(request)
+	if err != nil {
+		return nil, err
+	}
+	logger.Debug("Detected an incoming webhook of ", origin.VcsProvider)
+	return ParseIncomingWebhook(ctx, logger, origin, request)
 }
 
 // WebhookOrigin provides information about the hook to parse.
@@ -117,6 +336,23 @@ type WebhookOrigin struct {
 	// Token is used to authenticate incoming webhooks. If empty, signature will not be verified.
 	// The token is a random key generated in the CreateWebhook command.
 	Token []byte
+	// BranchEvents reports a push which creates or deletes a branch as BranchCreated or BranchDeleted.
+	// By default, such a push is reported as Push, and the creation or deletion is reported in BranchStatus.
+	BranchEvents bool
+}
+
+// PayloadValidationError is returned when the payload of an incoming webhook can't be validated with the token,
+// for example when the signature of the payload doesn't match.
+type PayloadValidationError struct {
+	err error
+}
+
+func (err *PayloadValidationError) Error() string {
+	return err.err.Error()
+}
+
+func (err *PayloadValidationError) Unwrap() error {
+	return err.err
 }
 
 func validateAndParseHttpRequest(ctx context.Context, logger vcsclient.Log, parser webhookParser, token []byte, request *http.Request) (*WebhookInfo, error) {
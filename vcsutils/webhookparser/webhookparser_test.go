@@ -1,9 +1,18 @@
 package webhookparser
 
 import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"strings"
 	"testing"
+	"testing/iotest"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jfrog/froggit-go/vcsclient"
+	"github.com/jfrog/froggit-go/vcsutils"
 )
 
 func TestBranchStatus(t *testing.T) {
@@ -13,3 +22,136 @@ func TestBranchStatus(t *testing.T) {
 	// this one should never happen
 	assert.Equal(t, WebhookInfoBranchStatusUpdated, branchStatus(false, false))
 }
+
+func TestChangedFiles(t *testing.T) {
+	commits := []WebHookInfoCommit{
+		{Files: commitFiles([]string{"added", "added-modified", "added-removed"}, []string{"modified"}, []string{"removed-added"})},
+		{Files: commitFiles([]string{"removed-added"}, []string{"added-modified", "modified-removed"}, []string{"added-removed"})},
+		{Files: commitFiles(nil, nil, []string{"modified-removed"})},
+	}
+	assert.Equal(t, []WebHookInfoFile{
+		{Path: "added", Status: vcsutils.FileAdded},
+		{Path: "added-modified", Status: vcsutils.FileAdded},
+		{Path: "modified", Status: vcsutils.FileModified},
+		{Path: "modified-removed", Status: vcsutils.FileRemoved},
+		{Path: "removed-added", Status: vcsutils.FileModified},
+	}, changedFiles(commits))
+	assert.Empty(t, changedFiles(nil))
+}
+
+// fileChangesClient is a VCS client which returns predefined file changes
+type fileChangesClient struct {
+	vcsclient.VcsClient
+	fileChanges []vcsclient.FileChange
+	err         error
+	refs        []string
+}
+
+func (client *fileChangesClient) GetFileChanges(_ context.Context, owner, repository, refBefore, refAfter string) ([]vcsclient.FileChange, error) {
+	client.refs = []string{owner, repository, refBefore, refAfter}
+	return client.fileChanges, client.err
+}
+
+func TestFillChangedFiles(t *testing.T) {
+	client := &fileChangesClient{fileChanges: []vcsclient.FileChange{
+		{Path: "README.md", Status: vcsutils.FileModified},
+		{Path: "new.go", PreviousPath: "old.go", Status: vcsutils.FileRenamed},
+	}}
+	webhookInfo := &WebhookInfo{
+		TargetRepositoryDetails: WebHookInfoRepoDetails{Name: "hello-world", Owner: "yahavi"},
+		Commit:                  WebHookInfoCommit{Hash: "929d3054"},
+		BeforeCommit:            WebHookInfoCommit{Hash: "0c3bb2b8"},
+		ChangedFilesIncomplete:  true,
+	}
+	require.NoError(t, FillChangedFiles(context.Background(), client, webhookInfo))
+	assert.Equal(t, []string{"yahavi", "hello-world", "0c3bb2b8", "929d3054"}, client.refs)
+	assert.Equal(t, []WebHookInfoFile{
+		{Path: "README.md", Status: vcsutils.FileModified},
+		{Path: "new.go", PreviousPath: "old.go", Status: vcsutils.FileRenamed},
+	}, webhookInfo.ChangedFiles)
+	assert.False(t, webhookInfo.ChangedFilesIncomplete)
+
+	// Complete changed files aren't fetched
+	client.refs = nil
+	require.NoError(t, FillChangedFiles(context.Background(), client, webhookInfo))
+	assert.Nil(t, client.refs)
+
+	// A created branch has no commit to compare with
+	webhookInfo = &WebhookInfo{Commit: WebHookInfoCommit{Hash: "929d3054"}, BeforeCommit: WebHookInfoCommit{Hash: gitNilHash}, ChangedFilesIncomplete: true}
+	require.NoError(t, FillChangedFiles(context.Background(), client, webhookInfo))
+	assert.Nil(t, client.refs)
+	assert.True(t, webhookInfo.ChangedFilesIncomplete)
+
+	client.err = errors.New("compare failed")
+	webhookInfo = &WebhookInfo{Commit: WebHookInfoCommit{Hash: "929d3054"}, BeforeCommit: WebHookInfoCommit{Hash: "0c3bb2b8"}, ChangedFilesIncomplete: true}
+	assert.EqualError(t, FillChangedFiles(context.Background(), client, webhookInfo), "compare failed")
+	assert.True(t, webhookInfo.ChangedFilesIncomplete)
+}
+
+func TestParseIncomingWebhookBranchEvents(t *testing.T) {
+	tests := []struct {
+		name          string
+		branchEvents  bool
+		before        string
+		after         string
+		expectedEvent vcsutils.WebhookEvent
+	}{
+		{name: "created", before: gitNilHash, after: "929d3054", expectedEvent: vcsutils.Push},
+		{name: "created with branch events", branchEvents: true, before: gitNilHash, after: "929d3054", expectedEvent: vcsutils.BranchCreated},
+		{name: "deleted with branch events", branchEvents: true, before: "929d3054", after: gitNilHash, expectedEvent: vcsutils.BranchDeleted},
+		{name: "updated with branch events", branchEvents: true, before: "929d3054", after: "0c3bb2b8", expectedEvent: vcsutils.Push},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payload := `{"object_kind": "push", "ref": "refs/heads/dev", "before": "` + tt.before + `", "after": "` + tt.after + `"}`
+			request := newGitLabWebhookRequest("Push Hook", string(token), payload)
+			origin := WebhookOrigin{VcsProvider: vcsutils.GitLab, Token: token, BranchEvents: tt.branchEvents}
+			actual, err := ParseIncomingWebhook(context.Background(), vcsclient.EmptyLogger{}, origin, request)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedEvent, actual.Event)
+			assert.Equal(t, "dev", actual.TargetBranch)
+		})
+	}
+}
+
+func TestParseIncomingWebhookPayloadValidationError(t *testing.T) {
+	tests := []struct {
+		name            string
+		origin          WebhookOrigin
+		event           string
+		validationError bool
+	}{
+		{name: "github", origin: WebhookOrigin{VcsProvider: vcsutils.GitHub, Token: token}, event: "push"},
+		{name: "gitlab", origin: WebhookOrigin{VcsProvider: vcsutils.GitLab, Token: token}, event: "Push Hook"},
+		{name: "bitbucket server", origin: WebhookOrigin{VcsProvider: vcsutils.BitbucketServer, Token: token}, event: "repo:refs_changed"},
+		{name: "bitbucket cloud", origin: WebhookOrigin{VcsProvider: vcsutils.BitbucketCloud, Token: token}, event: "repo:push"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var validationError *PayloadValidationError
+			// A failure to read the body isn't a validation error
+			request := httptest.NewRequest("POST", "https://127.0.0.1?token="+string(token), iotest.ErrReader(errors.New("read failed")))
+			request.Header.Add("Content-Type", "application/json")
+			request.Header.Add(EventHeaderKey, tt.event)
+			request.Header.Add(githubEventHeader, tt.event)
+			request.Header.Add(gitLabEventHeader, tt.event)
+			request.Header.Add(gitLabKeyHeader, string(token))
+			request.Header.Add(githubSha256Header, "sha256=signature")
+			_, err := ParseIncomingWebhook(context.Background(), vcsclient.EmptyLogger{}, tt.origin, request)
+			assert.EqualError(t, err, "read failed")
+			assert.False(t, errors.As(err, &validationError))
+
+			// A wrong token or signature is a validation error
+			request = httptest.NewRequest("POST", "https://127.0.0.1?token=wrong-token", strings.NewReader("{}"))
+			request.Header.Add("Content-Type", "application/json")
+			request.Header.Add(EventHeaderKey, tt.event)
+			request.Header.Add(githubEventHeader, tt.event)
+			request.Header.Add(gitLabEventHeader, tt.event)
+			request.Header.Add(gitLabKeyHeader, "wrong-token")
+			request.Header.Add(githubSha256Header, "sha256=0123")
+			request.Header.Add(sha256Signature, "sha256=0123")
+			_, err = ParseIncomingWebhook(context.Background(), vcsclient.EmptyLogger{}, tt.origin, request)
+			assert.ErrorAs(t, err, &validationError)
+		})
+	}
+}